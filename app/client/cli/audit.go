@@ -0,0 +1,120 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/spf13/cobra"
+)
+
+var (
+	UnknownAuditFormatErr = errors.New("Unknown output format, expected table or json")
+	UnableCreateAListErr  = errors.New("Failed to create audit log list")
+)
+
+type auditRow struct {
+	Time     string          `json:"time"`
+	User     string          `json:"user"`
+	Method   string          `json:"method"`
+	EventTag string          `json:"event_tag,omitempty"`
+	Msg      string          `json:"msg"`
+	Fields   json.RawMessage `json:"fields,omitempty"`
+}
+
+func (c *Client) CmdAudit() *cobra.Command {
+	var (
+		req    pb.QueryAuditLogRequest
+		limit  int
+		format string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Query the audit log of the daemon (superuser only)",
+		Example: `hkn audit --event esboot --method StopEvent
+hkn audit --user alice --since "2020-02-13 09:00" --until 2020-02-15 --format json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if format != "table" && format != "json" {
+				PrintError(UnknownAuditFormatErr)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			req.Limit = int32(limit)
+			r, err := c.rpcClient.QueryAuditLog(ctx, &req)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if err := writeAuditLog(os.Stdout, format, r.Entries); err != nil {
+				PrintError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&req.User, "user", "u", "", "only list actions of this user")
+	cmd.Flags().StringVarP(&req.Method, "method", "m", "", "only list calls of this method, e.g. StopEvent")
+	cmd.Flags().StringVarP(&req.EventTag, "event", "e", "", "only list actions on the event with this tag")
+	cmd.Flags().StringVar(&req.Since, "since", "", "only list actions from this time (YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")")
+	cmd.Flags().StringVar(&req.Until, "until", "", "only list actions before this time (YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 100, "maximum number of the latest actions to list")
+	cmd.Flags().StringVarP(&format, "format", "f", "table", "output format (table or json)")
+
+	return cmd
+}
+
+func writeAuditLog(w io.Writer, format string, entries []*pb.QueryAuditLogResponse_Entry) error {
+	rows := []auditRow{}
+	for _, e := range entries {
+		row := auditRow{
+			Time:     e.Time,
+			User:     e.User,
+			Method:   e.Method,
+			EventTag: e.EventTag,
+			Msg:      e.Msg,
+		}
+		if e.Fields != "" {
+			row.Fields = json.RawMessage(e.Fields)
+		}
+
+		rows = append(rows, row)
+	}
+
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	f := formatter{
+		header: []string{"TIME", "USER", "METHOD", "EVENT", "MESSAGE", "FIELDS"},
+		fields: []string{"Time", "User", "Method", "EventTag", "Msg", "Fields"},
+	}
+
+	var elements []formatElement
+	for _, e := range entries {
+		elements = append(elements, e)
+	}
+
+	table, err := f.AsTable(elements)
+	if err != nil {
+		return UnableCreateAListErr
+	}
+
+	_, err = fmt.Fprint(w, table)
+	return err
+}
@@ -6,17 +6,23 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
 	pbar "github.com/schollz/progressbar"
 	"github.com/spf13/cobra"
 	"io"
+	"os"
+	"strconv"
 	"time"
 )
 
 var (
-	UnableCreateEListErr = errors.New("Failed to create event list")
+	UnableCreateEListErr      = errors.New("Failed to create event list")
+	UnableCreateScoreboardErr = errors.New("Failed to create scoreboard")
+	UnknownExportFormatErr    = errors.New("Unknown export format, expected csv or json")
 )
 
 func (c *Client) CmdEvent() *cobra.Command {
@@ -29,44 +35,73 @@ func (c *Client) CmdEvent() *cobra.Command {
 	cmd.AddCommand(
 		c.CmdEventCreate(),
 		c.CmdEventStop(),
+		c.CmdEventSuspend(),
+		c.CmdEventResume(),
 		c.CmdEventList(),
 		c.CmdEventTeams(),
-		c.CmdEventTeamRestart())
+		c.CmdEventTeamRestart(),
+		c.CmdEventTeamSnapshot(),
+		c.CmdEventTeamRestore(),
+		c.CmdEventScoreboard(),
+		c.CmdEventIncidents(),
+		c.CmdEventSubmissions(),
+		c.CmdEventExport())
 
 	return cmd
 }
 
 func (c *Client) CmdEventCreate() *cobra.Command {
 	var (
-		name      string
-		available int
-		capacity  int
-		frontends []string
-		exercises []string
+		name       string
+		available  int
+		capacity   int
+		frontends  []string
+		exercises  []string
+		startTime  string
 		finishTime string
+		flagFormat string
+		scoring    pb.Scoring
 	)
 
 	cmd := &cobra.Command{
 		Use:     "create [event tag]",
 		Short:   "Create event",
-		Example: `hkn event create esboot -name "ES Bootcamp" -a 5 -c 30 -e scan,sql,hb -f kali -d 2020-02-15`,
+		Example: `hkn event create esboot -name "ES Bootcamp" -a 5 -c 30 -e scan,sql,hb -f kali -s "2020-02-13 09:00" -d 2020-02-15`,
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := context.Background()
 			tag := args[0]
 			stream, err := c.rpcClient.CreateEvent(ctx, &pb.CreateEventRequest{
-				Name:                 name,
-				Tag:                  tag,
-				Frontends:            frontends,
-				Exercises:            exercises,
-				Available:            int32(available),
-				Capacity:             int32(capacity),
-				FinishTime:           finishTime,
+				Name:       name,
+				Tag:        tag,
+				Frontends:  frontends,
+				Exercises:  exercises,
+				Available:  int32(available),
+				Capacity:   int32(capacity),
+				StartTime:  startTime,
+				FinishTime: finishTime,
+				Scoring:    &scoring,
+				FlagFormat: flagFormat,
 			})
 			if err != nil {
 				PrintError(err)
 				return
 			}
+
+			if startTime != "" {
+				for {
+					_, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						PrintError(err)
+						return
+					}
+				}
+				fmt.Printf("Event %s scheduled to start at %s\n", tag, startTime)
+				return
+			}
 			// progress bar library changed
 			// now it does not create stack of progress bar,
 			// once anything is received from daemon.
@@ -101,7 +136,15 @@ func (c *Client) CmdEventCreate() *cobra.Command {
 	cmd.Flags().IntVarP(&capacity, "capacity", "c", 10, "maximum amount of labs")
 	cmd.Flags().StringSliceVarP(&frontends, "frontends", "f", []string{}, "list of frontends to have for each lab")
 	cmd.Flags().StringSliceVarP(&exercises, "exercises", "e", []string{}, "list of exercises to have for each lab")
-	cmd.Flags().StringVarP(&finishTime, "finishtime", "d", "", "expected finish time of the event")
+	cmd.Flags().StringVarP(&startTime, "starttime", "s", "", "time at which the event should be started (YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")")
+	cmd.Flags().StringVarP(&finishTime, "finishtime", "d", "", "time at which the event is stopped and archived (YYYY-MM-DD or \"YYYY-MM-DD HH:MM\")")
+	cmd.Flags().StringVar(&flagFormat, "flag-format", "", "format of flags for exercises without their own, e.g. \"HKN{%s}\"")
+
+	cmd.Flags().StringVar(&scoring.Mode, "scoring", "static", "scoring mode of challenges (static or dynamic)")
+	cmd.Flags().Int32Var(&scoring.Initial, "initial", 0, "initial points of every challenge with dynamic scoring (defaults to the points of the challenge)")
+	cmd.Flags().Int32Var(&scoring.Minimum, "minimum", 0, "minimum points of a challenge with dynamic scoring")
+	cmd.Flags().Int32Var(&scoring.Decay, "decay", 0, "amount of solves before a challenge reaches its minimum points with dynamic scoring")
+	cmd.Flags().Int32Var(&scoring.FirstBloodBonus, "first-blood", 0, "bonus points for the first team to solve a challenge")
 
 	cmd.MarkFlagRequired("name")
 
@@ -142,6 +185,68 @@ func (c *Client) CmdEventStop() *cobra.Command {
 	}
 }
 
+func (c *Client) CmdEventSuspend() *cobra.Command {
+	return &cobra.Command{
+		Use:     "suspend [event tag]",
+		Short:   "Suspend event, stopping its labs until resumed",
+		Example: `hkn event suspend esboot`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			stream, err := c.rpcClient.SuspendEvent(ctx, &pb.SuspendEventRequest{
+				Tag: args[0],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			for {
+				_, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+
+				if err != nil {
+					PrintError(err)
+					return
+				}
+			}
+		},
+	}
+}
+
+func (c *Client) CmdEventResume() *cobra.Command {
+	return &cobra.Command{
+		Use:     "resume [event tag]",
+		Short:   "Resume suspended event",
+		Example: `hkn event resume esboot`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			stream, err := c.rpcClient.ResumeEvent(ctx, &pb.ResumeEventRequest{
+				Tag: args[0],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			for {
+				_, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+
+				if err != nil {
+					PrintError(err)
+					return
+				}
+			}
+		},
+	}
+}
+
 func (c *Client) CmdEvents() *cobra.Command {
 	return &cobra.Command{
 		Use:     "events",
@@ -158,8 +263,8 @@ func (c *Client) CmdEvents() *cobra.Command {
 			}
 
 			f := formatter{
-				header: []string{"EVENT TAG", "NAME", "# TEAM", "EXERCISES", "CAPACITY", "CREATION TIME", "EXPECTED FINISH TIME"},
-				fields: []string{"Tag", "Name", "TeamCount", "Exercises", "Capacity", "CreationTime", "FinishTime"},
+				header: []string{"EVENT TAG", "NAME", "STATUS", "# TEAM", "EXERCISES", "CAPACITY", "CREATION TIME", "EXPECTED FINISH TIME"},
+				fields: []string{"Tag", "Name", "Status", "TeamCount", "Exercises", "Capacity", "CreationTime", "FinishTime"},
 			}
 
 			var elements []formatElement
@@ -224,6 +329,89 @@ func (c *Client) CmdEventTeams() *cobra.Command {
 	}
 }
 
+func (c *Client) CmdEventIncidents() *cobra.Command {
+	return &cobra.Command{
+		Use:     "incidents [event tag]",
+		Short:   "List unhealthy lab instances found in an event",
+		Example: `hkn event incidents esboot`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListIncidents(ctx, &pb.ListIncidentsRequest{
+				EventTag: args[0],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"TIME", "TEAM ID", "INSTANCE", "IMAGE", "STATE", "ACTION", "RESTARTS", "ERROR"},
+				fields: []string{"Time", "TeamId", "InstanceId", "Image", "State", "Action", "Restarts", "Error"},
+			}
+
+			var elements []formatElement
+			for _, i := range r.Incidents {
+				elements = append(elements, i)
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
+
+func (c *Client) CmdEventSubmissions() *cobra.Command {
+	var teamId string
+
+	cmd := &cobra.Command{
+		Use:     "submissions [event tag]",
+		Short:   "List flags submitted by the teams of an event",
+		Example: `hkn event submissions esboot --team d11eb89b`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListSubmissions(ctx, &pb.ListSubmissionsRequest{
+				EventTag: args[0],
+				TeamId:   teamId,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"TIME", "TEAM ID", "CHALLENGE", "RESULT", "HASHED", "VALUE"},
+				fields: []string{"Time", "TeamId", "Tag", "Result", "Hashed", "Value"},
+			}
+
+			var elements []formatElement
+			for _, s := range r.Submissions {
+				elements = append(elements, s)
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamId, "team", "t", "", "only list submissions of the team with this id")
+
+	return cmd
+}
+
 func (c *Client) CmdEventTeamRestart() *cobra.Command {
 	return &cobra.Command{
 		Use:     "restart [event tag] [team id]",
@@ -264,3 +452,255 @@ func (c *Client) CmdEventTeamRestart() *cobra.Command {
 		},
 	}
 }
+
+func (c *Client) CmdEventTeamSnapshot() *cobra.Command {
+	return &cobra.Command{
+		Use:     "snapshot [event tag] [team id] [name]",
+		Short:   "Take a named snapshot of the lab of a team",
+		Example: `hkn event snapshot esboot d11eb89b before-exploit`,
+		Args:    cobra.MinimumNArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			// snapshots of running virtual machines include their memory,
+			// so no timeout is used
+			ctx := context.Background()
+
+			_, err := c.rpcClient.SnapshotTeamLab(ctx, &pb.SnapshotTeamLabRequest{
+				EventTag: args[0],
+				TeamId:   args[1],
+				Name:     args[2],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+}
+
+func (c *Client) CmdEventTeamRestore() *cobra.Command {
+	return &cobra.Command{
+		Use:     "restore [event tag] [team id] [name]",
+		Short:   "Restore the lab of a team to a named snapshot",
+		Example: `hkn event restore esboot d11eb89b before-exploit`,
+		Args:    cobra.MinimumNArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+
+			_, err := c.rpcClient.RestoreTeamLab(ctx, &pb.RestoreTeamLabRequest{
+				EventTag: args[0],
+				TeamId:   args[1],
+				Name:     args[2],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+}
+
+func (c *Client) CmdEventScoreboard() *cobra.Command {
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:     "scoreboard [event tag]",
+		Short:   "Show the scoreboard of an event",
+		Example: `hkn event scoreboard esboot --follow`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			tag := args[0]
+
+			table, err := c.scoreboardTable(tag)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if !follow {
+				fmt.Printf(table)
+				return
+			}
+
+			stream, err := c.rpcClient.StreamSolves(context.Background(), &pb.StreamSolvesRequest{
+				EventTag: tag,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			var lastSolve string
+			for {
+				// clear the terminal so the scoreboard is redrawn in place
+				fmt.Print("\033[H\033[2J")
+				fmt.Printf(table)
+				if lastSolve != "" {
+					fmt.Printf("\n%s\n", lastSolve)
+				}
+
+				s, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				if err != nil {
+					PrintError(err)
+					return
+				}
+				lastSolve = fmt.Sprintf("[%s] %s solved %s (+%d)", s.CompletedAt, s.TeamName, s.ChallengeTag, s.Points)
+
+				table, err = c.scoreboardTable(tag)
+				if err != nil {
+					PrintError(err)
+					return
+				}
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep the scoreboard updated as challenges are solved")
+
+	return cmd
+}
+
+func (c *Client) scoreboardTable(tag string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	r, err := c.rpcClient.GetScoreboard(ctx, &pb.GetScoreboardRequest{
+		EventTag: tag,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	f := formatter{
+		header: []string{"RANK", "TEAM", "POINTS", "SOLVES", "LAST SOLVE"},
+		fields: []string{"Rank", "TeamName", "Points", "Solves", "LastSolve"},
+	}
+
+	var elements []formatElement
+	for _, t := range r.Teams {
+		elements = append(elements, t)
+	}
+
+	table, err := f.AsTable(elements)
+	if err != nil {
+		return "", UnableCreateScoreboardErr
+	}
+
+	return table, nil
+}
+
+type exportRow struct {
+	TeamId                   string `json:"team_id"`
+	TeamName                 string `json:"team_name"`
+	ChallengeTag             string `json:"challenge_tag"`
+	ChallengeName            string `json:"challenge_name"`
+	Category                 string `json:"category"`
+	Points                   int32  `json:"points"`
+	CompletedAt              string `json:"completed_at"`
+	SecondsSinceTeamCreation int64  `json:"seconds_since_team_creation"`
+	HintPenalty              int32  `json:"hint_penalty"`
+}
+
+func (c *Client) CmdEventExport() *cobra.Command {
+	var (
+		format string
+		output string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "export [event tag]",
+		Short:   "Export the results of a running or archived event",
+		Example: `hkn event export esboot --format csv --output esboot.csv`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if format != "csv" && format != "json" {
+				PrintError(UnknownExportFormatErr)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ExportEvent(ctx, &pb.ExportEventRequest{
+				Tag: args[0],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			var w io.Writer = os.Stdout
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					PrintError(err)
+					return
+				}
+				defer f.Close()
+				w = f
+			}
+
+			if err := writeExport(w, format, r.Rows); err != nil {
+				PrintError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "csv", "output format (csv or json)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write the export to (default is stdout)")
+
+	return cmd
+}
+
+func writeExport(w io.Writer, format string, rows []*pb.ExportEventResponse_Row) error {
+	var export []exportRow
+	for _, r := range rows {
+		export = append(export, exportRow{
+			TeamId:                   r.TeamId,
+			TeamName:                 r.TeamName,
+			ChallengeTag:             r.ChallengeTag,
+			ChallengeName:            r.ChallengeName,
+			Category:                 r.Category,
+			Points:                   r.Points,
+			CompletedAt:              r.CompletedAt,
+			SecondsSinceTeamCreation: r.SecondsSinceTeamCreation,
+			HintPenalty:              r.HintPenalty,
+		})
+	}
+
+	if format == "json" {
+		if export == nil {
+			export = []exportRow{}
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(export)
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"team_id", "team_name", "challenge_tag", "challenge_name", "category", "points", "completed_at", "seconds_since_team_creation", "hint_penalty"})
+	for _, r := range export {
+		var since string
+		if r.CompletedAt != "" {
+			since = strconv.FormatInt(r.SecondsSinceTeamCreation, 10)
+		}
+
+		cw.Write([]string{
+			r.TeamId,
+			r.TeamName,
+			r.ChallengeTag,
+			r.ChallengeName,
+			r.Category,
+			strconv.Itoa(int(r.Points)),
+			r.CompletedAt,
+			since,
+			strconv.Itoa(int(r.HintPenalty)),
+		})
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"strings"
 	"time"
@@ -26,7 +27,10 @@ func (c *Client) CmdExercise() *cobra.Command {
 	cmd.AddCommand(
 		c.CmdExerciseList(),
 		c.CmdExerciseReset(),
+		c.CmdExerciseAdd(),
+		c.CmdExerciseRemove(),
 		c.CmdUpdateExerciseFile(),
+		c.CmdExerciseLint(),
 	)
 
 	return cmd
@@ -83,24 +87,159 @@ func (c *Client) CmdExerciseList() *cobra.Command {
 	return &cmd
 }
 func (c *Client) CmdUpdateExerciseFile() *cobra.Command {
+	var dryRun bool
+
 	cmd := &cobra.Command{
-		Use:     "update [path of challenges file]",
-		Short:   "Updates challenges file",
-		Example: "hkn update exercises.yml",
-		Args:    cobra.MaximumNArgs(1),
+		Use:     "update",
+		Short:   "Reload the exercises file of the daemon",
+		Example: "hkn exercise update --dry-run",
+		Args:    cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 			defer cancel()
-			resp, err := c.rpcClient.UpdateExercisesFile(ctx, &pb.Empty{})
+			resp, err := c.rpcClient.UpdateExercisesFile(ctx, &pb.UpdateExercisesFileRequest{DryRun: dryRun})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if resp.ValidationError != "" {
+				PrintError(fmt.Errorf("%s: %s", resp.Msg, resp.ValidationError))
+				return
+			}
+
+			table, err := exercisesDiffTable(resp.Diff)
 			if err != nil {
 				PrintError(err)
+				return
 			}
+
+			if table == "" {
+				fmt.Println("No changes to exercises")
+			} else {
+				fmt.Printf(table)
+			}
+
+			for _, ev := range resp.AffectedEvents {
+				fmt.Printf("Event %s uses changed exercises: %s\n", ev.Tag, strings.Join(ev.Exercises, ","))
+			}
+
 			fmt.Println(resp.Msg)
 		},
 	}
+
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "d", false, "validate the exercises file and show changes without applying them")
+
 	return cmd
 }
 
+func (c *Client) CmdExerciseLint() *cobra.Command {
+	return &cobra.Command{
+		Use:     "lint [file]",
+		Short:   "Check an exercises file for mistakes, the one of the daemon if no file is given",
+		Example: "hkn exercise lint exercises.yml",
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var content []byte
+			if len(args) > 0 {
+				var err error
+				content, err = ioutil.ReadFile(args[0])
+				if err != nil {
+					PrintError(err)
+					return
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			r, err := c.rpcClient.ValidateExercisesFile(ctx, &pb.ValidateExercisesFileRequest{Content: content})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if len(r.Issues) == 0 {
+				fmt.Println("No issues found")
+				return
+			}
+
+			f := formatter{
+				header: []string{"EXERCISE", "ISSUE"},
+				fields: []string{"Exercise", "Msg"},
+			}
+
+			var elements []formatElement
+			for _, i := range r.Issues {
+				elements = append(elements, i)
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+			fmt.Printf(table)
+			PrintWarning(fmt.Sprintf("Found %d issues", len(r.Issues)))
+		},
+	}
+}
+
+func exercisesDiffTable(diff *pb.ExercisesDiff) (string, error) {
+	if diff == nil {
+		return "", nil
+	}
+
+	f := formatter{
+		header: []string{"CHANGE", "TAG", "NAME", "FLAGS", "IMAGES"},
+		fields: []string{"Change", "Tag", "Name", "Flags", "Images"},
+	}
+
+	// prefixes describe whether a flag or image is added, removed or changed
+	prefixed := func(prefix string, values []string) []string {
+		var res []string
+		for _, v := range values {
+			res = append(res, prefix+v)
+		}
+		return res
+	}
+
+	var elements []formatElement
+	add := func(change string, exercises []*pb.ExercisesDiff_Exercise) {
+		for _, e := range exercises {
+			var flags, images []string
+			flags = append(flags, prefixed("+", e.AddedFlags)...)
+			flags = append(flags, prefixed("-", e.RemovedFlags)...)
+			flags = append(flags, prefixed("~", e.ChangedFlags)...)
+			images = append(images, prefixed("+", e.AddedImages)...)
+			images = append(images, prefixed("-", e.RemovedImages)...)
+
+			elements = append(elements, struct {
+				Change string
+				Tag    string
+				Name   string
+				Flags  string
+				Images string
+			}{
+				Change: change,
+				Tag:    e.Tag,
+				Name:   e.Name,
+				Flags:  strings.Join(flags, ","),
+				Images: strings.Join(images, ","),
+			})
+		}
+	}
+
+	add("added", diff.Added)
+	add("removed", diff.Removed)
+	add("changed", diff.Changed)
+
+	if len(elements) == 0 {
+		return "", nil
+	}
+
+	return f.AsTable(elements)
+}
+
 func (c *Client) CmdExerciseReset() *cobra.Command {
 	var (
 		evTag   string
@@ -155,3 +294,64 @@ func (c *Client) CmdExerciseReset() *cobra.Command {
 
 	return cmd
 }
+
+func (c *Client) CmdExerciseAdd() *cobra.Command {
+	var evTag string
+
+	cmd := &cobra.Command{
+		Use:     "add [exercise tags]",
+		Short:   "Add exercises to a running event",
+		Long:    "Add exercises to every lab of a running event, their challenges are created in CTFd.",
+		Example: `hkn exercise add sql xss -e esboot`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			// exercises are created in every lab of the event,
+			// so no timeout is used
+			ctx := context.Background()
+
+			_, err := c.rpcClient.AddExercisesToEvent(ctx, &pb.EventExercisesRequest{
+				EventTag:  evTag,
+				Exercises: args,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&evTag, "evtag", "e", "", "the event name")
+	cmd.MarkFlagRequired("evtag")
+
+	return cmd
+}
+
+func (c *Client) CmdExerciseRemove() *cobra.Command {
+	var evTag string
+
+	cmd := &cobra.Command{
+		Use:     "remove [exercise tags]",
+		Short:   "Remove exercises from a running event",
+		Long:    "Remove exercises from every lab of a running event, their challenges are hidden in CTFd while solves are kept.",
+		Example: `hkn exercise remove sql -e esboot`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+			defer cancel()
+
+			_, err := c.rpcClient.RemoveExercisesFromEvent(ctx, &pb.EventExercisesRequest{
+				EventTag:  evTag,
+				Exercises: args,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&evTag, "evtag", "e", "", "the event name")
+	cmd.MarkFlagRequired("evtag")
+
+	return cmd
+}
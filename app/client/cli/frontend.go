@@ -26,6 +26,7 @@ func (c *Client) CmdFrontend() *cobra.Command {
 	cmd.AddCommand(
 		c.CmdFrontendList(),
 		c.CmdFrontendReset(),
+		c.CmdFrontendResize(),
 		c.CmdFrontendSet(),
 	)
 
@@ -48,8 +49,8 @@ func (c *Client) CmdFrontends() *cobra.Command {
 			}
 
 			f := formatter{
-				header: []string{"IMAGE NAME", "SIZE", "MEMORY (MB)", "CPU"},
-				fields: []string{"Image", "Size", "MemoryMB", "Cpu"},
+				header: []string{"IMAGE NAME", "TYPE", "SIZE", "MEMORY (MB)", "CPU"},
+				fields: []string{"Image", "Type", "Size", "MemoryMB", "Cpu"},
 			}
 
 			var elements []formatElement
@@ -64,11 +65,13 @@ func (c *Client) CmdFrontends() *cobra.Command {
 				}
 				elements = append(elements, struct {
 					Image    string
+					Type     string
 					Size     int64
 					MemoryMB string
 					Cpu      string
 				}{
 					Image:    f.Image,
+					Type:     f.Type,
 					Size:     f.Size,
 					MemoryMB: memoryStr,
 					Cpu:      cpuStr,
@@ -141,6 +144,61 @@ func (c *Client) CmdFrontendReset() *cobra.Command {
 	return cmd
 }
 
+func (c *Client) CmdFrontendResize() *cobra.Command {
+	var (
+		teamIds  []string
+		teams    []*pb.Team
+		memoryMB int64
+		cpu      float32
+	)
+
+	cmd := &cobra.Command{
+		Use:     "resize [event tag] [image]",
+		Short:   "Resize the running frontends of an event",
+		Long:    "Resize the running frontends of an event, each frontend is restarted to apply the change. Use -t for specifying certain teams only.",
+		Example: `hkn frontend resize demo kali --memory 4096 --cpu 1.5`,
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			for _, t := range teamIds {
+				teams = append(teams, &pb.Team{Id: t})
+			}
+
+			stream, err := c.rpcClient.ResizeFrontends(ctx, &pb.ResizeFrontendsRequest{
+				EventTag: args[0],
+				Image:    args[1],
+				MemoryMB: memoryMB,
+				Cpu:      cpu,
+				Teams:    teams,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			for {
+				msg, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+
+				if err != nil {
+					PrintError(err)
+					return
+				}
+
+				fmt.Printf("[%s] %s\n", msg.Status, msg.TeamId)
+			}
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&teamIds, "teams", "t", nil, "list of team ids for which to resize their frontends")
+	cmd.Flags().Int64VarP(&memoryMB, "memory", "m", 0, "memory (in MB) of the frontends")
+	cmd.Flags().Float32VarP(&cpu, "cpu", "c", 0, "number of CPUs of the frontends, fractions limit the execution cap")
+
+	return cmd
+}
+
 func (c *Client) CmdFrontendSet() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set",
@@ -11,6 +11,7 @@ import (
 	color "github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
 	"io"
+	"time"
 )
 
 func (c *Client) CmdHost() *cobra.Command {
@@ -22,6 +23,7 @@ func (c *Client) CmdHost() *cobra.Command {
 
 	cmd.AddCommand(
 		c.CmdHostMonitor(),
+		c.CmdHostWorkers(),
 	)
 
 	return cmd
@@ -100,3 +102,54 @@ func (c *Client) CmdHostMonitor() *cobra.Command {
 
 	return cmd
 }
+
+func (c *Client) CmdHostWorkers() *cobra.Command {
+	return &cobra.Command{
+		Use:     "workers",
+		Short:   "List workers which labs are scheduled on",
+		Example: `hkn host workers`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListWorkers(ctx, &pb.Empty{})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"NAME", "ADDRESS", "LABS", "CPU", "MEMORY", "AVAILABLE (MB)", "ERROR"},
+				fields: []string{"Name", "Address", "Labs", "Cpu", "Memory", "MemoryAvailableMB", "Error"},
+			}
+
+			var elements []formatElement
+			for _, w := range r.Workers {
+				elements = append(elements, struct {
+					Name              string
+					Address           string
+					Labs              int32
+					Cpu               string
+					Memory            string
+					MemoryAvailableMB int64
+					Error             string
+				}{
+					Name:              w.Name,
+					Address:           w.Address,
+					Labs:              w.Labs,
+					Cpu:               fmt.Sprintf("%.1f%%", w.CpuPercent),
+					Memory:            fmt.Sprintf("%.1f%%", w.MemoryPercent),
+					MemoryAvailableMB: w.MemoryAvailableMB,
+					Error:             w.Error,
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
@@ -62,6 +62,7 @@ func Execute() {
 		c.CmdFrontends(),
 		c.CmdHost(),
 		c.CmdTeam(),
+		c.CmdAudit(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -6,13 +6,22 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
+	"errors"
 	"fmt"
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"strings"
 	"time"
 )
 
+var (
+	InvalidTeamsFileErr = errors.New("teams file must have a name and an email in each row")
+)
+
 func (c *Client) CmdTeam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "team",
@@ -22,6 +31,11 @@ func (c *Client) CmdTeam() *cobra.Command {
 
 	cmd.AddCommand(
 		c.CmdTeamInfo(),
+		c.CmdTeamCreate(),
+		c.CmdTeamRename(),
+		c.CmdTeamDelete(),
+		c.CmdTeamPassword(),
+		c.CmdTeamMove(),
 	)
 
 	return cmd
@@ -104,3 +118,194 @@ func (c *Client) CmdTeamInfo() *cobra.Command {
 
 	return cmd
 }
+
+// readTeamsFile reads rows of team name and email, a header row is skipped
+func readTeamsFile(r io.Reader) ([]*pb.CreateTeamsRequest_Team, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*pb.CreateTeamsRequest_Team
+	for i, row := range rows {
+		if len(row) < 2 {
+			return nil, InvalidTeamsFileErr
+		}
+
+		if i == 0 && strings.EqualFold(row[0], "name") && strings.EqualFold(row[1], "email") {
+			continue
+		}
+
+		teams = append(teams, &pb.CreateTeamsRequest_Team{
+			Name:  row[0],
+			Email: row[1],
+		})
+	}
+
+	return teams, nil
+}
+
+func (c *Client) CmdTeamCreate() *cobra.Command {
+	return &cobra.Command{
+		Use:     "create [event tag] [csv file]",
+		Short:   "Create teams with generated passwords from a file of names and emails",
+		Example: "hkn team create test-event teams.csv",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+			defer cancel()
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				PrintError(err)
+				return
+			}
+			defer file.Close()
+
+			teams, err := readTeamsFile(file)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			resp, err := c.rpcClient.CreateTeams(ctx, &pb.CreateTeamsRequest{
+				EventTag: args[0],
+				Teams:    teams,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"NAME", "EMAIL", "ID", "PASSWORD", "ERROR"},
+				fields: []string{"Name", "Email", "Id", "Password", "Error"},
+			}
+
+			var elements []formatElement
+			for _, t := range resp.Teams {
+				elements = append(elements, struct {
+					Name     string
+					Email    string
+					Id       string
+					Password string
+					Error    string
+				}{
+					Name:     t.Name,
+					Email:    t.Email,
+					Id:       t.Id,
+					Password: t.Password,
+					Error:    t.Error,
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
+
+func (c *Client) CmdTeamRename() *cobra.Command {
+	return &cobra.Command{
+		Use:     "rename [team id] [event tag] [name]",
+		Short:   "Change the name of a team",
+		Example: "hkn team rename azbu29c1 test-event \"new name\"",
+		Args:    cobra.MinimumNArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			_, err := c.rpcClient.RenameTeam(ctx, &pb.RenameTeamRequest{
+				TeamId:   args[0],
+				EventTag: args[1],
+				Name:     args[2],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+}
+
+func (c *Client) CmdTeamDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete [team id] [event tag]",
+		Short:   "Delete a team and release its lab",
+		Example: "hkn team delete azbu29c1 test-event",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+
+			_, err := c.rpcClient.DeleteTeam(ctx, &pb.DeleteTeamRequest{
+				TeamId:   args[0],
+				EventTag: args[1],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+}
+
+func (c *Client) CmdTeamPassword() *cobra.Command {
+	var password string
+
+	cmd := &cobra.Command{
+		Use:     "password [team id] [event tag]",
+		Short:   "Reset the password of a team, a password is generated unless given",
+		Example: "hkn team password azbu29c1 test-event",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			resp, err := c.rpcClient.ResetTeamPassword(ctx, &pb.ResetTeamPasswordRequest{
+				TeamId:   args[0],
+				EventTag: args[1],
+				Password: password,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Printf("New password: %s\n", resp.Password)
+		},
+	}
+
+	cmd.Flags().StringVarP(&password, "password", "p", "", "password to set for the team")
+
+	return cmd
+}
+
+func (c *Client) CmdTeamMove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "move [team id] [event tag]",
+		Short:   "Assign a fresh lab to a team, releasing its current lab",
+		Example: "hkn team move azbu29c1 test-event",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+
+			_, err := c.rpcClient.MoveTeamLab(ctx, &pb.MoveTeamLabRequest{
+				TeamId:   args[0],
+				EventTag: args[1],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+}
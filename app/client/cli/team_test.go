@@ -0,0 +1,46 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadTeamsFile(t *testing.T) {
+	tt := []struct {
+		name  string
+		input string
+		teams []string
+		err   error
+	}{
+		{name: "Normal", input: "alpha,alpha@example.com\nbravo, bravo@example.com\n", teams: []string{"alpha", "bravo"}},
+		{name: "Header", input: "name,email\nalpha,alpha@example.com\n", teams: []string{"alpha"}},
+		{name: "Missing email", input: "alpha\n", err: InvalidTeamsFileErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			teams, err := readTeamsFile(strings.NewReader(tc.input))
+			if err != tc.err {
+				t.Fatalf("unexpected error (expected: %v): %v", tc.err, err)
+			}
+
+			if len(teams) != len(tc.teams) {
+				t.Fatalf("expected %d teams, got %d", len(tc.teams), len(teams))
+			}
+
+			for i, team := range teams {
+				if team.Name != tc.teams[i] {
+					t.Fatalf("expected team %s, got %s", tc.teams[i], team.Name)
+				}
+
+				if !strings.HasSuffix(team.Email, "@example.com") {
+					t.Fatalf("unexpected email of team: %s", team.Email)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
@@ -16,7 +17,8 @@ import (
 )
 
 var (
-	PasswordsNoMatchErr = errors.New("Passwords do not match, so cancelling signup :-(")
+	PasswordsNoMatchErr  = errors.New("Passwords do not match, so cancelling signup :-(")
+	UnableCreateUListErr = errors.New("Failed to create users list")
 )
 
 func (c *Client) CmdUser() *cobra.Command {
@@ -28,6 +30,13 @@ func (c *Client) CmdUser() *cobra.Command {
 
 	cmd.AddCommand(
 		c.CmdInviteUser(),
+		c.CmdUserRoles(),
+		c.CmdUserList(),
+		c.CmdUserDelete(),
+		c.CmdUserPasswd(),
+		c.CmdUserRevoke(),
+		c.CmdSignupKeys(),
+		c.CmdSignupKeyRevoke(),
 		c.CmdSignupUser(),
 		c.CmdLoginUser())
 
@@ -35,16 +44,23 @@ func (c *Client) CmdUser() *cobra.Command {
 }
 
 func (c *Client) CmdInviteUser() *cobra.Command {
-	var superUser bool
+	var (
+		superUser bool
+		roles     []string
+	)
 	cmd := &cobra.Command{
-		Use:     "invite",
-		Short:   "Create key for inviting other users (superuser only)",
-		Example: `hkn user invite --superuser`,
+		Use:   "invite",
+		Short: "Create key for inviting other users (superuser only)",
+		Example: `hkn user invite --superuser
+hkn user invite --roles event-manager,observer`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
 
-			r, err := c.rpcClient.InviteUser(ctx, &pb.InviteUserRequest{SuperUser: superUser})
+			r, err := c.rpcClient.InviteUser(ctx, &pb.InviteUserRequest{
+				SuperUser: superUser,
+				Roles:     roles,
+			})
 			if err != nil {
 				PrintError(err)
 				return
@@ -60,9 +76,37 @@ func (c *Client) CmdInviteUser() *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&superUser, "super-user", "s", false, "indicates if the signup key will create a super user")
+	cmd.Flags().StringSliceVarP(&roles, "roles", "r", nil, "roles given to the user (admin, event-manager, observer, exercise-author)")
 	return cmd
 }
 
+func (c *Client) CmdUserRoles() *cobra.Command {
+	return &cobra.Command{
+		Use:     "roles [username] [roles...]",
+		Short:   "Set the roles of a user (superuser only)",
+		Example: `hkn user roles alice event-manager observer`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.SetUserRoles(ctx, &pb.SetUserRolesRequest{
+				Username: args[0],
+				Roles:    args[1:],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if r.Error != "" {
+				PrintError(fmt.Errorf(r.Error))
+				return
+			}
+		},
+	}
+}
+
 func (c *Client) CmdSignupUser() *cobra.Command {
 	return &cobra.Command{
 		Use:     "signup",
@@ -160,3 +204,240 @@ func (c *Client) CmdLoginUser() *cobra.Command {
 		},
 	}
 }
+
+type userElement struct {
+	Username  string
+	SuperUser bool
+	Roles     string
+	CreatedAt string
+}
+
+func (c *Client) CmdUserList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List users (superuser only)",
+		Example: `hkn user list`,
+		Aliases: []string{"ls"},
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListUsers(ctx, &pb.Empty{})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"USERNAME", "SUPER USER", "ROLES", "CREATED AT"},
+				fields: []string{"Username", "SuperUser", "Roles", "CreatedAt"},
+			}
+
+			var elements []formatElement
+			for _, u := range r.Users {
+				elements = append(elements, userElement{
+					Username:  u.Username,
+					SuperUser: u.SuperUser,
+					Roles:     strings.Join(u.Roles, ","),
+					CreatedAt: u.CreatedAt,
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateUListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
+
+func (c *Client) CmdUserDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete [username]",
+		Short:   "Delete a user (superuser only)",
+		Example: `hkn user delete alice`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.DeleteUser(ctx, &pb.DeleteUserRequest{Username: args[0]})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if r.Error != "" {
+				PrintError(fmt.Errorf(r.Error))
+				return
+			}
+		},
+	}
+}
+
+func (c *Client) CmdUserPasswd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "passwd [username]",
+		Short: "Change password of yourself or another user (superuser only)",
+		Example: `hkn user passwd
+hkn user passwd alice`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				username    string
+				oldPassword string
+				err         error
+			)
+
+			if len(args) > 0 {
+				username = args[0]
+			} else {
+				oldPassword, err = ReadSecret("Current password: ")
+				if err != nil {
+					log.Fatal("Unable to read password")
+				}
+			}
+
+			password, err := ReadSecret("New password: ")
+			if err != nil {
+				log.Fatal("Unable to read password")
+			}
+
+			password2, err := ReadSecret("New password (again): ")
+			if err != nil {
+				log.Fatal("Unable to read password")
+			}
+
+			if password != password2 {
+				PrintError(PasswordsNoMatchErr)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			r, err := c.rpcClient.ChangePassword(ctx, &pb.ChangePasswordRequest{
+				Username:    username,
+				OldPassword: oldPassword,
+				NewPassword: password,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if r.Error != "" {
+				PrintError(fmt.Errorf(r.Error))
+				return
+			}
+
+			// changing your own password revokes the current token
+			if r.Token != "" {
+				c.Token = r.Token
+				if err := c.SaveToken(); err != nil {
+					PrintError(err)
+				}
+			}
+		},
+	}
+}
+
+func (c *Client) CmdUserRevoke() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [username]",
+		Short: "Revoke all login tokens of yourself or another user (superuser only)",
+		Example: `hkn user revoke
+hkn user revoke alice`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var username string
+			if len(args) > 0 {
+				username = args[0]
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.RevokeTokens(ctx, &pb.RevokeTokensRequest{Username: username})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if r.Error != "" {
+				PrintError(fmt.Errorf(r.Error))
+				return
+			}
+		},
+	}
+}
+
+type signupKeyElement struct {
+	Key       string
+	SuperUser bool
+	Roles     string
+}
+
+func (c *Client) CmdSignupKeys() *cobra.Command {
+	return &cobra.Command{
+		Use:     "keys",
+		Short:   "List unused signup keys (superuser only)",
+		Example: `hkn user keys`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListSignupKeys(ctx, &pb.Empty{})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"KEY", "SUPER USER", "ROLES"},
+				fields: []string{"Key", "SuperUser", "Roles"},
+			}
+
+			var elements []formatElement
+			for _, k := range r.Keys {
+				elements = append(elements, signupKeyElement{
+					Key:       k.Key,
+					SuperUser: k.SuperUser,
+					Roles:     strings.Join(k.Roles, ","),
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateUListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
+
+func (c *Client) CmdSignupKeyRevoke() *cobra.Command {
+	return &cobra.Command{
+		Use:     "revoke-key [key]",
+		Short:   "Revoke an unused signup key (superuser only)",
+		Example: `hkn user revoke-key 2a4f7b1e-0c6d-4b9e-9f51-3c2d8e7a6b10`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.RevokeSignupKey(ctx, &pb.RevokeSignupKeyRequest{Key: args[0]})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if r.Error != "" {
+				PrintError(fmt.Errorf(r.Error))
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/aau-network-security/haaukins/worker"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultConfigFile = "config.yml"
+)
+
+func handleCancel(clean func() error) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		log.Info().Msgf("Shutting down gracefully...")
+		if err := clean(); err != nil {
+			log.Error().Msgf("Error while shutting down: %s", err)
+			os.Exit(1)
+		}
+		log.Info().Msgf("Closed worker")
+		os.Exit(0)
+	}()
+}
+
+func main() {
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	confFilePtr := flag.String("config", defaultConfigFile, "configuration file")
+	flag.Parse()
+
+	c, err := worker.NewConfigFromFile(*confFilePtr)
+	if err != nil {
+		fmt.Printf("unable to read configuration file \"%s\": %s\n", *confFilePtr, err)
+		return
+	}
+
+	w := worker.New(c)
+
+	handleCancel(func() error {
+		return w.Close()
+	})
+
+	if err := w.Run(); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/logging"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	auditLog = "audit"
+
+	defaultAuditLimit = 100
+	maxAuditLine      = 1 << 20
+)
+
+// withAuditEvent adds the event referenced by the request to the audit
+// logger, so the actions taken on an event can be found
+func withAuditEvent(ctx context.Context, req interface{}) context.Context {
+	tag, ok := requestEventTag(req)
+	if !ok || tag == "" {
+		return ctx
+	}
+
+	l := log.Ctx(ctx).With().Str("event-tag", string(tag)).Logger()
+	return l.WithContext(ctx)
+}
+
+// auditStream adds the event to the audit logger once the request has been
+// received, as streaming requests are not available to the interceptor.
+type auditStream struct {
+	contextStream
+}
+
+func (s *auditStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.ctx = withAuditEvent(s.ctx, m)
+	return nil
+}
+
+type auditEntry struct {
+	Time     time.Time
+	User     string
+	Method   string
+	EventTag string
+	Msg      string
+	Fields   map[string]interface{}
+}
+
+func parseAuditEntry(line []byte) (auditEntry, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return auditEntry{}, err
+	}
+
+	str := func(key string) string {
+		s, _ := fields[key].(string)
+		delete(fields, key)
+		return s
+	}
+
+	var e auditEntry
+	t, err := time.Parse(zerolog.TimeFieldFormat, str(zerolog.TimestampFieldName))
+	if err != nil {
+		return auditEntry{}, err
+	}
+
+	e.Time = t
+	e.User = str("user")
+	e.Method = str("method")
+	e.EventTag = str("event-tag")
+	e.Msg = str(zerolog.MessageFieldName)
+
+	// the level and the roles of the user are left out as noise
+	delete(fields, zerolog.LevelFieldName)
+	delete(fields, "is-super-user")
+	delete(fields, "roles")
+	e.Fields = fields
+
+	return e, nil
+}
+
+type auditQuery struct {
+	user     string
+	method   string
+	eventTag string
+	since    time.Time
+	until    time.Time
+}
+
+func (q auditQuery) matches(e auditEntry) bool {
+	if q.user != "" && q.user != e.User {
+		return false
+	}
+
+	if q.method != "" && !strings.EqualFold(q.method, e.Method) {
+		return false
+	}
+
+	if q.eventTag != "" && q.eventTag != e.EventTag {
+		return false
+	}
+
+	if !q.since.IsZero() && e.Time.Before(q.since) {
+		return false
+	}
+
+	if !q.until.IsZero() && !e.Time.Before(q.until) {
+		return false
+	}
+
+	return true
+}
+
+// queryAuditLog returns the latest entries of the audit log, including its
+// rotated backups, matching the query
+func queryAuditLog(path string, q auditQuery, limit int) ([]auditEntry, error) {
+	r, err := logging.OpenLog(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var entries []auditEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxAuditLine)
+	for scanner.Scan() {
+		e, err := parseAuditEntry(scanner.Bytes())
+		if err != nil || !q.matches(e) {
+			continue
+		}
+
+		entries = append(entries, e)
+		if len(entries) > limit {
+			entries = entries[1:]
+		}
+	}
+
+	return entries, scanner.Err()
+}
+
+func (d *daemon) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	q := auditQuery{
+		user:     req.User,
+		method:   methodName(req.Method),
+		eventTag: req.EventTag,
+	}
+
+	var err error
+	if req.Since != "" {
+		if q.since, err = parseEventTime(req.Since); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Until != "" {
+		if q.until, err = parseEventTime(req.Until); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	}
+
+	entries, err := queryAuditLog(filepath.Join(d.conf.LogDir, auditLog+".log"), q, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbEntries []*pb.QueryAuditLogResponse_Entry
+	for _, e := range entries {
+		var fields string
+		if len(e.Fields) > 0 {
+			raw, err := json.Marshal(e.Fields)
+			if err != nil {
+				return nil, err
+			}
+			fields = string(raw)
+		}
+
+		pbEntries = append(pbEntries, &pb.QueryAuditLogResponse_Entry{
+			Time:     e.Time.Format(time.RFC3339),
+			User:     e.User,
+			Method:   e.Method,
+			EventTag: e.EventTag,
+			Msg:      e.Msg,
+			Fields:   fields,
+		})
+	}
+
+	return &pb.QueryAuditLogResponse{Entries: pbEntries}, nil
+}
@@ -17,15 +17,18 @@ import (
 )
 
 const (
-	USERNAME_KEY    = "un"
-	SUPERUSER_KEY   = "su"
-	VALID_UNTIL_KEY = "vu"
+	USER_ID_KEY          = "id"
+	USERNAME_KEY         = "un"
+	SUPERUSER_KEY        = "su"
+	VALID_UNTIL_KEY      = "vu"
+	TOKEN_GENERATION_KEY = "tg"
 )
 
 var (
 	InvalidUsernameOrPassErr = errors.New("Invalid username or password")
 	InvalidTokenFormatErr    = errors.New("Invalid token format")
 	TokenExpiredErr          = errors.New("Token has expired")
+	TokenRevokedErr          = errors.New("Token has been revoked")
 	UnknownUserErr           = errors.New("Unknown user")
 	EmptyUserErr             = errors.New("Username cannot be empty")
 	EmptyPasswdErr           = errors.New("Password cannot be empty")
@@ -71,9 +74,11 @@ func (a *auth) TokenForUser(username, password string) (string, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		USERNAME_KEY:    u.Username,
-		SUPERUSER_KEY:   u.SuperUser,
-		VALID_UNTIL_KEY: time.Now().Add(31 * 24 * time.Hour).Unix(),
+		USER_ID_KEY:          u.Id,
+		USERNAME_KEY:         u.Username,
+		SUPERUSER_KEY:        u.SuperUser,
+		VALID_UNTIL_KEY:      time.Now().Add(31 * 24 * time.Hour).Unix(),
+		TOKEN_GENERATION_KEY: u.TokenGeneration,
 	})
 
 	tokenString, err := token.SignedString([]byte(a.key))
@@ -134,6 +139,18 @@ func (a *auth) AuthenticateContext(ctx context.Context) (context.Context, error)
 		return ctx, TokenExpiredErr
 	}
 
+	// tokens issued before generations and ids were introduced have
+	// none, just like the users created back then
+	generation, _ := claims[TOKEN_GENERATION_KEY].(float64)
+	if int(generation) != u.TokenGeneration {
+		return ctx, TokenRevokedErr
+	}
+
+	id, _ := claims[USER_ID_KEY].(string)
+	if id != u.Id {
+		return ctx, TokenRevokedErr
+	}
+
 	ctx = context.WithValue(ctx, us{}, u)
 
 	return ctx, nil
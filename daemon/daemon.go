@@ -9,10 +9,12 @@ import (
 	"fmt"
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
 	"github.com/aau-network-security/haaukins/event"
+	"github.com/aau-network-security/haaukins/lab"
 	"github.com/aau-network-security/haaukins/logging"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
+	"github.com/aau-network-security/haaukins/worker"
 	dockerclient "github.com/fsouza/go-dockerclient"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -43,9 +45,16 @@ var (
 	InvalidArgumentsErr = errors.New("Invalid arguments provided")
 	UnknownTeamErr      = errors.New("Unable to find team by that id")
 	GrpcOptsErr         = errors.New("failed to retrieve server options")
-  NoLabByTeamIdErr    = errors.New("Lab is nil, no lab found for given team id ! ")
-	
-  version string
+	InvalidTimeErr      = errors.New("Invalid time format, expected YYYY-MM-DD or YYYY-MM-DD HH:MM")
+	NoLabByTeamIdErr    = errors.New("Lab is nil, no lab found for given team id ! ")
+	DeleteSelfErr       = errors.New("Unable to delete the user you are logged in as")
+	IncorrectPasswdErr  = errors.New("Incorrect password")
+	EventSuspendedErr   = errors.New("Event is already suspended")
+	EventRunningErr     = errors.New("Event is not suspended")
+	NoResizeErr         = errors.New("Either memory or cpu needs to be specified")
+	NegativeMemoryErr   = errors.New("Memory cannot be negative")
+
+	version string
 )
 
 const (
@@ -53,6 +62,8 @@ const (
 	displayTimeFormat = "2006-01-02 15:04:05"
 )
 
+var eventTimeFormats = []string{"2006-01-02 15:04", "2006-01-02"}
+
 type MissingConfigErr struct {
 	Option string
 }
@@ -71,12 +82,14 @@ func (m *MngtPortErr) Error() string {
 
 type Config struct {
 	Host struct {
-		Http string `yaml:"http,omitempty"`
-		Grpc string `yaml:"grpc,omitempty"`
+		Http    string `yaml:"http,omitempty"`
+		Grpc    string `yaml:"grpc,omitempty"`
+		Metrics string `yaml:"metrics,omitempty"`
 	} `yaml:"host,omitempty"`
 	Port struct {
 		Secure   uint `yaml:"secure,omitempty"`
 		InSecure uint `yaml:"insecure,omitempty"`
+		Metrics  uint `yaml:"metrics,omitempty"`
 	}
 	UsersFile          string                           `yaml:"users-file,omitempty"`
 	ExercisesFile      string                           `yaml:"exercises-file,omitempty"`
@@ -84,14 +97,24 @@ type Config struct {
 	OvaDir             string                           `yaml:"ova-directory,omitempty"`
 	LogDir             string                           `yaml:"log-directory,omitempty"`
 	EventsDir          string                           `yaml:"events-directory,omitempty"`
+	EventsDB           string                           `yaml:"events-database,omitempty"`
 	DockerRepositories []dockerclient.AuthConfiguration `yaml:"docker-repositories,omitempty"`
 	SigningKey         string                           `yaml:"sign-key,omitempty"`
+	Workers            []worker.Remote                  `yaml:"workers,omitempty"`
 	TLS                struct {
 		Enabled   bool   `yaml:"enabled"`
 		Directory string `yaml:"directory"`
-		CertFile string `yaml:"certfile"`
-		CertKey string `yaml:"certkey"`
+		CertFile  string `yaml:"certfile"`
+		CertKey   string `yaml:"certkey"`
 	} `yaml:"tls,omitempty"`
+	ExercisesWatch struct {
+		Enabled   bool `yaml:"enabled"`
+		AutoApply bool `yaml:"auto-apply"`
+	} `yaml:"exercises-watch,omitempty"`
+	AuditLog struct {
+		MaxSizeMB  int `yaml:"max-size-mb"`
+		MaxBackups int `yaml:"max-backups"`
+	} `yaml:"audit-log,omitempty"`
 }
 
 func NewConfigFromFile(path string) (*Config, error) {
@@ -130,6 +153,12 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.Port.Secure = 443
 	}
 
+	// metrics are only served if a port is given, and only locally
+	// unless told otherwise
+	if c.Host.Metrics == "" {
+		c.Host.Metrics = "127.0.0.1"
+	}
+
 	if c.OvaDir == "" {
 		dir, _ := os.Getwd()
 		c.OvaDir = filepath.Join(dir, "vbox")
@@ -140,6 +169,14 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.LogDir = filepath.Join(dir, "logs")
 	}
 
+	if c.AuditLog.MaxSizeMB == 0 {
+		c.AuditLog.MaxSizeMB = 10
+	}
+
+	if c.AuditLog.MaxBackups == 0 {
+		c.AuditLog.MaxBackups = 10
+	}
+
 	if c.UsersFile == "" {
 		c.UsersFile = "users.yml"
 	}
@@ -170,15 +207,21 @@ func NewConfigFromFile(path string) (*Config, error) {
 }
 
 type daemon struct {
-	conf      *Config
-	auth      Authenticator
-	users     store.UsersFile
-	exercises store.ExerciseStore
-	eventPool *eventPool
-	frontends store.FrontendStore
-	ehost     event.Host
-	logPool   logging.Pool
-	closers   []io.Closer
+	conf       *Config
+	auth       Authenticator
+	users      store.UsersFile
+	exercises  store.ExerciseStore
+	eventPool  *eventPool
+	eventFiles store.EventFileHub
+	scheduler  scheduler
+	frontends  store.FrontendStore
+	ehost      event.Host
+	workers    *worker.Scheduler
+	logPool    logging.Pool
+	closers    []io.Closer
+
+	// guards exercises, which is replaced when the exercises file is updated
+	exercisesLock sync.RWMutex
 }
 
 func New(conf *Config) (*daemon, error) {
@@ -219,40 +262,110 @@ func New(conf *Config) (*daemon, error) {
 		}
 	}
 
-	efh, err := store.NewEventFileHub(conf.EventsDir)
+	logPool, err := logging.NewPool(conf.LogDir)
 	if err != nil {
 		return nil, err
 	}
+	closers := []io.Closer{logPool, eventPool}
 
-	logPool, err := logging.NewPool(conf.LogDir)
-	if err != nil {
-		return nil, err
+	var efh store.EventFileHub
+	if conf.EventsDB != "" {
+		dbh, err := store.NewEventDBHub(conf.EventsDB, conf.EventsDir)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("unable to open events database: %s", conf.EventsDB))
+		}
+
+		n, err := dbh.ImportEventFiles(conf.EventsDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to import event files into database")
+		}
+		if n > 0 {
+			log.Info().Int("amount", n).Msg("Imported event files into database")
+		}
+
+		efh = dbh
+		closers = append(closers, dbh)
+	} else {
+		efh, err = store.NewEventFileHub(conf.EventsDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// labs are only scheduled when workers are configured, a nil
+	// scheduler creates every lab on this host
+	var sched lab.Scheduler
+	var workers *worker.Scheduler
+	if len(conf.Workers) > 0 {
+		workers, err = worker.NewScheduler(conf.Workers)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to connect to workers")
+		}
+		sched = workers
+		closers = append(closers, workers)
 	}
 
 	d := &daemon{
-		conf:      conf,
-		auth:      NewAuthenticator(uf, conf.SigningKey),
-		users:     uf,
-		exercises: ef,
-		eventPool: eventPool,
-		frontends: ff,
-		ehost:     event.NewHost(vlib, ef, efh),
-		logPool:   logPool,
-		closers:   []io.Closer{logPool, eventPool},
+		conf:       conf,
+		auth:       NewAuthenticator(uf, conf.SigningKey),
+		users:      uf,
+		exercises:  ef,
+		eventPool:  eventPool,
+		eventFiles: efh,
+		frontends:  ff,
+		ehost:      event.NewHost(vlib, ef, efh, sched),
+		workers:    workers,
+		logPool:    logPool,
+	}
+	d.closers = append([]io.Closer{&d.scheduler}, closers...)
+
+	if conf.ExercisesWatch.Enabled {
+		w, err := d.watchExercisesFile(conf.ExercisesWatch.AutoApply)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("unable to watch exercises file: %s", conf.ExercisesFile))
+		}
+		d.closers = append(d.closers, w)
 	}
 
 	eventFiles, err := efh.GetUnfinishedEvents()
 	if err != nil {
 		return nil, err
 	}
+	if err := d.restoreEvents(eventFiles); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// restoreEvents starts the unfinished events again, events which should
+// have finished while the daemon was stopped are archived right away
+func (d *daemon) restoreEvents(eventFiles []store.EventFile) error {
+	now := time.Now()
 	for _, ef := range eventFiles {
+		conf := ef.Read()
+		if conf.FinishExpected != nil && !conf.FinishExpected.After(now) {
+			log.Info().Str("tag", string(conf.Tag)).Msg("Archiving event which finished while stopped")
+			if err := finishEventFile(ef, *conf.FinishExpected); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if conf.StartedAt == nil && conf.StartAt != nil && conf.StartAt.After(now) {
+			d.scheduleStart(ef)
+			d.scheduleFinish(conf)
+			continue
+		}
+
 		err := d.createEventFromEventFile(context.Background(), ef)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		d.scheduleFinish(conf)
 	}
 
-	return d, nil
+	return nil
 }
 
 type contextStream struct {
@@ -264,39 +377,45 @@ func (s *contextStream) Context() context.Context {
 	return s.ctx
 }
 
-func withAuditLogger(ctx context.Context, logger *zerolog.Logger) context.Context {
+func withAuditLogger(ctx context.Context, logger *zerolog.Logger, fullMethod string) context.Context {
 	if logger == nil {
 		return ctx
 	}
 
-	u, ok := ctx.Value(us{}).(store.User)
-	if !ok {
-		return logger.WithContext(ctx)
+	lc := logger.With().Str("method", methodName(fullMethod))
+	if u, ok := ctx.Value(us{}).(store.User); ok {
+		lc = lc.
+			Str("user", u.Username).
+			Bool("is-super-user", u.SuperUser).
+			Interface("roles", u.GetRoles())
 	}
 
-	ls := logger.With().
-		Str("user", u.Username).
-		Bool("is-super-user", u.SuperUser).
-		Logger()
-	logger = &ls
-
-	return logger.WithContext(ctx)
+	ls := lc.Logger()
+	return ls.WithContext(ctx)
 }
 
 func (d *daemon) GetServer(opts ...grpc.ServerOption) *grpc.Server {
 	nonAuth := []string{"LoginUser", "SignupUser"}
 	var logger *zerolog.Logger
 	if d.logPool != nil {
-		logger, _ = d.logPool.GetLogger("audit")
-		l := *logger
-		l = l.With().Timestamp().Logger()
-		logger = &l
+		maxSize := int64(d.conf.AuditLog.MaxSizeMB) << 20
+		l, err := d.logPool.GetLogger(auditLog, logging.WithRotation(maxSize, d.conf.AuditLog.MaxBackups))
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to open audit log")
+		} else {
+			ll := l.With().Timestamp().Logger()
+			logger = &ll
+		}
 	}
 
-	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func(started time.Time) {
+			observeCall(info.FullMethod, started, err)
+		}(time.Now())
+
 		ctx, authErr := d.auth.AuthenticateContext(stream.Context())
-		ctx = withAuditLogger(ctx, logger)
-		stream = &contextStream{stream, ctx}
+		ctx = withAuditLogger(ctx, logger, info.FullMethod)
+		stream = &auditStream{contextStream{stream, ctx}}
 
 		header := metadata.Pairs("daemon-version", version)
 		stream.SendHeader(header)
@@ -311,12 +430,26 @@ func (d *daemon) GetServer(opts ...grpc.ServerOption) *grpc.Server {
 			return authErr
 		}
 
+		p := getPermission(info.FullMethod)
+		if err := checkRoles(ctx, p); err != nil {
+			return err
+		}
+
+		if p.owned {
+			stream = &ownershipStream{stream, d}
+		}
+
 		return handler(srv, stream)
 	}
 
-	unaryInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	unaryInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func(started time.Time) {
+			observeCall(info.FullMethod, started, err)
+		}(time.Now())
+
 		ctx, authErr := d.auth.AuthenticateContext(ctx)
-		ctx = withAuditLogger(ctx, logger)
+		ctx = withAuditLogger(ctx, logger, info.FullMethod)
+		ctx = withAuditEvent(ctx, req)
 
 		header := metadata.Pairs("daemon-version", version)
 		grpc.SendHeader(ctx, header)
@@ -331,6 +464,17 @@ func (d *daemon) GetServer(opts ...grpc.ServerOption) *grpc.Server {
 			return nil, authErr
 		}
 
+		p := getPermission(info.FullMethod)
+		if err := checkRoles(ctx, p); err != nil {
+			return nil, err
+		}
+
+		if p.owned {
+			if err := d.checkOwnership(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+
 		return handler(ctx, req)
 	}
 
@@ -373,6 +517,8 @@ func (d *daemon) SignupUser(ctx context.Context, req *pb.SignupUserRequest) (*pb
 	if k.WillBeSuperUser {
 		u.SuperUser = true
 	}
+	u.Roles = k.Roles
+	u.RolesSet = k.RolesSet
 
 	if err := d.users.CreateUser(u); err != nil {
 		return &pb.LoginUserResponse{Error: err.Error()}, nil
@@ -394,16 +540,25 @@ func (d *daemon) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb
 	log.Ctx(ctx).Info().Msg("invite user")
 
 	u, _ := ctx.Value(us{}).(store.User)
-	if !u.SuperUser {
+	if !u.HasRole(store.RoleAdmin) {
 		return &pb.InviteUserResponse{
 			Error: "This action requires super user permissions",
 		}, nil
 	}
 
+	roles, err := parseRoles(req.Roles)
+	if err != nil {
+		return &pb.InviteUserResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
 	k := store.NewSignupKey()
 	if req.SuperUser {
 		k.WillBeSuperUser = true
 	}
+	k.Roles = roles
+	k.RolesSet = true
 
 	if err := d.users.CreateSignupKey(k); err != nil {
 		return &pb.InviteUserResponse{
@@ -416,8 +571,205 @@ func (d *daemon) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb
 	}, nil
 }
 
-//READS CONFIG FILE PROPERLY AND CALLS THE FUNCTION WHICH IS RESPONSIBLE TO CREATE EVENT
+func (d *daemon) SetUserRoles(ctx context.Context, req *pb.SetUserRolesRequest) (*pb.SetUserRolesResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("username", req.Username).
+		Strs("roles", req.Roles).
+		Msg("set user roles")
+
+	roles, err := parseRoles(req.Roles)
+	if err != nil {
+		return &pb.SetUserRolesResponse{Error: err.Error()}, nil
+	}
+
+	u, err := d.users.GetUserByUsername(strings.ToLower(req.Username))
+	if err != nil {
+		return &pb.SetUserRolesResponse{Error: err.Error()}, nil
+	}
+
+	u.Roles = roles
+	u.RolesSet = true
+	if err := d.users.UpdateUser(u); err != nil {
+		return &pb.SetUserRolesResponse{Error: err.Error()}, nil
+	}
+
+	return &pb.SetUserRolesResponse{}, nil
+}
+
+func (d *daemon) ListUsers(ctx context.Context, req *pb.Empty) (*pb.ListUsersResponse, error) {
+	var users []*pb.ListUsersResponse_User
+	for _, u := range d.users.ListUsers() {
+		var roles []string
+		for _, r := range u.Roles {
+			roles = append(roles, string(r))
+		}
+
+		users = append(users, &pb.ListUsersResponse_User{
+			Username:  u.Username,
+			SuperUser: u.SuperUser,
+			Roles:     roles,
+			CreatedAt: u.CreatedAt.Format(displayTimeFormat),
+		})
+	}
+
+	return &pb.ListUsersResponse{Users: users}, nil
+}
+
+func (d *daemon) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("username", req.Username).
+		Msg("delete user")
+
+	username := strings.ToLower(req.Username)
+	u, _ := ctx.Value(us{}).(store.User)
+	if u.Username == username {
+		return &pb.DeleteUserResponse{Error: DeleteSelfErr.Error()}, nil
+	}
+
+	if err := d.users.DeleteUserByUsername(username); err != nil {
+		return &pb.DeleteUserResponse{Error: err.Error()}, nil
+	}
+
+	return &pb.DeleteUserResponse{}, nil
+}
+
+// ChangePassword changes the password of the given user (or the caller if
+// empty), which revokes all of the tokens previously issued to the user.
+func (d *daemon) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.LoginUserResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("username", req.Username).
+		Msg("change password")
+
+	caller, _ := ctx.Value(us{}).(store.User)
+	username := strings.ToLower(req.Username)
+	if username == "" {
+		username = caller.Username
+	}
+
+	self := username == caller.Username
+	if !self && !caller.HasRole(store.RoleAdmin) {
+		return &pb.LoginUserResponse{Error: (&PermissionErr{}).Error()}, nil
+	}
+
+	u, err := d.users.GetUserByUsername(username)
+	if err != nil {
+		return &pb.LoginUserResponse{Error: err.Error()}, nil
+	}
+
+	if self && !u.IsCorrectPassword(req.OldPassword) {
+		return &pb.LoginUserResponse{Error: IncorrectPasswdErr.Error()}, nil
+	}
+
+	if err := u.SetPassword(req.NewPassword); err != nil {
+		return &pb.LoginUserResponse{Error: err.Error()}, nil
+	}
+	u.RevokeTokens()
+
+	if err := d.users.UpdateUser(u); err != nil {
+		return &pb.LoginUserResponse{Error: err.Error()}, nil
+	}
+
+	if !self {
+		return &pb.LoginUserResponse{}, nil
+	}
+
+	token, err := d.auth.TokenForUser(username, req.NewPassword)
+	if err != nil {
+		return &pb.LoginUserResponse{Error: err.Error()}, nil
+	}
+
+	return &pb.LoginUserResponse{Token: token}, nil
+}
+
+func (d *daemon) RevokeTokens(ctx context.Context, req *pb.RevokeTokensRequest) (*pb.RevokeTokensResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("username", req.Username).
+		Msg("revoke tokens")
+
+	caller, _ := ctx.Value(us{}).(store.User)
+	username := strings.ToLower(req.Username)
+	if username == "" {
+		username = caller.Username
+	}
+
+	if username != caller.Username && !caller.HasRole(store.RoleAdmin) {
+		return &pb.RevokeTokensResponse{Error: (&PermissionErr{}).Error()}, nil
+	}
+
+	u, err := d.users.GetUserByUsername(username)
+	if err != nil {
+		return &pb.RevokeTokensResponse{Error: err.Error()}, nil
+	}
+
+	u.RevokeTokens()
+	if err := d.users.UpdateUser(u); err != nil {
+		return &pb.RevokeTokensResponse{Error: err.Error()}, nil
+	}
+
+	return &pb.RevokeTokensResponse{}, nil
+}
+
+func (d *daemon) ListSignupKeys(ctx context.Context, req *pb.Empty) (*pb.ListSignupKeysResponse, error) {
+	var keys []*pb.ListSignupKeysResponse_SignupKey
+	for _, k := range d.users.ListSignupKeys() {
+		var roles []string
+		for _, r := range k.Roles {
+			roles = append(roles, string(r))
+		}
+
+		keys = append(keys, &pb.ListSignupKeysResponse_SignupKey{
+			Key:       k.Value,
+			SuperUser: k.WillBeSuperUser,
+			Roles:     roles,
+		})
+	}
+
+	return &pb.ListSignupKeysResponse{Keys: keys}, nil
+}
+
+func (d *daemon) RevokeSignupKey(ctx context.Context, req *pb.RevokeSignupKeyRequest) (*pb.RevokeSignupKeyResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("key", req.Key).
+		Msg("revoke signup key")
+
+	k, err := d.users.GetSignupKey(req.Key)
+	if err != nil {
+		return &pb.RevokeSignupKeyResponse{Error: err.Error()}, nil
+	}
+
+	if err := d.users.DeleteSignupKey(k); err != nil {
+		return &pb.RevokeSignupKeyResponse{Error: err.Error()}, nil
+	}
+
+	return &pb.RevokeSignupKeyResponse{}, nil
+}
+
+func parseRoles(in []string) ([]store.Role, error) {
+	var roles []store.Role
+	for _, s := range in {
+		r, err := store.NewRole(s)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, r)
+	}
+
+	return roles, nil
+}
+
+// READS CONFIG FILE PROPERLY AND CALLS THE FUNCTION WHICH IS RESPONSIBLE TO CREATE EVENT
 func (d *daemon) createEventFromEventFile(ctx context.Context, ef store.EventFile) error {
+	if ef.Read().StartedAt == nil {
+		if err := ef.Start(time.Now()); err != nil {
+			return err
+		}
+	}
+
 	ev, err := d.ehost.CreateEventFromEventFile(ctx, ef)
 	if err != nil {
 		log.Error().Err(err).Msg("Error creating event from file")
@@ -444,11 +796,93 @@ func (d *daemon) startEvent(ev event.Event) {
 		Strs("Frontends", frontendNames).
 		Msg("Creating event")
 
-	 ev.Start(context.TODO())
+	ev.Start(context.TODO())
+
+	// keep events suspended across restarts of the daemon
+	if conf.Suspended {
+		if err := ev.Suspend(context.TODO()); err != nil {
+			log.Warn().Err(err).Str("tag", string(conf.Tag)).Msg("Failed to suspend event")
+		}
+	}
 
 	d.eventPool.AddEvent(ev)
 }
 
+// scheduleStart keeps the event file as pending until its start time,
+// at which point the event is created from it.
+func (d *daemon) scheduleStart(ef store.EventFile) {
+	conf := ef.Read()
+	d.scheduler.addPending(ef)
+	d.scheduler.at(conf.Tag, *conf.StartAt, func() {
+		ef, ok := d.scheduler.takePending(conf.Tag)
+		if !ok {
+			return
+		}
+
+		log.Info().Str("tag", string(conf.Tag)).Msg("Starting scheduled event")
+		if err := d.createEventFromEventFile(context.Background(), ef); err != nil {
+			log.Error().Err(err).Str("tag", string(conf.Tag)).Msg("Failed to start scheduled event")
+		}
+	})
+}
+
+// scheduleFinish stops, finishes and archives the event once its
+// expected finish time has passed.
+func (d *daemon) scheduleFinish(conf store.EventConfig) {
+	if conf.FinishExpected == nil {
+		return
+	}
+
+	d.scheduler.at(conf.Tag, *conf.FinishExpected, func() {
+		log.Info().Str("tag", string(conf.Tag)).Msg("Finishing event as scheduled")
+		if err := d.stopEvent(conf.Tag); err != nil {
+			log.Error().Err(err).Str("tag", string(conf.Tag)).Msg("Failed to finish scheduled event")
+		}
+	})
+}
+
+func (d *daemon) stopEvent(tag store.Tag) error {
+	if ef, ok := d.scheduler.takePending(tag); ok {
+		d.scheduler.cancel(tag)
+		return finishEventFile(ef, time.Now())
+	}
+
+	// retrieve tag of event from event pool
+	ev, err := d.eventPool.GetEvent(tag)
+	if err != nil {
+		return err
+	}
+	// tag of the event is removed from eventPool
+	if err := d.eventPool.RemoveEvent(tag); err != nil {
+		return err
+	}
+	d.scheduler.cancel(tag)
+
+	ev.Close()
+	ev.Finish() // Finishing and archiving event....
+	return nil
+}
+
+// finishEventFile finishes and archives an event which is not running
+func finishEventFile(ef store.EventFile, t time.Time) error {
+	if err := ef.Finish(t); err != nil {
+		return err
+	}
+
+	return ef.Archive()
+}
+
+func parseEventTime(s string) (time.Time, error) {
+	for _, format := range eventTimeFormats {
+		t, err := time.ParseInLocation(format, s, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, InvalidTimeErr
+}
+
 type GrpcLogger struct {
 	resp pb.Daemon_CreateEventServer
 }
@@ -472,7 +906,9 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 		Int32("capacity", req.Capacity).
 		Strs("frontends", req.Frontends).
 		Strs("exercises", req.Exercises).
+		Str("startTime", req.StartTime).
 		Str("finishTime", req.FinishTime).
+		Str("scoring", req.GetScoring().GetMode()).
 		Msg("create event")
 	now := time.Now()
 
@@ -483,7 +919,7 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 			return err
 		}
 		// check exercise before creating event file
-		_, tagErr := d.exercises.GetExercisesByTags(t)
+		_, tagErr := d.getExercises().GetExercisesByTags(t)
 		if tagErr != nil {
 			return tagErr
 		}
@@ -491,31 +927,63 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 	}
 	evtag, _ := store.NewTag(req.Tag)
 
+	var startTime, finishTime time.Time
+	if req.StartTime != "" {
+		t, err := parseEventTime(req.StartTime)
+		if err != nil {
+			return err
+		}
+		startTime = t
+	}
+
+	if req.FinishTime != "" {
+		t, err := parseEventTime(req.FinishTime)
+		if err != nil {
+			return err
+		}
+		finishTime = t
+	}
+
+	u, _ := resp.Context().Value(us{}).(store.User)
 
-	finishTime, _ := time.Parse("2006-01-02", req.FinishTime)
-	fmt.Println(req.FinishTime)
-	fmt.Println(finishTime)
+	scoring := store.ScoringConfig{
+		Mode:            strings.ToLower(req.GetScoring().GetMode()),
+		Initial:         uint(req.GetScoring().GetInitial()),
+		Minimum:         uint(req.GetScoring().GetMinimum()),
+		Decay:           uint(req.GetScoring().GetDecay()),
+		FirstBloodBonus: uint(req.GetScoring().GetFirstBloodBonus()),
+	}
 
 	conf := store.EventConfig{
-		Name:      req.Name,
-		Tag:       evtag,
-		Available: int(req.Available),
-		Capacity:  int(req.Capacity),
-		StartedAt: &now,
+		Name:           req.Name,
+		Tag:            evtag,
+		CreatedBy:      u.Username,
+		Scoring:        scoring,
+		Available:      int(req.Available),
+		Capacity:       int(req.Capacity),
 		FinishExpected: &finishTime,
+		FlagFormat:     store.FlagFormat(req.FlagFormat),
 		Lab: store.Lab{
 			Frontends: d.frontends.GetFrontends(req.Frontends...),
 			Exercises: tags,
 		},
 	}
 
+	begin := now
+	if startTime.After(now) {
+		conf.StartAt = &startTime
+		begin = startTime
+	} else {
+		conf.StartedAt = &now
+	}
+
 	if err := conf.Validate(); err != nil {
 		return err
 	}
 
 	_, err := d.eventPool.GetEvent(evtag)
 
-	if err == nil {
+	if err == nil || d.scheduler.isPending(evtag) {
 		return DuplicateEventErr
 	}
 
@@ -527,9 +995,20 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 		conf.Capacity = 10
 	}
 
-	if conf.FinishExpected.Before(time.Now()) || conf.FinishExpected.String() == "" {
-		expectedFinishTime := now.AddDate(0,0,15)
-		conf.FinishExpected =&expectedFinishTime
+	if !conf.FinishExpected.After(begin) {
+		expectedFinishTime := begin.AddDate(0, 0, 15)
+		conf.FinishExpected = &expectedFinishTime
+	}
+
+	if conf.StartAt != nil {
+		ef, err := d.eventFiles.CreateEventFile(conf)
+		if err != nil {
+			return err
+		}
+
+		d.scheduleStart(ef)
+		d.scheduleFinish(conf)
+		return nil
 	}
 
 	loggerInstance := &GrpcLogger{resp: resp}
@@ -539,6 +1018,7 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 		return err
 	}
 	d.startEvent(ev)
+	d.scheduleFinish(conf)
 	return nil
 }
 
@@ -552,35 +1032,60 @@ func (d *daemon) StopEvent(req *pb.StopEventRequest, resp pb.Daemon_StopEventSer
 	if err != nil {
 		return err
 	}
-	// retrieve tag of event from event pool
-	ev, err := d.eventPool.GetEvent(evtag)
-	if err != nil {
-		return err
-	}
-	// tag of the event is removed from eventPool
-	if err := d.eventPool.RemoveEvent(evtag); err != nil {
-		return err
-	}
 
-	ev.Close()
-	ev.Finish() // Finishing and archiving event....
-	return nil
+	return d.stopEvent(evtag)
 }
 
-func (d *daemon) RestartTeamLab(req *pb.RestartTeamLabRequest, resp pb.Daemon_RestartTeamLabServer) error {
+func (d *daemon) SuspendEvent(req *pb.SuspendEventRequest, resp pb.Daemon_SuspendEventServer) error {
 	log.Ctx(resp.Context()).
 		Info().
-		Str("event", req.EventTag).
-		Str("lab", req.TeamId).
-		Msg("restart lab")
+		Str("tag", req.Tag).
+		Msg("suspend event")
 
-	evtag, err := store.NewTag(req.EventTag)
+	ev, err := d.eventPool.GetEvent(store.Tag(req.Tag))
 	if err != nil {
 		return err
 	}
 
-	ev, err := d.eventPool.GetEvent(evtag)
-	if err != nil {
+	if ev.GetConfig().Suspended {
+		return EventSuspendedErr
+	}
+
+	return ev.Suspend(resp.Context())
+}
+
+func (d *daemon) ResumeEvent(req *pb.ResumeEventRequest, resp pb.Daemon_ResumeEventServer) error {
+	log.Ctx(resp.Context()).
+		Info().
+		Str("tag", req.Tag).
+		Msg("resume event")
+
+	ev, err := d.eventPool.GetEvent(store.Tag(req.Tag))
+	if err != nil {
+		return err
+	}
+
+	if !ev.GetConfig().Suspended {
+		return EventRunningErr
+	}
+
+	return ev.Resume(resp.Context())
+}
+
+func (d *daemon) RestartTeamLab(req *pb.RestartTeamLabRequest, resp pb.Daemon_RestartTeamLabServer) error {
+	log.Ctx(resp.Context()).
+		Info().
+		Str("event", req.EventTag).
+		Str("lab", req.TeamId).
+		Msg("restart lab")
+
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return err
+	}
+
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
 		return err
 	}
 
@@ -597,55 +1102,98 @@ func (d *daemon) RestartTeamLab(req *pb.RestartTeamLabRequest, resp pb.Daemon_Re
 	return nil
 }
 
+func (d *daemon) SnapshotTeamLab(ctx context.Context, req *pb.SnapshotTeamLabRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Str("lab", req.TeamId).
+		Str("name", req.Name).
+		Msg("snapshot lab")
+
+	lab, err := d.getTeamLab(req.EventTag, req.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lab.Snapshot(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (d *daemon) RestoreTeamLab(ctx context.Context, req *pb.RestoreTeamLabRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Str("lab", req.TeamId).
+		Str("name", req.Name).
+		Msg("restore lab")
+
+	lab, err := d.getTeamLab(req.EventTag, req.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lab.Restore(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (d *daemon) getTeamLab(eventTag, teamId string) (lab.Lab, error) {
+	evtag, err := store.NewTag(eventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, err
+	}
+
+	l, ok := ev.GetLabByTeam(teamId)
+	if !ok {
+		return nil, NoLabByTeamIdErr
+	}
+
+	return l, nil
+}
+
 func (d *daemon) ListExercises(ctx context.Context, req *pb.Empty) (*pb.ListExercisesResponse, error) {
 	var exercises []*pb.ListExercisesResponse_Exercise
 
-	for _, e := range d.exercises.ListExercises() {
+	for _, e := range d.getExercises().ListExercises() {
 		var tags []string
 		for _, t := range e.Tags {
 			tags = append(tags, string(t))
 		}
 
 		var exercisesInfo []*pb.ListExercisesResponse_Exercise_ExerciseInfo
-		for _, e := range d.exercises.GetExercisesInfo(e.Tags[0]){
+		for _, e := range d.getExercises().GetExercisesInfo(e.Tags[0]) {
 
 			exercisesInfo = append(exercisesInfo, &pb.ListExercisesResponse_Exercise_ExerciseInfo{
-				Tag:                  string(e.Tag),
-				Name:                 e.Name,
-				Points:               int32(e.Points),
-				Category:             e.Category,
-				Description:          e.Description,
+				Tag:         string(e.Tag),
+				Name:        e.Name,
+				Points:      int32(e.Points),
+				Category:    e.Category,
+				Description: e.Description,
 			})
 		}
 
 		exercises = append(exercises, &pb.ListExercisesResponse_Exercise{
-			Name:             	  e.Name,
-			Tags:             	  tags,
-			DockerImageCount: 	  int32(len(e.DockerConfs)),
-			VboxImageCount:   	  int32(len(e.VboxConfs)),
-			Exerciseinfo:         exercisesInfo,
+			Name:             e.Name,
+			Tags:             tags,
+			DockerImageCount: int32(len(e.DockerConfs)),
+			VboxImageCount:   int32(len(e.VboxConfs)),
+			Exerciseinfo:     exercisesInfo,
 		})
 	}
 
 	return &pb.ListExercisesResponse{Exercises: exercises}, nil
 }
 
-func (d *daemon) UpdateExercisesFile(ctx context.Context, req *pb.Empty) (*pb.UpdateExercisesFileResponse, error) {
-	exercises, err := d.exercises.UpdateExercisesFile(d.conf.ExercisesFile)
-	if err != nil {
-		return nil, err
-	}
-	// update event host exercises store
-	if err := d.ehost.UpdateEventHostExercisesFile(exercises); err != nil {
-		return nil, err
-	}
-	// update daemons' exercises store
-	d.exercises = exercises
-	return &pb.UpdateExercisesFileResponse{
-		Msg: "Exercises file updated ",
-	}, nil
-
-}
 func (d *daemon) ResetExercise(req *pb.ResetExerciseRequest, stream pb.Daemon_ResetExerciseServer) error {
 	log.Ctx(stream.Context()).Info().
 		Str("evtag", req.EventTag).
@@ -695,31 +1243,127 @@ func (d *daemon) ResetExercise(req *pb.ResetExerciseRequest, stream pb.Daemon_Re
 	return nil
 }
 
+// eventExercises looks up the event and the exercises of a request
+func (d *daemon) eventExercises(req *pb.EventExercisesRequest) (event.Event, []store.Exercise, error) {
+	if len(req.Exercises) == 0 {
+		return nil, nil, InvalidArgumentsErr
+	}
+
+	var tags []store.Tag
+	for _, s := range req.Exercises {
+		t, err := store.NewTag(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	exercises, err := d.getExercises().GetExercisesByTags(tags...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ev, exercises, nil
+}
+
+func (d *daemon) AddExercisesToEvent(ctx context.Context, req *pb.EventExercisesRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Strs("exercises", req.Exercises).
+		Msg("add exercises to event")
+
+	ev, exercises, err := d.eventExercises(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ev.AddExercises(ctx, exercises...); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (d *daemon) RemoveExercisesFromEvent(ctx context.Context, req *pb.EventExercisesRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Strs("exercises", req.Exercises).
+		Msg("remove exercises from event")
+
+	ev, exercises, err := d.eventExercises(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ev.RemoveExercises(ctx, exercises...); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
 func (d *daemon) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
 	var events []*pb.ListEventsResponse_Events
 
 	for _, event := range d.eventPool.GetAllEvents() {
 		conf := event.GetConfig()
 
-		var exercises [] string
-		for _, ex := range conf.Lab.Exercises {
-			exercises = append(exercises, string(ex))
+		status := "running"
+		if conf.Suspended {
+			status = "suspended"
 		}
 
 		events = append(events, &pb.ListEventsResponse_Events{
-			Tag:           string(conf.Tag),
-			Name:          conf.Name,
-			TeamCount:     int32(len(event.GetTeams())),
-			Exercises: 	   strings.Join(exercises, ","),
-			Capacity:      int32(conf.Capacity),
-			CreationTime:  conf.StartedAt.Format(displayTimeFormat),
-			FinishTime:    conf.FinishExpected.Format(displayTimeFormat),
+			Tag:          string(conf.Tag),
+			Name:         conf.Name,
+			TeamCount:    int32(len(event.GetTeams())),
+			Exercises:    joinExercises(conf.Lab.Exercises),
+			Capacity:     int32(conf.Capacity),
+			CreationTime: conf.StartedAt.Format(displayTimeFormat),
+			FinishTime:   conf.FinishExpected.Format(displayTimeFormat),
+			Status:       status,
+		})
+	}
+
+	for _, ef := range d.scheduler.getPending() {
+		conf := ef.Read()
+
+		events = append(events, &pb.ListEventsResponse_Events{
+			Tag:          string(conf.Tag),
+			Name:         conf.Name,
+			TeamCount:    int32(len(ef.GetTeams())),
+			Exercises:    joinExercises(conf.Lab.Exercises),
+			Capacity:     int32(conf.Capacity),
+			CreationTime: conf.StartAt.Format(displayTimeFormat),
+			FinishTime:   conf.FinishExpected.Format(displayTimeFormat),
+			Status:       "scheduled",
 		})
 	}
 
 	return &pb.ListEventsResponse{Events: events}, nil
 }
 
+func joinExercises(tags []store.Tag) string {
+	var exercises []string
+	for _, ex := range tags {
+		exercises = append(exercises, string(ex))
+	}
+
+	return strings.Join(exercises, ",")
+}
+
 func (d *daemon) ListEventTeams(ctx context.Context, req *pb.ListEventTeamsRequest) (*pb.ListEventTeamsResponse, error) {
 	var eventTeams []*pb.ListEventTeamsResponse_Teams
 	evtag, err := store.NewTag(req.Tag)
@@ -748,6 +1392,310 @@ func (d *daemon) ListEventTeams(ctx context.Context, req *pb.ListEventTeamsReque
 	return &pb.ListEventTeamsResponse{Teams: eventTeams}, nil
 }
 
+func (d *daemon) GetScoreboard(ctx context.Context, req *pb.GetScoreboardRequest) (*pb.GetScoreboardResponse, error) {
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*pb.GetScoreboardResponse_TeamScore
+	for i, s := range ev.GetScoreboard() {
+		ts := &pb.GetScoreboardResponse_TeamScore{
+			Rank:     int32(i + 1),
+			TeamId:   s.TeamId,
+			TeamName: s.TeamName,
+			Points:   int32(s.Points),
+			Solves:   int32(s.Solves),
+		}
+
+		if s.LastSolve != nil {
+			ts.LastSolve = s.LastSolve.Format(displayTimeFormat)
+		}
+
+		teams = append(teams, ts)
+	}
+
+	return &pb.GetScoreboardResponse{Teams: teams}, nil
+}
+
+func (d *daemon) ListIncidents(ctx context.Context, req *pb.ListIncidentsRequest) (*pb.ListIncidentsResponse, error) {
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	var recorded []event.Incident
+	if ev, err := d.eventPool.GetEvent(evtag); err == nil {
+		recorded, err = ev.GetIncidents()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		dir, _, err := d.getArchivedEvent(req.EventTag)
+		if err != nil {
+			return nil, err
+		}
+
+		recorded, err = event.ReadIncidents(event.IncidentsPath(filepath.Join(d.conf.EventsDir, dir)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var incidents []*pb.ListIncidentsResponse_Incident
+	for _, i := range recorded {
+		// incidents of teams, rather than instances, have no state
+		var state string
+		if i.InstanceId != "" {
+			state = i.State.String()
+		}
+
+		incidents = append(incidents, &pb.ListIncidentsResponse_Incident{
+			TeamId:     i.TeamId,
+			InstanceId: i.InstanceId,
+			Image:      i.Image,
+			Type:       i.Type,
+			State:      state,
+			Action:     i.Action,
+			Restarts:   int32(i.Restarts),
+			Error:      i.Error,
+			Time:       i.Time.Format(displayTimeFormat),
+		})
+	}
+
+	return &pb.ListIncidentsResponse{Incidents: incidents}, nil
+}
+
+func (d *daemon) ListSubmissions(ctx context.Context, req *pb.ListSubmissionsRequest) (*pb.ListSubmissionsResponse, error) {
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	var subs []event.Submission
+	if ev, err := d.eventPool.GetEvent(evtag); err == nil {
+		subs, err = ev.GetSubmissions()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		dir, _, err := d.getArchivedEvent(req.EventTag)
+		if err != nil {
+			return nil, err
+		}
+
+		subs, err = event.ReadSubmissions(event.SubmissionsPath(filepath.Join(d.conf.EventsDir, dir)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var submissions []*pb.ListSubmissionsResponse_Submission
+	for _, s := range subs {
+		if req.TeamId != "" && s.TeamId != req.TeamId {
+			continue
+		}
+
+		submissions = append(submissions, &pb.ListSubmissionsResponse_Submission{
+			TeamId:  s.TeamId,
+			Tag:     string(s.Tag),
+			Result:  s.Result,
+			Correct: s.Correct(),
+			Value:   s.Value,
+			Hashed:  s.Hashed,
+			Time:    s.Time.Format(time.RFC3339Nano),
+		})
+	}
+
+	return &pb.ListSubmissionsResponse{Submissions: submissions}, nil
+}
+
+func (d *daemon) StreamSolves(req *pb.StreamSolvesRequest, stream pb.Daemon_StreamSolvesServer) error {
+	log.Ctx(stream.Context()).
+		Info().
+		Str("tag", req.EventTag).
+		Msg("stream solves")
+
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return err
+	}
+
+	solves, unsubscribe := ev.SubscribeSolves()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case s, ok := <-solves:
+			if !ok {
+				return nil
+			}
+
+			if err := stream.Send(&pb.Solve{
+				TeamId:       s.TeamId,
+				TeamName:     s.TeamName,
+				ChallengeTag: string(s.Tag),
+				Points:       int32(s.Points),
+				CompletedAt:  s.CompletedAt.Format(displayTimeFormat),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *daemon) ExportEvent(ctx context.Context, req *pb.ExportEventRequest) (*pb.ExportEventResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("tag", req.Tag).
+		Msg("export event")
+
+	conf, teams, err := d.getEventForExport(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	// events archived before their flags were kept are exported with the
+	// flags of the current exercises
+	flags := conf.Flags
+	if len(flags) == 0 {
+		for _, tag := range conf.Lab.Exercises {
+			exercises, err := d.getExercises().GetExercisesByTags(tag)
+			if err != nil {
+				log.Warn().Err(err).Str("tag", string(tag)).Msg("Unable to find exercise for export")
+				continue
+			}
+
+			for _, e := range exercises {
+				flags = append(flags, e.Flags()...)
+			}
+		}
+	}
+
+	return &pb.ExportEventResponse{
+		EventTag:  string(conf.Tag),
+		EventName: conf.Name,
+		Rows:      exportRows(teams, flags, conf.Scoring),
+	}, nil
+}
+
+// getEventForExport looks up a running event by its tag, or an archived
+// event by either the name of its archive directory or its tag, in which
+// case the most recently finished event is used.
+func (d *daemon) getEventForExport(tag string) (store.EventConfig, []store.Team, error) {
+	evtag, err := store.NewTag(tag)
+	if err != nil {
+		return store.EventConfig{}, nil, err
+	}
+
+	if ev, err := d.eventPool.GetEvent(evtag); err == nil {
+		return ev.GetConfig(), ev.GetTeams(), nil
+	}
+
+	_, raw, err := d.getArchivedEvent(tag)
+	if err != nil {
+		return store.EventConfig{}, nil, err
+	}
+
+	return raw.EventConfig, raw.Teams, nil
+}
+
+// getArchivedEvent looks up an archived event by either the name of its
+// archive directory or its tag, returning the name of its directory
+func (d *daemon) getArchivedEvent(tag string) (string, store.RawEventFile, error) {
+	archived, err := store.GetArchivedEvents(d.conf.EventsDir)
+	if err != nil {
+		return "", store.RawEventFile{}, err
+	}
+
+	if raw, ok := archived[tag]; ok {
+		return tag, raw, nil
+	}
+
+	var latest string
+	for dir, raw := range archived {
+		if string(raw.Tag) != tag {
+			continue
+		}
+
+		if latest == "" || finishedAt(raw).After(finishedAt(archived[latest])) {
+			latest = dir
+		}
+	}
+
+	if latest == "" {
+		return "", store.RawEventFile{}, UnknownEventErr
+	}
+
+	return latest, archived[latest], nil
+}
+
+func finishedAt(ef store.RawEventFile) time.Time {
+	if ef.FinishedAt == nil {
+		return time.Time{}
+	}
+
+	return *ef.FinishedAt
+}
+
+// exportRows produces a row for every challenge of every team, leaving
+// the completion time empty for challenges which were not solved.
+func exportRows(teams []store.Team, flags []store.FlagConfig, scoring store.ScoringConfig) []*pb.ExportEventResponse_Row {
+	values := event.ChallengeValues(teams, flags, scoring)
+	firstBloods := store.FirstBloods(teams)
+
+	var rows []*pb.ExportEventResponse_Row
+	for _, t := range teams {
+		hintCosts := t.HintCosts()
+		solved := map[store.Tag]time.Time{}
+		for _, c := range t.SolvedChallenges {
+			if c.CompletedAt == nil {
+				continue
+			}
+
+			if at, ok := solved[c.FlagTag]; !ok || c.CompletedAt.Before(at) {
+				solved[c.FlagTag] = *c.CompletedAt
+			}
+		}
+
+		for _, f := range flags {
+			row := &pb.ExportEventResponse_Row{
+				TeamId:        t.Id,
+				TeamName:      t.Name,
+				ChallengeTag:  string(f.Tag),
+				ChallengeName: f.Name,
+				Category:      f.Category,
+				Points:        int32(values[f.Tag]),
+				HintPenalty:   int32(hintCosts[f.Tag]),
+			}
+
+			if at, ok := solved[f.Tag]; ok {
+				if firstBloods[f.Tag] == t.Id {
+					row.Points += int32(scoring.FirstBloodBonus)
+				}
+
+				row.CompletedAt = at.Format(time.RFC3339)
+				if t.CreatedAt != nil {
+					row.SecondsSinceTeamCreation = int64(at.Sub(*t.CreatedAt).Seconds())
+				}
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
 func (d *daemon) Close() error {
 	var errs error
 	var wg sync.WaitGroup
@@ -789,6 +1737,7 @@ func (d *daemon) ListFrontends(ctx context.Context, req *pb.Empty) (*pb.ListFron
 				Size:     info.Size(),
 				MemoryMB: int64(ic.MemoryMB),
 				Cpu:      float32(ic.CPU),
+				Type:     store.VBoxFrontend,
 			})
 		}
 		return nil
@@ -797,6 +1746,20 @@ func (d *daemon) ListFrontends(ctx context.Context, req *pb.Empty) (*pb.ListFron
 		return nil, err
 	}
 
+	// container frontends are pulled by docker, so are not found on disk
+	for _, ic := range d.frontends.ListFrontends() {
+		if !ic.IsContainer() {
+			continue
+		}
+
+		respList = append(respList, &pb.ListFrontendsResponse_Frontend{
+			Image:    ic.Image,
+			MemoryMB: int64(ic.MemoryMB),
+			Cpu:      float32(ic.CPU),
+			Type:     ic.Type,
+		})
+	}
+
 	return &pb.ListFrontendsResponse{Frontends: respList}, nil
 }
 
@@ -849,6 +1812,75 @@ func (d *daemon) ResetFrontends(req *pb.ResetFrontendsRequest, stream pb.Daemon_
 	return nil
 }
 
+func (d *daemon) ResizeFrontends(req *pb.ResizeFrontendsRequest, stream pb.Daemon_ResizeFrontendsServer) error {
+	log.Ctx(stream.Context()).Info().
+		Str("image", req.Image).
+		Int64("memory-mb", req.MemoryMB).
+		Float32("cpu", req.Cpu).
+		Int("n-teams", len(req.Teams)).
+		Msg("resize frontends")
+
+	if req.MemoryMB == 0 && req.Cpu == 0 {
+		return NoResizeErr
+	}
+
+	if req.MemoryMB < 0 {
+		return NegativeMemoryErr
+	}
+
+	conf := store.InstanceConfig{
+		Image:    req.Image,
+		MemoryMB: uint(req.MemoryMB),
+		CPU:      float64(req.Cpu),
+	}
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return err
+	}
+
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return err
+	}
+
+	teamIds := make([]string, len(req.Teams))
+	for i, t := range req.Teams {
+		teamIds[i] = t.Id
+	}
+
+	if req.Teams == nil {
+		for _, t := range ev.GetTeams() {
+			teamIds = append(teamIds, t.Id)
+		}
+	}
+
+	for _, id := range teamIds {
+		lab, ok := ev.GetLabByTeam(id)
+		if !ok {
+			stream.Send(&pb.ResetTeamStatus{TeamId: id, Status: "?"})
+			continue
+		}
+
+		// a single failing frontend should not prevent resizing the rest
+		status := "ok"
+		n, err := lab.ResizeFrontends(stream.Context(), conf)
+		switch {
+		case err != nil:
+			status = fmt.Sprintf("error: %s", err)
+		case n == 0:
+			status = "?"
+		}
+
+		stream.Send(&pb.ResetTeamStatus{TeamId: id, Status: status})
+	}
+
+	return nil
+}
+
 func (d *daemon) SetFrontendMemory(ctx context.Context, in *pb.SetFrontendMemoryRequest) (*pb.Empty, error) {
 	err := d.frontends.SetMemoryMB(in.Image, uint(in.MemoryMB))
 	return &pb.Empty{}, err
@@ -919,15 +1951,40 @@ func (d *daemon) MonitorHost(req *pb.Empty, stream pb.Daemon_MonitorHostServer)
 	}
 }
 
+func (d *daemon) ListWorkers(ctx context.Context, req *pb.Empty) (*pb.ListWorkersResponse, error) {
+	if d.workers == nil {
+		return &pb.ListWorkersResponse{}, nil
+	}
+
+	var workers []*pb.ListWorkersResponse_Worker
+	for _, h := range d.workers.Hosts() {
+		w := &pb.ListWorkersResponse_Worker{
+			Name:              h.Name,
+			Address:           h.Address,
+			CpuPercent:        float32(h.Status.CPUPercent),
+			Cpus:              int32(h.Status.CPUs),
+			MemoryPercent:     float32(h.Status.MemoryPercent),
+			MemoryAvailableMB: int64(h.Status.MemoryAvailableMB),
+			Labs:              int32(h.Status.Labs),
+		}
+		if h.Err != nil {
+			w.Error = h.Err.Error()
+		}
+		workers = append(workers, w)
+	}
+
+	return &pb.ListWorkersResponse{Workers: workers}, nil
+}
+
 func (d *daemon) Version(context.Context, *pb.Empty) (*pb.VersionResponse, error) {
 	return &pb.VersionResponse{Version: version}, nil
 }
 
 func (d *daemon) grpcOpts() ([]grpc.ServerOption, error) {
 	if d.conf.TLS.Enabled {
-		creds,err := credentials.NewServerTLSFromFile(d.conf.TLS.CertFile,d.conf.TLS.CertKey)
-		if err !=nil {
-			log.Error().Msgf("Error reading certificate from file %s ",err)
+		creds, err := credentials.NewServerTLSFromFile(d.conf.TLS.CertFile, d.conf.TLS.CertKey)
+		if err != nil {
+			log.Error().Msgf("Error reading certificate from file %s ", err)
 		}
 		return []grpc.ServerOption{grpc.Creds(creds)}, nil
 	}
@@ -939,7 +1996,7 @@ func (d *daemon) Run() error {
 	// start frontend
 	go func() {
 		if d.conf.TLS.Enabled {
-			if err := http.ListenAndServeTLS(fmt.Sprintf(":%d", d.conf.Port.Secure),d.conf.TLS.CertFile,d.conf.TLS.CertKey,d.eventPool); err != nil {
+			if err := http.ListenAndServeTLS(fmt.Sprintf(":%d", d.conf.Port.Secure), d.conf.TLS.CertFile, d.conf.TLS.CertKey, d.eventPool); err != nil {
 				log.Warn().Msgf("Serving error: %s", err)
 			}
 			return
@@ -954,6 +2011,16 @@ func (d *daemon) Run() error {
 			http.Redirect(w, r, "https://"+r.Host+r.URL.String(), http.StatusMovedPermanently)
 		}))
 	}
+	// expose metrics for prometheus
+	if d.conf.Port.Metrics != 0 {
+		go func() {
+			addr := net.JoinHostPort(d.conf.Host.Metrics, fmt.Sprint(d.conf.Port.Metrics))
+			if err := http.ListenAndServe(addr, d.metricsHandler()); err != nil {
+				log.Warn().Msgf("Serving metrics error: %s", err)
+			}
+		}()
+	}
+
 	// start gRPC daemon
 	lis, err := net.Listen("tcp", mngtPort)
 	if err != nil {
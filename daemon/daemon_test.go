@@ -24,10 +24,14 @@ import (
 	"github.com/aau-network-security/haaukins/event"
 	"github.com/aau-network-security/haaukins/exercise"
 	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/logging"
 	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/ctfd"
 	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/worker"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
@@ -43,6 +47,7 @@ const (
 type noAuth struct {
 	allowed   bool
 	superuser bool
+	roles     []store.Role
 }
 
 func (a *noAuth) TokenForUser(username, password string) (string, error) {
@@ -51,7 +56,7 @@ func (a *noAuth) TokenForUser(username, password string) (string, error) {
 
 func (a *noAuth) AuthenticateContext(ctx context.Context) (context.Context, error) {
 	if a.allowed {
-		return context.WithValue(ctx, us{}, store.User{Username: "some_user", SuperUser: a.superuser}), nil
+		return context.WithValue(ctx, us{}, store.User{Username: "some_user", SuperUser: a.superuser, Roles: a.roles}), nil
 	}
 
 	return ctx, fmt.Errorf("unauthorized")
@@ -80,10 +85,13 @@ func TestInviteUser(t *testing.T) {
 		token     string
 		allowed   bool
 		superuser bool
+		roles     []store.Role
 		err       string
 	}{
 		{name: "Normal with auth and super", allowed: true, superuser: true},
 		{name: "No super with auth", allowed: true, err: "This action requires super user permissions"},
+		{name: "Admin role", allowed: true, roles: []store.Role{store.RoleAdmin}},
+		{name: "Event manager role", allowed: true, roles: []store.Role{store.RoleEventManager}, err: "This action requires super user permissions"},
 		{name: "Unauthorized", allowed: false, err: "unauthorized"},
 	}
 
@@ -96,6 +104,7 @@ func TestInviteUser(t *testing.T) {
 				auth: &noAuth{
 					allowed:   tc.allowed,
 					superuser: tc.superuser,
+					roles:     tc.roles,
 				},
 				users: struct {
 					store.SignupKeyStore
@@ -353,6 +362,316 @@ func TestLoginUser(t *testing.T) {
 	}
 }
 
+func TestChangePassword(t *testing.T) {
+	tt := []struct {
+		name     string
+		caller   store.User
+		username string
+		old      string
+		err      string
+	}{
+		{name: "Own password", caller: store.User{Username: "tkp"}, old: "tkptkp"},
+		{name: "Wrong old password", caller: store.User{Username: "tkp"}, old: "wrong", err: "Incorrect password"},
+		{name: "Admin other user", caller: store.User{Username: "admin", SuperUser: true}, username: "tkp"},
+		{name: "Other user", caller: store.User{Username: "mrr"}, username: "tkp", err: "This action requires super user permissions"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := store.NewUser("tkp", "tkptkp")
+			if err != nil {
+				t.Fatalf("unexpected error when creating user: %s", err)
+			}
+
+			users := store.NewUserStore([]store.User{u})
+			auth := NewAuthenticator(users, "some-signing-key")
+			d := &daemon{
+				auth: auth,
+				users: struct {
+					store.SignupKeyStore
+					store.UserStore
+				}{
+					store.NewSignupKeyStore([]store.SignupKey{}),
+					users,
+				},
+			}
+
+			oldToken, err := auth.TokenForUser("tkp", "tkptkp")
+			if err != nil {
+				t.Fatalf("unexpected error when creating token: %s", err)
+			}
+
+			ctx := context.WithValue(context.Background(), us{}, tc.caller)
+			resp, err := d.ChangePassword(ctx, &pb.ChangePasswordRequest{
+				Username:    tc.username,
+				OldPassword: tc.old,
+				NewPassword: "newpassword",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resp.Error != "" {
+				if tc.err != resp.Error {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, resp.Error)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if _, err := auth.TokenForUser("tkp", "newpassword"); err != nil {
+				t.Fatalf("expected to be able to login with new password, received: %s", err)
+			}
+
+			md := metadata.Pairs("token", oldToken)
+			_, err = auth.AuthenticateContext(metadata.NewIncomingContext(context.Background(), md))
+			if err != TokenRevokedErr {
+				t.Fatalf("expected old token to be revoked, received: %v", err)
+			}
+		})
+	}
+}
+
+func TestRevokeTokens(t *testing.T) {
+	u, err := store.NewUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating user: %s", err)
+	}
+
+	users := store.NewUserStore([]store.User{u})
+	auth := NewAuthenticator(users, "some-signing-key")
+	d := &daemon{
+		auth: auth,
+		users: struct {
+			store.SignupKeyStore
+			store.UserStore
+		}{
+			store.NewSignupKeyStore([]store.SignupKey{}),
+			users,
+		},
+	}
+
+	token, err := auth.TokenForUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating token: %s", err)
+	}
+
+	authCtx := func(token string) error {
+		md := metadata.Pairs("token", token)
+		_, err := auth.AuthenticateContext(metadata.NewIncomingContext(context.Background(), md))
+		return err
+	}
+
+	if err := authCtx(token); err != nil {
+		t.Fatalf("expected token to be valid, received: %s", err)
+	}
+
+	ctx := context.WithValue(context.Background(), us{}, u)
+	resp, err := d.RevokeTokens(ctx, &pb.RevokeTokensRequest{})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("unexpected error when revoking tokens: %v %s", err, resp.GetError())
+	}
+
+	if err := authCtx(token); err != TokenRevokedErr {
+		t.Fatalf("expected token to be revoked, received: %v", err)
+	}
+
+	token, err = auth.TokenForUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating token: %s", err)
+	}
+
+	if err := authCtx(token); err != nil {
+		t.Fatalf("expected new token to be valid, received: %s", err)
+	}
+}
+
+func TestRecreatedUserTokens(t *testing.T) {
+	u, err := store.NewUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating user: %s", err)
+	}
+
+	users := store.NewUserStore([]store.User{})
+	auth := NewAuthenticator(users, "some-signing-key")
+
+	authCtx := func(token string) error {
+		md := metadata.Pairs("token", token)
+		_, err := auth.AuthenticateContext(metadata.NewIncomingContext(context.Background(), md))
+		return err
+	}
+
+	if err := users.CreateUser(u); err != nil {
+		t.Fatalf("unexpected error when storing user: %s", err)
+	}
+
+	old, err := auth.TokenForUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating token: %s", err)
+	}
+
+	if err := users.DeleteUserByUsername("tkp"); err != nil {
+		t.Fatalf("unexpected error when deleting user: %s", err)
+	}
+
+	if err := users.CreateUser(u); err != nil {
+		t.Fatalf("unexpected error when storing user again: %s", err)
+	}
+
+	if err := authCtx(old); err != TokenRevokedErr {
+		t.Fatalf("expected token of deleted user to be revoked, received: %v", err)
+	}
+
+	token, err := auth.TokenForUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating token: %s", err)
+	}
+
+	if err := authCtx(token); err != nil {
+		t.Fatalf("expected new token to be valid, received: %s", err)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	tt := []struct {
+		name     string
+		username string
+		err      string
+	}{
+		{name: "Normal", username: "tkp"},
+		{name: "Unknown user", username: "mrr", err: "User not found"},
+		{name: "Self", username: "admin", err: "Unable to delete the user you are logged in as"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			users := store.NewUserStore([]store.User{
+				{Username: "admin", SuperUser: true},
+				{Username: "tkp"},
+			})
+			d := &daemon{
+				users: struct {
+					store.SignupKeyStore
+					store.UserStore
+				}{
+					store.NewSignupKeyStore([]store.SignupKey{}),
+					users,
+				},
+			}
+
+			ctx := context.WithValue(context.Background(), us{}, store.User{Username: "admin", SuperUser: true})
+			resp, err := d.DeleteUser(ctx, &pb.DeleteUserRequest{Username: tc.username})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resp.Error != "" {
+				if tc.err != resp.Error {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, resp.Error)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if _, err := users.GetUserByUsername(tc.username); err != store.UserNotFoundErr {
+				t.Fatalf("expected user to be deleted")
+			}
+		})
+	}
+}
+
+func TestSetUserRoles(t *testing.T) {
+	tt := []struct {
+		name     string
+		roles    []string
+		role     store.Role
+		expected bool
+	}{
+		{name: "Observer", roles: []string{"observer"}, role: store.RoleObserver, expected: true},
+		{name: "Legacy role removed", roles: []string{"observer"}, role: store.RoleEventManager},
+		{name: "No roles", roles: nil, role: store.RoleEventManager},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// a user from before roles were introduced
+			users := store.NewUserStore([]store.User{{Username: "tkp"}})
+			d := &daemon{
+				users: struct {
+					store.SignupKeyStore
+					store.UserStore
+				}{
+					store.NewSignupKeyStore([]store.SignupKey{}),
+					users,
+				},
+			}
+
+			ctx := context.WithValue(context.Background(), us{}, store.User{Username: "admin", SuperUser: true})
+			resp, err := d.SetUserRoles(ctx, &pb.SetUserRolesRequest{Username: "tkp", Roles: tc.roles})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resp.Error != "" {
+				t.Fatalf("expected no error, but received: %s", resp.Error)
+			}
+
+			u, err := users.GetUserByUsername("tkp")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ok := u.HasRole(tc.role); ok != tc.expected {
+				t.Fatalf("expected user to have role %s: %t, got: %t", tc.role, tc.expected, ok)
+			}
+		})
+	}
+}
+
+func TestCheckOwnership(t *testing.T) {
+	tt := []struct {
+		name string
+		user store.User
+		req  interface{}
+		err  error
+	}{
+		{name: "Owner", user: store.User{Username: "tkp"}, req: &pb.StopEventRequest{Tag: "tst"}},
+		{name: "Not owner", user: store.User{Username: "mrr"}, req: &pb.StopEventRequest{Tag: "tst"}, err: NotEventOwnerErr},
+		{name: "No event", user: store.User{Username: "tkp"}, req: &pb.Empty{}, err: NotEventOwnerErr},
+		{name: "Admin no event", user: store.User{Username: "admin", SuperUser: true}, req: &pb.Empty{}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := fakeEvent{
+				conf: store.EventConfig{
+					Tag:       store.Tag("tst"),
+					CreatedBy: "tkp",
+				},
+			}
+
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: NewEventPool(""),
+			}
+			d.eventPool.AddEvent(&ev)
+
+			ctx := context.WithValue(context.Background(), us{}, tc.user)
+			if err := d.checkOwnership(ctx, tc.req); err != tc.err {
+				t.Fatalf("unexpected error (expected: %v) received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
 type fakeEventHost struct {
 	event event.Event
 	event.Host
@@ -366,6 +685,10 @@ func (eh fakeEventHost) CreateEventFromEventFile(context.Context, store.EventFil
 	return eh.event, nil
 }
 
+func (eh fakeEventHost) UpdateEventHostExercisesFile(store.ExerciseStore) error {
+	return nil
+}
+
 type fakeEvent struct {
 	m         sync.Mutex
 	connected int
@@ -376,6 +699,10 @@ type fakeEvent struct {
 	teams     []store.Team
 	lab       *fakeLab
 	conf      store.EventConfig
+	scores    []event.TeamScore
+	incidents []event.Incident
+	subs      []event.Submission
+	exercises []store.Tag
 	event.Event
 }
 
@@ -387,6 +714,51 @@ func (fe *fakeEvent) Start(context.Context) error {
 	return nil
 }
 
+func (fe *fakeEvent) AddExercises(_ context.Context, exercises ...store.Exercise) error {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	for _, e := range exercises {
+		fe.exercises = append(fe.exercises, e.Tags[0])
+	}
+	return nil
+}
+
+func (fe *fakeEvent) RemoveExercises(_ context.Context, exercises ...store.Exercise) error {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	var remaining []store.Tag
+	for _, t := range fe.exercises {
+		removed := false
+		for _, e := range exercises {
+			if e.Tags[0] == t {
+				removed = true
+			}
+		}
+
+		if !removed {
+			remaining = append(remaining, t)
+		}
+	}
+	fe.exercises = remaining
+	return nil
+}
+
+func (fe *fakeEvent) CreateTeam(ctx context.Context, t *store.Team) error {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	for _, other := range fe.teams {
+		if other.Name == t.Name {
+			return event.TeamNameTakenErr
+		}
+	}
+
+	fe.teams = append(fe.teams, *t)
+	return nil
+}
+
 func (fe *fakeEvent) Handler() http.Handler {
 	fe.m.Lock()
 	defer fe.m.Unlock()
@@ -413,6 +785,22 @@ func (fe *fakeEvent) Finish() {
 	fe.finished += 1
 }
 
+func (fe *fakeEvent) Suspend(context.Context) error {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	fe.conf.Suspended = true
+	return nil
+}
+
+func (fe *fakeEvent) Resume(context.Context) error {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	fe.conf.Suspended = false
+	return nil
+}
+
 func (fe *fakeEvent) Register(store.Team) error {
 	fe.m.Lock()
 	defer fe.m.Unlock()
@@ -435,6 +823,27 @@ func (fe *fakeEvent) GetTeams() []store.Team {
 	return fe.teams
 }
 
+func (fe *fakeEvent) GetScoreboard() []event.TeamScore {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	return fe.scores
+}
+
+func (fe *fakeEvent) GetIncidents() ([]event.Incident, error) {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	return fe.incidents, nil
+}
+
+func (fe *fakeEvent) GetSubmissions() ([]event.Submission, error) {
+	fe.m.Lock()
+	defer fe.m.Unlock()
+
+	return fe.subs, nil
+}
+
 func (fe *fakeEvent) GetLabByTeam(teamId string) (lab.Lab, bool) {
 	if fe.lab != nil {
 		return fe.lab, true
@@ -445,6 +854,9 @@ func (fe *fakeEvent) GetLabByTeam(teamId string) (lab.Lab, bool) {
 type fakeLab struct {
 	environment exercise.Environment
 	instances   []virtual.InstanceInfo
+	resized     int
+	snapshots   []string
+	restored    []string
 	lab.Lab
 }
 
@@ -456,6 +868,27 @@ func (fl *fakeLab) InstanceInfo() []virtual.InstanceInfo {
 	return fl.instances
 }
 
+func (fl *fakeLab) ResizeFrontends(ctx context.Context, conf store.InstanceConfig) (int, error) {
+	fl.resized += 1
+	return 1, nil
+}
+
+func (fl *fakeLab) Snapshot(ctx context.Context, name string) error {
+	fl.snapshots = append(fl.snapshots, name)
+	return nil
+}
+
+func (fl *fakeLab) Restore(ctx context.Context, name string) error {
+	for _, s := range fl.snapshots {
+		if s == name {
+			fl.restored = append(fl.restored, name)
+			return nil
+		}
+	}
+
+	return lab.UnknownSnapshotErr
+}
+
 type fakeEnvironment struct {
 	resettedExercises int
 	exercise.Environment
@@ -467,9 +900,14 @@ func (fe *fakeEnvironment) ResetByTag(ctx context.Context, t string) error {
 }
 
 type fakeFrontendStore struct {
+	frontends []store.InstanceConfig
 	store.FrontendStore
 }
 
+func (fe *fakeFrontendStore) ListFrontends() []store.InstanceConfig {
+	return fe.frontends
+}
+
 func (fe *fakeFrontendStore) GetFrontends(names ...string) []store.InstanceConfig {
 	var res []store.InstanceConfig
 	for _, f := range names {
@@ -491,6 +929,8 @@ func TestCreateEvent(t *testing.T) {
 		{name: "Empty tag", event: pb.CreateEventRequest{Name: "Test", Exercises: []string{"hb"}, Frontends: []string{"kali"}}, err: "Tag cannot be empty for Event"},
 		{name: "Empty exercises", event: pb.CreateEventRequest{Name: "Test", Tag: "tst", Frontends: []string{"kali"}}, err: "Exercises cannot be empty for Event"},
 		{name: "Empty frontends", event: pb.CreateEventRequest{Name: "Test", Tag: "tst", Exercises: []string{"hb"}}, err: "Frontends cannot be empty for Event"},
+		{name: "Dynamic scoring", event: pb.CreateEventRequest{Name: "Test", Tag: "tst", Exercises: []string{"hb"}, Frontends: []string{"kali"}, Scoring: &pb.Scoring{Mode: "dynamic", Minimum: 50, Decay: 10}}},
+		{name: "Dynamic scoring without decay", event: pb.CreateEventRequest{Name: "Test", Tag: "tst", Exercises: []string{"hb"}, Frontends: []string{"kali"}, Scoring: &pb.Scoring{Mode: "dynamic"}}, err: "Dynamic scoring requires a decay larger than zero"},
 	}
 
 	for _, tc := range tt {
@@ -599,6 +1039,8 @@ func TestStopEvent(t *testing.T) {
 	tt := []struct {
 		name         string
 		unauthorized bool
+		roles        []store.Role
+		owner        string
 		event        *pb.CreateEventRequest
 		stopTag      string
 		err          string
@@ -607,13 +1049,18 @@ func TestStopEvent(t *testing.T) {
 		{name: "Empty delete tag", stopTag: "", err: "Tag cannot be empty"},
 		{name: "Unknown tag", stopTag: "some-other-tag", err: "Unable to find event by that tag"},
 		{name: "Unauthorized", unauthorized: true, stopTag: "tst", err: "unauthorized"},
+		{name: "Owner", owner: "some_user", roles: []store.Role{store.RoleEventManager}, stopTag: "tst"},
+		{name: "Not owner", owner: "another_user", roles: []store.Role{store.RoleEventManager}, stopTag: "tst", err: "Only the creator of the event is allowed to manage it"},
+		{name: "Admin not owner", owner: "another_user", roles: []store.Role{store.RoleAdmin}, stopTag: "tst"},
+		{name: "Observer", roles: []store.Role{store.RoleObserver}, stopTag: "tst", err: "This action requires one of the roles: event-manager"},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			ev := fakeEvent{
 				conf: store.EventConfig{
-					Tag: store.Tag("tst"),
+					Tag:       store.Tag("tst"),
+					CreatedBy: tc.owner,
 				},
 			}
 
@@ -624,6 +1071,7 @@ func TestStopEvent(t *testing.T) {
 				eventPool: eventPool,
 				auth: &noAuth{
 					allowed: !tc.unauthorized,
+					roles:   tc.roles,
 				},
 				ehost: &fakeEventHost{
 					event: &ev,
@@ -703,35 +1151,382 @@ func TestStopEvent(t *testing.T) {
 	}
 }
 
-func TestListEvents(t *testing.T) {
+func TestSuspendEvent(t *testing.T) {
 	tt := []struct {
-		name         string
-		unauthorized bool
-		count        int
-		err          string
-		startedTime  string
+		name      string
+		suspended bool
+		resume    bool
+		tag       string
+		err       string
 	}{
-		{name: "Normal", count: 1},
-		{name: "Normal three events", count: 3},
-		{name: "Unauthorized", unauthorized: true, count: 1, err: "unauthorized"},
+		{name: "Suspend", tag: "tst"},
+		{name: "Suspend suspended", suspended: true, tag: "tst", err: "Event is already suspended"},
+		{name: "Resume", suspended: true, resume: true, tag: "tst"},
+		{name: "Resume running", resume: true, tag: "tst", err: "Event is not suspended"},
+		{name: "Unknown tag", tag: "some-other-tag", err: "Unable to find event by that tag"},
 	}
+
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			ev := &fakeEvent{}
+			ev := fakeEvent{
+				conf: store.EventConfig{
+					Tag:       store.Tag("tst"),
+					Suspended: tc.suspended,
+				},
+			}
 
 			ctx := context.Background()
-			eventPool := NewEventPool("")
 			d := &daemon{
 				conf:      &Config{},
-				eventPool: eventPool,
-				auth: &noAuth{
-					allowed: !tc.unauthorized,
-				},
-				ehost: &fakeEventHost{
-					event: ev,
-				},
+				eventPool: NewEventPool(""),
+				auth:      &noAuth{allowed: true},
+				ehost:     &fakeEventHost{event: &ev},
 			}
-			startedAt, _ := time.Parse(tc.startedTime, displayTimeFormat)
+			d.eventPool.AddEvent(&ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+
+			var recv func() (*pb.EventStatus, error)
+			if tc.resume {
+				stream, err := client.ResumeEvent(ctx, &pb.ResumeEventRequest{Tag: tc.tag})
+				if err != nil {
+					t.Fatalf("expected no error when initiating connection, but received: %s", err)
+				}
+				recv = stream.Recv
+			} else {
+				stream, err := client.SuspendEvent(ctx, &pb.SuspendEventRequest{Tag: tc.tag})
+				if err != nil {
+					t.Fatalf("expected no error when initiating connection, but received: %s", err)
+				}
+				recv = stream.Recv
+			}
+
+			for {
+				_, err = recv()
+				if err != nil {
+					break
+				}
+			}
+
+			if err != io.EOF {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != err.Error() {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if ev.GetConfig().Suspended == tc.resume {
+				t.Fatalf("expected event suspended to be %t", !tc.resume)
+			}
+		})
+	}
+}
+
+func TestScheduledEvent(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	efh, err := store.NewEventFileHub(tmp)
+	if err != nil {
+		t.Fatalf("unable to create event file hub: %s", err)
+	}
+
+	exStore, err := store.NewExerciseStore([]store.Exercise{{
+		Tags: []store.Tag{"hb"},
+	}})
+	if err != nil {
+		t.Fatalf("Error %v", err)
+	}
+
+	ev := fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}}
+	eventPool := NewEventPool("")
+	d := &daemon{
+		conf:       &Config{},
+		eventPool:  eventPool,
+		eventFiles: efh,
+		frontends:  &fakeFrontendStore{},
+		exercises:  exStore,
+		auth:       &noAuth{allowed: true},
+		ehost:      &fakeEventHost{event: &ev},
+	}
+	defer d.scheduler.Close()
+
+	ctx := context.Background()
+	dialer, close := getServer(d)
+	defer close()
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+		grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewDaemonClient(conn)
+	startTime := time.Now().Add(time.Hour).Format("2006-01-02 15:04")
+	stream, err := client.CreateEvent(ctx, &pb.CreateEventRequest{
+		Name:      "Test",
+		Tag:       "tst",
+		Exercises: []string{"hb"},
+		Frontends: []string{"kali"},
+		StartTime: startTime,
+	})
+	if err != nil {
+		t.Fatalf("expected no error when initiating connection, but received: %s", err)
+	}
+	for {
+		if _, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+	if err != io.EOF {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	if len(eventPool.GetAllEvents()) != 0 {
+		t.Fatalf("expected scheduled event not to be started")
+	}
+
+	resp, err := client.ListEvents(ctx, &pb.ListEventsRequest{})
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	if n := len(resp.Events); n != 1 {
+		t.Fatalf("expected one scheduled event, received: %d", n)
+	}
+	if s := resp.Events[0].Status; s != "scheduled" {
+		t.Fatalf("expected event status to be scheduled, received: %s", s)
+	}
+	if ct := resp.Events[0].CreationTime; ct != startTime+":00" {
+		t.Fatalf("expected creation time to be start time (%s), received: %s", startTime, ct)
+	}
+
+	efs, err := efh.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	if len(efs) != 1 {
+		t.Fatalf("expected scheduled event to be stored")
+	}
+
+	stopStream, err := client.StopEvent(ctx, &pb.StopEventRequest{Tag: "tst"})
+	if err != nil {
+		t.Fatalf("expected no error when initiating connection, but received: %s", err)
+	}
+	for {
+		if _, err = stopStream.Recv(); err != nil {
+			break
+		}
+	}
+	if err != io.EOF {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	if d.scheduler.isPending("tst") {
+		t.Fatalf("expected scheduled event to be removed")
+	}
+
+	efs, err = efh.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	if len(efs) != 0 {
+		t.Fatalf("expected scheduled event to be archived")
+	}
+}
+
+func TestScheduleTimers(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	efh, err := store.NewEventFileHub(tmp)
+	if err != nil {
+		t.Fatalf("unable to create event file hub: %s", err)
+	}
+
+	startAt := time.Now().Add(20 * time.Millisecond)
+	finishAt := time.Now().Add(100 * time.Millisecond)
+	conf := store.EventConfig{
+		Name:           "Test",
+		Tag:            store.Tag("tst"),
+		StartAt:        &startAt,
+		FinishExpected: &finishAt,
+	}
+	ef, err := efh.CreateEventFile(conf)
+	if err != nil {
+		t.Fatalf("unable to create event file: %s", err)
+	}
+
+	ev := fakeEvent{conf: conf}
+	eventPool := NewEventPool("")
+	d := &daemon{
+		conf:      &Config{},
+		eventPool: eventPool,
+		ehost:     &fakeEventHost{event: &ev},
+	}
+	defer d.scheduler.Close()
+
+	d.scheduleStart(ef)
+	d.scheduleFinish(conf)
+
+	time.Sleep(60 * time.Millisecond)
+	if len(eventPool.GetAllEvents()) != 1 {
+		t.Fatalf("expected event to have been started")
+	}
+	if ef.Read().StartedAt == nil {
+		t.Fatalf("expected start time of event to be stored")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if len(eventPool.GetAllEvents()) != 0 {
+		t.Fatalf("expected event to have been stopped")
+	}
+
+	ev.m.Lock()
+	defer ev.m.Unlock()
+	if ev.close != 1 {
+		t.Fatalf("expected event to have been closed once")
+	}
+	if ev.finished != 1 {
+		t.Fatalf("expected event to have been finished once")
+	}
+}
+
+func TestRestoreEvents(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	efh, err := store.NewEventFileHub(tmp)
+	if err != nil {
+		t.Fatalf("unable to create event file hub: %s", err)
+	}
+
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+	for _, conf := range []store.EventConfig{
+		{Name: "Expired", Tag: store.Tag("old"), StartedAt: &expired, FinishExpected: &expired},
+		{Name: "Running", Tag: store.Tag("tst"), StartedAt: &now, FinishExpected: &later},
+	} {
+		if _, err := efh.CreateEventFile(conf); err != nil {
+			t.Fatalf("unable to create event file: %s", err)
+		}
+	}
+
+	eventFiles, err := efh.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("unable to read event files: %s", err)
+	}
+
+	ev := fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}}
+	d := &daemon{
+		conf:      &Config{},
+		eventPool: NewEventPool(""),
+		ehost:     &fakeEventHost{event: &ev},
+	}
+	defer d.scheduler.Close()
+
+	if err := d.restoreEvents(eventFiles); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := d.eventPool.GetEvent(store.Tag("old")); err == nil {
+		t.Fatalf("expected expired event not to be created")
+	}
+
+	if _, err := d.eventPool.GetEvent(store.Tag("tst")); err != nil {
+		t.Fatalf("expected running event to be created: %s", err)
+	}
+
+	unfinished, err := efh.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("unable to read event files: %s", err)
+	}
+
+	if n := len(unfinished); n != 1 {
+		t.Fatalf("expected only the running event to be unfinished, got: %d", n)
+	}
+
+	archived, err := store.GetArchivedEvents(tmp)
+	if err != nil {
+		t.Fatalf("unable to read archived events: %s", err)
+	}
+
+	if len(archived) != 1 {
+		t.Fatalf("expected expired event to be archived, got: %v", archived)
+	}
+
+	for _, raw := range archived {
+		if raw.Tag != store.Tag("old") || raw.FinishedAt == nil || !raw.FinishedAt.Equal(expired) {
+			t.Fatalf("expected expired event to be finished at its expected finish time, got: %+v", raw.EventConfig)
+		}
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	tt := []struct {
+		name         string
+		unauthorized bool
+		count        int
+		err          string
+		startedTime  string
+	}{
+		{name: "Normal", count: 1},
+		{name: "Normal three events", count: 3},
+		{name: "Unauthorized", unauthorized: true, count: 1, err: "unauthorized"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := &fakeEvent{}
+
+			ctx := context.Background()
+			eventPool := NewEventPool("")
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: eventPool,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+				ehost: &fakeEventHost{
+					event: ev,
+				},
+			}
+			startedAt, _ := time.Parse(tc.startedTime, displayTimeFormat)
 			expectedFinishDate, _ := time.Parse(time.Now().String(),displayTimeFormat)
 			for i := 1; i <= tc.count; i++ {
 				tempEvent := *ev
@@ -771,57 +1566,1145 @@ func TestListEvents(t *testing.T) {
 				t.Fatalf("expected no error, but received: %s", err)
 			}
 
-			if tc.err != "" {
-				if tc.err != err.Error() {
-					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
-				}
+			if tc.err != "" {
+				if tc.err != err.Error() {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if n := len(resp.Events); n != tc.count {
+				t.Fatalf("unexpected amount of events (expected: %d), received: %d", tc.count, n)
+			}
+
+		})
+	}
+}
+
+func TestGetScoreboard(t *testing.T) {
+	lastSolve := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	tt := []struct {
+		name         string
+		unauthorized bool
+		tag          string
+		err          string
+	}{
+		{name: "Normal", tag: "tst"},
+		{name: "Unknown event", tag: "other", err: "Unable to find event by that tag"},
+		{name: "Unauthorized", unauthorized: true, tag: "tst", err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := &fakeEvent{
+				conf: store.EventConfig{Tag: store.Tag("tst")},
+				scores: []event.TeamScore{
+					{TeamId: "a", TeamName: "first", Points: 20, Solves: 2, LastSolve: &lastSolve},
+					{TeamId: "b", TeamName: "second"},
+				},
+			}
+
+			ctx := context.Background()
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: NewEventPool(""),
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+			d.startEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.GetScoreboard(ctx, &pb.GetScoreboardRequest{EventTag: tc.tag})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != "" {
+					if tc.err != err.Error() {
+						t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+					}
+
+					return
+				}
+
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if n := len(resp.Teams); n != 2 {
+				t.Fatalf("expected two teams on scoreboard, received: %d", n)
+			}
+
+			first := resp.Teams[0]
+			if first.Rank != 1 || first.TeamId != "a" || first.Points != 20 || first.Solves != 2 {
+				t.Fatalf("unexpected first team on scoreboard: %v", first)
+			}
+
+			if first.LastSolve != lastSolve.Format(displayTimeFormat) {
+				t.Fatalf("unexpected last solve time: %s", first.LastSolve)
+			}
+
+			if second := resp.Teams[1]; second.Rank != 2 || second.LastSolve != "" {
+				t.Fatalf("unexpected second team on scoreboard: %v", second)
+			}
+		})
+	}
+}
+
+func TestListIncidents(t *testing.T) {
+	at := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tmp, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	ef := store.NewEventFile(tmp, "old-01-01-20.yml", store.RawEventFile{EventConfig: store.EventConfig{Tag: store.Tag("old")}})
+	if err := ef.Archive(); err != nil {
+		t.Fatalf("unable to archive event: %s", err)
+	}
+
+	line := fmt.Sprintf(`{"t":%q,"team-id":"a","instance-id":"sql-1","image":"sql","type":"docker","state":%d,"action":%q,"restarts":1}`, at.Format(time.RFC3339Nano), virtual.Stopped, event.IncidentRestarted)
+	if err := ioutil.WriteFile(event.IncidentsPath(ef.ArchiveDir()), []byte(line+"\n"), 0644); err != nil {
+		t.Fatalf("unable to write incidents: %s", err)
+	}
+
+	tt := []struct {
+		name         string
+		unauthorized bool
+		tag          string
+		err          string
+	}{
+		{name: "Normal", tag: "tst"},
+		{name: "Archived event", tag: "old"},
+		{name: "Unknown event", tag: "other", err: "Unable to find event by that tag"},
+		{name: "Unauthorized", unauthorized: true, tag: "tst", err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := &fakeEvent{
+				conf: store.EventConfig{Tag: store.Tag("tst")},
+				incidents: []event.Incident{
+					{TeamId: "a", InstanceId: "sql-1", Image: "sql", Type: "docker", State: virtual.Stopped, Action: event.IncidentRestarted, Restarts: 1, Time: at},
+				},
+			}
+
+			ctx := context.Background()
+			d := &daemon{
+				conf:      &Config{EventsDir: tmp},
+				eventPool: NewEventPool(""),
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+			d.startEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.ListIncidents(ctx, &pb.ListIncidentsRequest{EventTag: tc.tag})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != "" {
+					if tc.err != err.Error() {
+						t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+					}
+
+					return
+				}
+
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if n := len(resp.Incidents); n != 1 {
+				t.Fatalf("expected one incident, received: %d", n)
+			}
+
+			i := resp.Incidents[0]
+			if i.TeamId != "a" || i.InstanceId != "sql-1" || i.State != "stopped" || i.Action != event.IncidentRestarted || i.Restarts != 1 {
+				t.Fatalf("unexpected incident: %v", i)
+			}
+
+			if i.Time != at.Format(displayTimeFormat) {
+				t.Fatalf("unexpected incident time: %s", i.Time)
+			}
+		})
+	}
+}
+
+func TestListSubmissions(t *testing.T) {
+	at := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tmp, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	ef := store.NewEventFile(tmp, "old-01-01-20.yml", store.RawEventFile{EventConfig: store.EventConfig{Tag: store.Tag("old")}})
+	if err := ef.Archive(); err != nil {
+		t.Fatalf("unable to archive event: %s", err)
+	}
+
+	line := fmt.Sprintf(`{"t":%q,"team-id":"a","tag":"sql-1","result":"incorrect","value":"2c26b46b","hashed":true}`, at.Format(time.RFC3339Nano))
+	if err := ioutil.WriteFile(event.SubmissionsPath(ef.ArchiveDir()), []byte(line+"\n"), 0644); err != nil {
+		t.Fatalf("unable to write submissions: %s", err)
+	}
+
+	tt := []struct {
+		name         string
+		unauthorized bool
+		tag          string
+		team         string
+		expected     int
+		err          string
+	}{
+		{name: "Normal", tag: "tst", expected: 2},
+		{name: "Team", tag: "tst", team: "b", expected: 1},
+		{name: "Unknown team", tag: "tst", team: "c"},
+		{name: "Archived event", tag: "old", expected: 1},
+		{name: "Unknown event", tag: "other", err: "Unable to find event by that tag"},
+		{name: "Unauthorized", unauthorized: true, tag: "tst", err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := &fakeEvent{
+				conf: store.EventConfig{Tag: store.Tag("tst")},
+				subs: []event.Submission{
+					{TeamId: "a", Tag: "sql-1", Result: ctfd.AttemptIncorrect, Value: "2c26b46b", Hashed: true, Time: at},
+					{TeamId: "b", Tag: "sql-1", Result: ctfd.AttemptCorrect, Value: "HKN{flag}", Time: at},
+				},
+			}
+
+			ctx := context.Background()
+			d := &daemon{
+				conf:      &Config{EventsDir: tmp},
+				eventPool: NewEventPool(""),
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+			d.startEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.ListSubmissions(ctx, &pb.ListSubmissionsRequest{EventTag: tc.tag, TeamId: tc.team})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != "" {
+					if tc.err != err.Error() {
+						t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+					}
+
+					return
+				}
+
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if n := len(resp.Submissions); n != tc.expected {
+				t.Fatalf("expected %d submissions, received: %d", tc.expected, n)
+			}
+
+			for _, s := range resp.Submissions {
+				if tc.team != "" && s.TeamId != tc.team {
+					t.Fatalf("expected only submissions of team %s, received: %v", tc.team, s)
+				}
+
+				if s.Correct != (s.TeamId == "b") || s.Hashed != (s.TeamId == "a") {
+					t.Fatalf("unexpected submission: %v", s)
+				}
+
+				if s.Time != at.Format(time.RFC3339Nano) {
+					t.Fatalf("unexpected submission time: %s", s.Time)
+				}
+			}
+		})
+	}
+}
+
+func TestExportEvent(t *testing.T) {
+	created := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	solved := created.Add(90 * time.Second)
+	finished := created.Add(time.Hour)
+
+	team := store.Team{
+		Id:        "team1",
+		Name:      "Team One",
+		CreatedAt: &created,
+		SolvedChallenges: []store.Challenge{
+			{FlagTag: "sql-1", CompletedAt: &solved},
+		},
+	}
+	conf := store.EventConfig{
+		Name: "Test",
+		Tag:  store.Tag("tst"),
+		Lab: store.Lab{
+			Exercises: []store.Tag{"sql"},
+		},
+	}
+
+	tmp, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	archived := conf
+	archived.Tag = store.Tag("old")
+	archived.FinishedAt = &finished
+	ef := store.NewEventFile(tmp, "old-01-01-20.yml", store.RawEventFile{EventConfig: archived, Teams: []store.Team{team}})
+	if err := ef.Archive(); err != nil {
+		t.Fatalf("unable to archive event: %s", err)
+	}
+
+	// the exercise of the event has since been removed from the library,
+	// but the flags of the event were kept
+	snapshot := conf
+	snapshot.Tag = store.Tag("snap")
+	snapshot.FinishedAt = &finished
+	snapshot.Lab.Exercises = []store.Tag{"removed"}
+	snapshot.Flags = []store.FlagConfig{
+		{Tag: "sql-1", Name: "Injection", Static: "HKN{static}", Points: 10, Category: "Web"},
+		{Tag: "sql-2", Name: "Blind", EnvVar: "FLAG2", Points: 20, Category: "Web"},
+	}
+	ef = store.NewEventFile(tmp, "snap-01-01-20.yml", store.RawEventFile{EventConfig: snapshot, Teams: []store.Team{team}})
+	if err := ef.Archive(); err != nil {
+		t.Fatalf("unable to archive event: %s", err)
+	}
+
+	exStore, err := store.NewExerciseStore([]store.Exercise{{
+		Tags: []store.Tag{"sql"},
+		DockerConfs: []store.DockerConfig{{
+			ExerciseInstanceConfig: store.ExerciseInstanceConfig{
+				InstanceConfig: store.InstanceConfig{Image: "sql"},
+				Flags: []store.FlagConfig{
+					{Tag: "sql-1", Name: "Injection", EnvVar: "FLAG1", Points: 10, Category: "Web"},
+					{Tag: "sql-2", Name: "Blind", EnvVar: "FLAG2", Points: 20, Category: "Web"},
+				},
+			},
+		}},
+	}})
+	if err != nil {
+		t.Fatalf("unable to create exercise store: %s", err)
+	}
+
+	tt := []struct {
+		name         string
+		unauthorized bool
+		tag          string
+		teamName     string
+		err          string
+	}{
+		{name: "Running event", tag: "tst", teamName: "Team One"},
+		{name: "Archived event by tag", tag: "old"},
+		{name: "Archived event by directory", tag: "old-01-01-20"},
+		{name: "Archived event with flags", tag: "snap"},
+		{name: "Unknown event", tag: "unknown", err: "Unable to find event by that tag"},
+		{name: "Unauthorized", unauthorized: true, tag: "tst", err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev := &fakeEvent{conf: conf, teams: []store.Team{team}}
+
+			ctx := context.Background()
+			d := &daemon{
+				conf:      &Config{EventsDir: tmp},
+				eventPool: NewEventPool(""),
+				exercises: exStore,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+			d.startEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.ExportEvent(ctx, &pb.ExportEventRequest{Tag: tc.tag})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != "" {
+					if tc.err != err.Error() {
+						t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+					}
+
+					return
+				}
+
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if n := len(resp.Rows); n != 2 {
+				t.Fatalf("expected a row per challenge, received: %d", n)
+			}
+
+			first, second := resp.Rows[0], resp.Rows[1]
+			if first.TeamId != "team1" || first.TeamName != tc.teamName {
+				t.Fatalf("unexpected team in export: %s (%s)", first.TeamId, first.TeamName)
+			}
+
+			if first.ChallengeTag != "sql-1" || first.Points != 10 || first.Category != "Web" {
+				t.Fatalf("unexpected challenge in export: %v", first)
+			}
+
+			if first.CompletedAt != solved.Format(time.RFC3339) || first.SecondsSinceTeamCreation != 90 {
+				t.Fatalf("unexpected completion in export: %s (%d)", first.CompletedAt, first.SecondsSinceTeamCreation)
+			}
+
+			if second.ChallengeTag != "sql-2" || second.CompletedAt != "" {
+				t.Fatalf("expected unsolved challenge in export, but received: %v", second)
+			}
+		})
+	}
+}
+
+func TestListEventTeams(t *testing.T) {
+	tt := []struct {
+		name           string
+		unauthorized   bool
+		tag            string
+		err            string
+		nExpectedTeams int
+	}{
+		{name: "Normal", tag: "tst", nExpectedTeams: 4},
+		{name: "Unauthorized", unauthorized: true, err: "unauthorized"},
+		{name: "Unknown event", tag: "unknown", err: UnknownEventErr.Error()},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			eventPool := NewEventPool("")
+
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: eventPool,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+
+			ev := fakeEvent{conf: store.EventConfig{Tag: store.Tag(tc.tag)}, teams: []store.Team{}, lab: &fakeLab{environment: &fakeEnvironment{}}}
+			for i := 0; i < tc.nExpectedTeams; i++ {
+				g := store.Team{}
+				ev.teams = append(ev.teams, g)
+			}
+			eventPool.AddEvent(&ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.ListEventTeams(ctx, &pb.ListEventTeamsRequest{Tag: tc.tag})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+				if err.Error() != tc.err {
+					t.Fatalf("expected error '%s', but got '%s'", tc.err, err.Error())
+				}
+			} else {
+				if len(resp.Teams) != tc.nExpectedTeams {
+					t.Fatalf("expected %d teams, but got %d", tc.nExpectedTeams, len(resp.Teams))
+				}
+			}
+		})
+	}
+}
+
+func TestResetExercise(t *testing.T) {
+	tt := []struct {
+		name         string
+		unauthorized bool
+		extag        string
+		evtag        string
+		teams        []*pb.Team
+		err          string
+		expected     int
+	}{
+		{
+			name:     "Reset specific team",
+			extag:    "sql",
+			evtag:    "tst",
+			teams:    []*pb.Team{{Id: "team-1"}},
+			expected: 1,
+		},
+		{
+			name:     "Reset all teams",
+			extag:    "sql",
+			evtag:    "tst",
+			teams:    nil,
+			expected: 2,
+		},
+		{
+			name:         "Unauthorized",
+			extag:        "sql",
+			evtag:        "tst",
+			teams:        []*pb.Team{{Id: "team-1"}},
+			unauthorized: true,
+			err:          "unauthorized",
+		},
+		{
+			name:  "Unknown event",
+			extag: "sql",
+			evtag: "unknown",
+			teams: []*pb.Team{{Id: "team-1"}},
+			err:   UnknownEventErr.Error(),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			eventPool := NewEventPool("")
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: eventPool,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+
+			ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}, lab: &fakeLab{environment: &fakeEnvironment{}}}
+			for i := 1; i <= 2; i++ {
+				g := store.Team{Id: fmt.Sprintf("team-%d", i)}
+				ev.teams = append(ev.teams, g)
+			}
+			eventPool.AddEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			stream, err := client.ResetExercise(ctx, &pb.ResetExerciseRequest{
+				ExerciseTag: tc.extag,
+				EventTag:    tc.evtag,
+				Teams:       tc.teams,
+			})
+			if err != nil {
+				t.Fatalf("expected no error when initiating connection, but received: %s", err)
+			}
+
+			count := 0
+			for {
+				_, err := stream.Recv()
+				if err != nil {
+					break
+				}
+				count += 1
+			}
+
+			if err != nil && err != io.EOF {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+				if tc.err != "" && err.Error() != tc.err {
+					t.Fatalf("expected error '%s', but got '%s'", tc.err, err.Error())
+				}
+				return
+			}
+
+			if count != tc.expected {
+				t.Fatalf("Expected %d resets, but observed %d", tc.expected, count)
+			}
+		})
+	}
+}
+
+func TestResizeFrontends(t *testing.T) {
+	tt := []struct {
+		name         string
+		unauthorized bool
+		evtag        string
+		memoryMB     int64
+		cpu          float32
+		teams        []*pb.Team
+		err          string
+		expected     int
+	}{
+		{name: "Resize specific team", evtag: "tst", memoryMB: 4096, teams: []*pb.Team{{Id: "team-1"}}, expected: 1},
+		{name: "Resize all teams", evtag: "tst", cpu: 1.5, expected: 2},
+		{name: "Nothing to resize", evtag: "tst", err: NoResizeErr.Error()},
+		{name: "Negative memory", evtag: "tst", memoryMB: -1, err: NegativeMemoryErr.Error()},
+		{name: "Negative cpu", evtag: "tst", cpu: -1, err: "cpu cannot be negative"},
+		{name: "Unknown event", evtag: "unknown", memoryMB: 4096, err: UnknownEventErr.Error()},
+		{name: "Unauthorized", unauthorized: true, evtag: "tst", memoryMB: 4096, err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			eventPool := NewEventPool("")
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: eventPool,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+
+			lab := &fakeLab{}
+			ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}, lab: lab}
+			for i := 1; i <= 2; i++ {
+				ev.teams = append(ev.teams, store.Team{Id: fmt.Sprintf("team-%d", i)})
+			}
+			eventPool.AddEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			stream, err := client.ResizeFrontends(ctx, &pb.ResizeFrontendsRequest{
+				EventTag: tc.evtag,
+				Image:    "kali",
+				MemoryMB: tc.memoryMB,
+				Cpu:      tc.cpu,
+				Teams:    tc.teams,
+			})
+			if err != nil {
+				t.Fatalf("expected no error when initiating connection, but received: %s", err)
+			}
+
+			var statuses []*pb.ResetTeamStatus
+			for {
+				var s *pb.ResetTeamStatus
+				s, err = stream.Recv()
+				if err != nil {
+					break
+				}
+				statuses = append(statuses, s)
+			}
+
+			if err != io.EOF {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != err.Error() {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if len(statuses) != tc.expected || lab.resized != tc.expected {
+				t.Fatalf("expected %d resized labs, but received: %d (statuses: %v)", tc.expected, lab.resized, statuses)
+			}
+
+			for _, s := range statuses {
+				if s.Status != "ok" {
+					t.Fatalf("expected status ok, but received: %s", s.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestSnapshotRestoreTeamLab(t *testing.T) {
+	tt := []struct {
+		name         string
+		unauthorized bool
+		evtag        string
+		noLab        bool
+		snapshot     string
+		restore      string
+		err          string
+	}{
+		{name: "Normal", evtag: "tst", snapshot: "checkpoint", restore: "checkpoint"},
+		{name: "Unknown snapshot", evtag: "tst", snapshot: "checkpoint", restore: "other", err: lab.UnknownSnapshotErr.Error()},
+		{name: "Unknown event", evtag: "unknown", snapshot: "checkpoint", err: UnknownEventErr.Error()},
+		{name: "No lab", evtag: "tst", noLab: true, snapshot: "checkpoint", err: NoLabByTeamIdErr.Error()},
+		{name: "Unauthorized", unauthorized: true, evtag: "tst", snapshot: "checkpoint", err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			eventPool := NewEventPool("")
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: eventPool,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+
+			l := &fakeLab{}
+			ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}, teams: []store.Team{{Id: "team-1"}}}
+			if !tc.noLab {
+				ev.lab = l
+			}
+			eventPool.AddEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			_, err = client.SnapshotTeamLab(ctx, &pb.SnapshotTeamLabRequest{
+				EventTag: tc.evtag,
+				TeamId:   "team-1",
+				Name:     tc.snapshot,
+			})
+			if err == nil && tc.restore != "" {
+				_, err = client.RestoreTeamLab(ctx, &pb.RestoreTeamLabRequest{
+					EventTag: tc.evtag,
+					TeamId:   "team-1",
+					Name:     tc.restore,
+				})
+			}
+
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != err.Error() {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if len(l.snapshots) != 1 || l.snapshots[0] != tc.snapshot {
+				t.Fatalf("expected snapshot %s to be taken, but received: %v", tc.snapshot, l.snapshots)
+			}
+
+			if len(l.restored) != 1 || l.restored[0] != tc.restore {
+				t.Fatalf("expected snapshot %s to be restored, but received: %v", tc.restore, l.restored)
+			}
+		})
+	}
+}
+
+func TestAddRemoveEventExercises(t *testing.T) {
+	tt := []struct {
+		name         string
+		unauthorized bool
+		evtag        string
+		add          []string
+		remove       []string
+		expected     []store.Tag
+		err          string
+	}{
+		{name: "Normal", evtag: "tst", add: []string{"hb", "sql"}, remove: []string{"hb"}, expected: []store.Tag{"sql"}},
+		{name: "Unknown exercise", evtag: "tst", add: []string{"unknown"}, err: "Unknown exercise tag: unknown"},
+		{name: "No exercises", evtag: "tst", err: InvalidArgumentsErr.Error()},
+		{name: "Unknown event", evtag: "unknown", add: []string{"hb"}, err: UnknownEventErr.Error()},
+		{name: "Unauthorized", unauthorized: true, evtag: "tst", add: []string{"hb"}, err: "unauthorized"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			exStore, err := store.NewExerciseStore([]store.Exercise{
+				{Tags: []store.Tag{"hb"}},
+				{Tags: []store.Tag{"sql"}},
+			})
+			if err != nil {
+				t.Fatalf("Error %v", err)
+			}
+
+			eventPool := NewEventPool("")
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: eventPool,
+				exercises: exStore,
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+
+			ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}}
+			eventPool.AddEvent(ev)
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			_, err = client.AddExercisesToEvent(ctx, &pb.EventExercisesRequest{
+				EventTag:  tc.evtag,
+				Exercises: tc.add,
+			})
+			if err == nil && len(tc.remove) > 0 {
+				_, err = client.RemoveExercisesFromEvent(ctx, &pb.EventExercisesRequest{
+					EventTag:  tc.evtag,
+					Exercises: tc.remove,
+				})
+			}
+
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != err.Error() {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+				}
+
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if len(ev.exercises) != len(tc.expected) {
+				t.Fatalf("expected exercises %v, got: %v", tc.expected, ev.exercises)
+			}
+
+			for i, e := range tc.expected {
+				if ev.exercises[i] != e {
+					t.Fatalf("expected exercises %v, got: %v", tc.expected, ev.exercises)
+				}
+			}
+		})
+	}
+}
+
+const testExercisesFile = `exercises:
+- name: SQL
+  tags: [sql]
+  docker:
+  - image: sql:%s
+    flag:
+    - tag: sql-1
+      name: SQL
+      env: FLAG
+      points: 10
+`
+
+func TestUpdateExercisesFile(t *testing.T) {
+	tt := []struct {
+		name    string
+		content string
+		dryRun  bool
+		applied bool
+		changed int
+		affect  bool
+		invalid bool
+	}{
+		{name: "Dry run", content: fmt.Sprintf(testExercisesFile, "2"), dryRun: true, changed: 1, affect: true},
+		{name: "Apply", content: fmt.Sprintf(testExercisesFile, "2"), applied: true, changed: 1, affect: true},
+		{name: "Unchanged", content: fmt.Sprintf(testExercisesFile, "1"), applied: true},
+		{name: "Invalid", content: "exercises:\n- name: SQL\n", invalid: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tmp, err := ioutil.TempDir("", "exercises")
+			if err != nil {
+				t.Fatalf("unable to create temporary directory: %s", err)
+			}
+			defer os.RemoveAll(tmp)
+
+			path := filepath.Join(tmp, "exercises.yml")
+			if err := ioutil.WriteFile(path, []byte(fmt.Sprintf(testExercisesFile, "1")), 0644); err != nil {
+				t.Fatalf("unable to write exercises file: %s", err)
+			}
+
+			exStore, err := store.NewExerciseFile(path)
+			if err != nil {
+				t.Fatalf("unable to read exercises file: %s", err)
+			}
+
+			if err := ioutil.WriteFile(path, []byte(tc.content), 0644); err != nil {
+				t.Fatalf("unable to write exercises file: %s", err)
+			}
+
+			eventPool := NewEventPool("")
+			eventPool.AddEvent(&fakeEvent{conf: store.EventConfig{
+				Tag: store.Tag("tst"),
+				Lab: store.Lab{Exercises: []store.Tag{"sql"}},
+			}})
+
+			d := &daemon{
+				conf:      &Config{ExercisesFile: path},
+				eventPool: eventPool,
+				exercises: exStore,
+				ehost:     &fakeEventHost{},
+				auth:      &noAuth{allowed: true},
+			}
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.UpdateExercisesFile(ctx, &pb.UpdateExercisesFileRequest{DryRun: tc.dryRun})
+			if err != nil {
+				t.Fatalf("unexpected error when updating exercises file: %s", err)
+			}
+
+			if tc.invalid != (resp.ValidationError != "") {
+				t.Fatalf("unexpected validation error: %q", resp.ValidationError)
+			}
 
+			if tc.invalid {
 				return
 			}
 
-			if tc.err != "" {
-				t.Fatalf("expected error, but received none")
+			if resp.Applied != tc.applied {
+				t.Fatalf("expected applied to be %t", tc.applied)
 			}
 
-			if n := len(resp.Events); n != tc.count {
-				t.Fatalf("unexpected amount of events (expected: %d), received: %d", tc.count, n)
+			if n := len(resp.Diff.Changed); n != tc.changed {
+				t.Fatalf("expected %d changed exercises, got %d", tc.changed, n)
+			}
+
+			if tc.affect && (len(resp.AffectedEvents) != 1 || resp.AffectedEvents[0].Tag != "tst") {
+				t.Fatalf("expected event to be affected, got: %v", resp.AffectedEvents)
+			}
+
+			expected := "sql:1"
+			if tc.applied && tc.changed > 0 {
+				expected = "sql:2"
 			}
 
+			if image := d.getExercises().ListExercises()[0].DockerConfs[0].Image; image != expected {
+				t.Fatalf("expected image %s after update, got: %s", expected, image)
+			}
 		})
 	}
 }
 
-func TestListEventTeams(t *testing.T) {
+func TestWatchExercisesFile(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "exercises")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	path := filepath.Join(tmp, "exercises.yml")
+	if err := ioutil.WriteFile(path, []byte(fmt.Sprintf(testExercisesFile, "1")), 0644); err != nil {
+		t.Fatalf("unable to write exercises file: %s", err)
+	}
+
+	exStore, err := store.NewExerciseFile(path)
+	if err != nil {
+		t.Fatalf("unable to read exercises file: %s", err)
+	}
+
+	d := &daemon{
+		conf:      &Config{ExercisesFile: path},
+		eventPool: NewEventPool(""),
+		exercises: exStore,
+		ehost:     &fakeEventHost{},
+	}
+
+	w, err := d.watchExercisesFile(true)
+	if err != nil {
+		t.Fatalf("unable to watch exercises file: %s", err)
+	}
+	defer w.Close()
+
+	if err := ioutil.WriteFile(path, []byte(fmt.Sprintf(testExercisesFile, "2")), 0644); err != nil {
+		t.Fatalf("unable to write exercises file: %s", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if d.getExercises().ListExercises()[0].DockerConfs[0].Image == "sql:2" {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	t.Fatalf("expected exercises file to be reloaded")
+}
+
+func TestValidateExercisesFile(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "exercises")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	path := filepath.Join(tmp, "exercises.yml")
+	if err := ioutil.WriteFile(path, []byte(fmt.Sprintf(testExercisesFile, "1")), 0644); err != nil {
+		t.Fatalf("unable to write exercises file: %s", err)
+	}
+
 	tt := []struct {
-		name           string
-		unauthorized   bool
-		tag            string
-		err            string
-		nExpectedTeams int
+		name    string
+		content string
+		issues  int
 	}{
-		{name: "Normal", tag: "tst", nExpectedTeams: 4},
-		{name: "Unauthorized", unauthorized: true, err: "unauthorized"},
-		{name: "Unknown event", tag: "unknown", err: UnknownEventErr.Error()},
+		{name: "Daemon file"},
+		{name: "Given file", content: "exercises:\n- {name: SQL, tags: [sql]}\n- {name: SQL 2, tags: [sql]}\n", issues: 1},
 	}
+
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
-			eventPool := NewEventPool("")
-
 			d := &daemon{
-				conf:      &Config{},
-				eventPool: eventPool,
-				auth: &noAuth{
-					allowed: !tc.unauthorized,
-				},
-			}
-
-			ev := fakeEvent{conf: store.EventConfig{Tag: store.Tag(tc.tag)}, teams: []store.Team{}, lab: &fakeLab{environment: &fakeEnvironment{}}}
-			for i := 0; i < tc.nExpectedTeams; i++ {
-				g := store.Team{}
-				ev.teams = append(ev.teams, g)
+				conf: &Config{ExercisesFile: path},
+				auth: &noAuth{allowed: true},
 			}
-			eventPool.AddEvent(&ev)
 
 			dialer, close := getServer(d)
 			defer close()
@@ -831,71 +2714,65 @@ func TestListEventTeams(t *testing.T) {
 				grpc.WithInsecure(),
 				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
 			)
-
 			if err != nil {
 				t.Fatalf("failed to dial bufnet: %v", err)
 			}
 			defer conn.Close()
 
 			client := pb.NewDaemonClient(conn)
-			resp, err := client.ListEventTeams(ctx, &pb.ListEventTeamsRequest{Tag: tc.tag})
+			resp, err := client.ValidateExercisesFile(ctx, &pb.ValidateExercisesFileRequest{Content: []byte(tc.content)})
 			if err != nil {
-				st, ok := status.FromError(err)
-				if ok {
-					err = fmt.Errorf(st.Message())
-				}
-				if err.Error() != tc.err {
-					t.Fatalf("expected error '%s', but got '%s'", tc.err, err.Error())
-				}
-			} else {
-				if len(resp.Teams) != tc.nExpectedTeams {
-					t.Fatalf("expected %d teams, but got %d", tc.nExpectedTeams, len(resp.Teams))
-				}
+				t.Fatalf("unexpected error when validating exercises file: %s", err)
+			}
+
+			if n := len(resp.Issues); n != tc.issues {
+				t.Fatalf("expected %d issues, received: %v", tc.issues, resp.Issues)
 			}
 		})
 	}
 }
 
-func TestResetExercise(t *testing.T) {
+func TestCreateTeams(t *testing.T) {
 	tt := []struct {
 		name         string
 		unauthorized bool
-		extag        string
 		evtag        string
-		teams        []*pb.Team
+		teams        []*pb.CreateTeamsRequest_Team
+		created      int
+		errs         int
 		err          string
-		expected     int
 	}{
 		{
-			name:     "Reset specific team",
-			extag:    "sql",
-			evtag:    "tst",
-			teams:    []*pb.Team{{Id: "team-1"}},
-			expected: 1,
-		},
-		{
-			name:     "Reset all teams",
-			extag:    "sql",
-			evtag:    "tst",
-			teams:    nil,
-			expected: 2,
+			name:  "Normal",
+			evtag: "tst",
+			teams: []*pb.CreateTeamsRequest_Team{
+				{Name: "team-1", Email: "team-1@example.com"},
+				{Name: "team-2", Email: "team-2@example.com"},
+			},
+			created: 2,
 		},
 		{
-			name:         "Unauthorized",
-			extag:        "sql",
-			evtag:        "tst",
-			teams:        []*pb.Team{{Id: "team-1"}},
-			unauthorized: true,
-			err:          "unauthorized",
+			name:  "Duplicate name",
+			evtag: "tst",
+			teams: []*pb.CreateTeamsRequest_Team{
+				{Name: "team-1", Email: "team-1@example.com"},
+				{Name: "team-1", Email: "other@example.com"},
+			},
+			created: 1,
+			errs:    1,
 		},
 		{
-			name:  "Unknown event",
-			extag: "sql",
-			evtag: "unknown",
-			teams: []*pb.Team{{Id: "team-1"}},
-			err:   UnknownEventErr.Error(),
+			name:    "Missing email",
+			evtag:   "tst",
+			teams:   []*pb.CreateTeamsRequest_Team{{Name: "team-1"}},
+			created: 0,
+			errs:    1,
 		},
+		{name: "No teams", evtag: "tst", err: InvalidArgumentsErr.Error()},
+		{name: "Unknown event", evtag: "unknown", teams: []*pb.CreateTeamsRequest_Team{{Name: "team-1", Email: "team-1@example.com"}}, err: UnknownEventErr.Error()},
+		{name: "Unauthorized", unauthorized: true, evtag: "tst", teams: []*pb.CreateTeamsRequest_Team{{Name: "team-1", Email: "team-1@example.com"}}, err: "unauthorized"},
 	}
+
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
@@ -909,11 +2786,7 @@ func TestResetExercise(t *testing.T) {
 				},
 			}
 
-			ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}, lab: &fakeLab{environment: &fakeEnvironment{}}}
-			for i := 1; i <= 2; i++ {
-				g := store.Team{Id: fmt.Sprintf("team-%d", i)}
-				ev.teams = append(ev.teams, g)
-			}
+			ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}}
 			eventPool.AddEvent(ev)
 
 			dialer, close := getServer(d)
@@ -924,44 +2797,52 @@ func TestResetExercise(t *testing.T) {
 				grpc.WithInsecure(),
 				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
 			)
-
 			if err != nil {
 				t.Fatalf("failed to dial bufnet: %v", err)
 			}
 			defer conn.Close()
 
 			client := pb.NewDaemonClient(conn)
-			stream, err := client.ResetExercise(ctx, &pb.ResetExerciseRequest{
-				ExerciseTag: tc.extag,
-				EventTag:    tc.evtag,
-				Teams:       tc.teams,
+			resp, err := client.CreateTeams(ctx, &pb.CreateTeamsRequest{
+				EventTag: tc.evtag,
+				Teams:    tc.teams,
 			})
 			if err != nil {
-				t.Fatalf("expected no error when initiating connection, but received: %s", err)
-			}
-
-			count := 0
-			for {
-				_, err := stream.Recv()
-				if err != nil {
-					break
-				}
-				count += 1
-			}
-
-			if err != nil && err != io.EOF {
 				st, ok := status.FromError(err)
 				if ok {
 					err = fmt.Errorf(st.Message())
 				}
-				if tc.err != "" && err.Error() != tc.err {
-					t.Fatalf("expected error '%s', but got '%s'", tc.err, err.Error())
+
+				if tc.err != err.Error() {
+					t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
 				}
+
 				return
 			}
 
-			if count != tc.expected {
-				t.Fatalf("Expected %d resets, but observed %d", tc.expected, count)
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			var created, errs int
+			for _, team := range resp.Teams {
+				if team.Error != "" {
+					errs += 1
+					continue
+				}
+
+				if team.Id == "" || len(team.Password) != passwordLength {
+					t.Fatalf("expected created team to have an id and a password, got: %+v", team)
+				}
+				created += 1
+			}
+
+			if created != tc.created || errs != tc.errs {
+				t.Fatalf("expected %d created and %d failed teams, got %d and %d", tc.created, tc.errs, created, errs)
+			}
+
+			if n := len(ev.teams); n != tc.created {
+				t.Fatalf("expected %d teams in event, got %d", tc.created, n)
 			}
 		})
 	}
@@ -976,7 +2857,7 @@ func TestListFrontends(t *testing.T) {
 	}{
 		{
 			name:           "Normal",
-			expectedImages: []string{"1/1", "2/2"},
+			expectedImages: []string{"1/1", "2/2", "kali-xrdp"},
 		},
 		{
 			name:         "Unauthorized",
@@ -1021,7 +2902,12 @@ func TestListFrontends(t *testing.T) {
 					OvaDir: tmpDir,
 				},
 				eventPool: NewEventPool(""),
-				frontends: &fakeFrontendStore{},
+				frontends: &fakeFrontendStore{
+					frontends: []store.InstanceConfig{
+						{Image: "kali", MemoryMB: 4096},
+						{Image: "kali-xrdp", Type: store.DockerFrontend},
+					},
+				},
 				auth: &noAuth{
 					allowed: !tc.unauthorized,
 				},
@@ -1064,6 +2950,90 @@ func TestListFrontends(t *testing.T) {
 					t.Fatalf("expected image '%s', but got '%s'", tc.expectedImages[i], f.Image)
 				}
 			}
+
+			if n := len(resp.Frontends); n > 0 && resp.Frontends[n-1].Type != store.DockerFrontend {
+				t.Fatalf("expected container frontend to be of type '%s', but got '%s'", store.DockerFrontend, resp.Frontends[n-1].Type)
+			}
+		})
+	}
+}
+
+func TestListWorkers(t *testing.T) {
+	tt := []struct {
+		name          string
+		workers       []worker.Remote
+		unauthorized  bool
+		err           string
+		expectedNames []string
+	}{
+		{name: "No workers"},
+		{
+			name:          "Normal",
+			workers:       []worker.Remote{{Name: "worker-1", Address: "127.0.0.1:1", AuthKey: "secret"}},
+			expectedNames: []string{"local", "worker-1"},
+		},
+		{name: "Unauthorized", unauthorized: true, err: "unauthorized"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			d := &daemon{
+				conf:      &Config{},
+				eventPool: NewEventPool(""),
+				auth: &noAuth{
+					allowed: !tc.unauthorized,
+				},
+			}
+
+			if len(tc.workers) > 0 {
+				s, err := worker.NewScheduler(tc.workers)
+				if err != nil {
+					t.Fatalf("unexpected error when creating scheduler: %s", err)
+				}
+				defer s.Close()
+				d.workers = s
+			}
+
+			dialer, close := getServer(d)
+			defer close()
+
+			conn, err := grpc.DialContext(ctx, "bufnet",
+				grpc.WithDialer(dialer),
+				grpc.WithInsecure(),
+				grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewDaemonClient(conn)
+			resp, err := client.ListWorkers(ctx, &pb.Empty{})
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+				if tc.err == "" || err.Error() != tc.err {
+					t.Fatalf("expected error '%s', but got '%s'", tc.err, err.Error())
+				}
+				return
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error '%s', but got none", tc.err)
+			}
+
+			if len(resp.Workers) != len(tc.expectedNames) {
+				t.Fatalf("expected %d workers, but got %d", len(tc.expectedNames), len(resp.Workers))
+			}
+
+			for i, w := range resp.Workers {
+				if w.Name != tc.expectedNames[i] {
+					t.Fatalf("expected worker '%s', but got '%s'", tc.expectedNames[i], w.Name)
+				}
+			}
 		})
 	}
 }
@@ -1168,3 +3138,125 @@ func TestGetTeamInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryAuditLog(t *testing.T) {
+	// the audit log is written through zerolog, which is disabled in tests
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	defer zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	logPool, err := logging.NewPool(dir)
+	if err != nil {
+		t.Fatalf("unable to create log pool: %s", err)
+	}
+	defer logPool.Close()
+
+	ev := &fakeEvent{conf: store.EventConfig{Tag: store.Tag("tst")}}
+	d := &daemon{
+		conf:      &Config{LogDir: dir},
+		eventPool: NewEventPool(""),
+		ehost:     &fakeEventHost{event: ev},
+		logPool:   logPool,
+		auth:      &noAuth{allowed: true, superuser: true},
+	}
+	d.eventPool.AddEvent(ev)
+
+	dialer, close := getServer(d)
+	defer close()
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+		grpc.WithPerRPCCredentials(cli.Creds{Insecure: true}),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewDaemonClient(conn)
+	if _, err := client.LoginUser(ctx, &pb.LoginUserRequest{Username: "some_user", Password: "secret"}); err != nil {
+		t.Fatalf("unable to login: %s", err)
+	}
+
+	stream, err := client.SuspendEvent(ctx, &pb.SuspendEventRequest{Tag: "tst"})
+	if err != nil {
+		t.Fatalf("unable to suspend event: %s", err)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if err != io.EOF {
+				t.Fatalf("unable to suspend event: %s", err)
+			}
+			break
+		}
+	}
+
+	tt := []struct {
+		name     string
+		req      pb.QueryAuditLogRequest
+		expected []string
+		err      string
+	}{
+		{name: "All", expected: []string{"LoginUser", "SuspendEvent"}},
+		{name: "Method", req: pb.QueryAuditLogRequest{Method: "suspendevent"}, expected: []string{"SuspendEvent"}},
+		{name: "Event", req: pb.QueryAuditLogRequest{EventTag: "tst"}, expected: []string{"SuspendEvent"}},
+		{name: "User", req: pb.QueryAuditLogRequest{User: "some_user"}, expected: []string{"LoginUser", "SuspendEvent"}},
+		{name: "Unknown user", req: pb.QueryAuditLogRequest{User: "other_user"}},
+		{name: "Since", req: pb.QueryAuditLogRequest{Since: time.Now().Add(24 * time.Hour).Format("2006-01-02")}},
+		{name: "Until", req: pb.QueryAuditLogRequest{Until: time.Now().Add(24 * time.Hour).Format("2006-01-02")}, expected: []string{"LoginUser", "SuspendEvent"}},
+		{name: "Limit", req: pb.QueryAuditLogRequest{Limit: 1}, expected: []string{"SuspendEvent"}},
+		{name: "Invalid time", req: pb.QueryAuditLogRequest{Since: "yesterday"}, err: InvalidTimeErr.Error()},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := client.QueryAuditLog(ctx, &tc.req)
+			if err != nil {
+				st, ok := status.FromError(err)
+				if ok {
+					err = fmt.Errorf(st.Message())
+				}
+
+				if tc.err != "" {
+					if tc.err != err.Error() {
+						t.Fatalf("unexpected error (expected: %s) received: %s", tc.err, err)
+					}
+
+					return
+				}
+
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if n := len(resp.Entries); n != len(tc.expected) {
+				t.Fatalf("expected %d entries, received: %d", len(tc.expected), n)
+			}
+
+			for i, e := range resp.Entries {
+				if e.Method != tc.expected[i] || e.User != "some_user" {
+					t.Fatalf("unexpected entry: %v", e)
+				}
+
+				if e.Method == "SuspendEvent" && (e.EventTag != "tst" || e.Msg != "suspend event") {
+					t.Fatalf("expected entry of event, received: %v", e)
+				}
+			}
+		})
+	}
+
+	d.auth = &noAuth{allowed: true}
+	if _, err := client.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{}); err == nil {
+		t.Fatalf("expected users which are not admins to be denied")
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+// a single save of the exercises file usually results in several events,
+// so the file is only reloaded once it has been left alone for a while
+const exercisesReloadDelay = 500 * time.Millisecond
+
+func (d *daemon) getExercises() store.ExerciseStore {
+	d.exercisesLock.RLock()
+	defer d.exercisesLock.RUnlock()
+
+	return d.exercises
+}
+
+func tagsToStrings(tags []store.Tag) []string {
+	var res []string
+	for _, t := range tags {
+		res = append(res, string(t))
+	}
+
+	return res
+}
+
+func toPbExerciseChanges(changes []store.ExerciseChange) []*pb.ExercisesDiff_Exercise {
+	var res []*pb.ExercisesDiff_Exercise
+	for _, c := range changes {
+		res = append(res, &pb.ExercisesDiff_Exercise{
+			Tag:           string(c.Tag),
+			Name:          c.Name,
+			AddedFlags:    tagsToStrings(c.AddedFlags),
+			RemovedFlags:  tagsToStrings(c.RemovedFlags),
+			ChangedFlags:  tagsToStrings(c.ChangedFlags),
+			AddedImages:   c.AddedImages,
+			RemovedImages: c.RemovedImages,
+		})
+	}
+
+	return res
+}
+
+// affectedEvents lists the running events using any of the removed or
+// changed exercises, expects the exercises lock to be held
+func (d *daemon) affectedEvents(diff store.ExercisesDiff) []*pb.UpdateExercisesFileResponse_Event {
+	changed := map[store.Tag]bool{}
+	for _, t := range diff.Tags() {
+		changed[t] = true
+	}
+
+	var events []*pb.UpdateExercisesFileResponse_Event
+	for _, ev := range d.eventPool.GetAllEvents() {
+		conf := ev.GetConfig()
+
+		var exercises []string
+		for _, t := range conf.Lab.Exercises {
+			exer, err := d.exercises.GetExercisesByTags(t)
+			if err != nil || !changed[exer[0].Tags[0]] {
+				continue
+			}
+
+			exercises = append(exercises, string(t))
+		}
+
+		if len(exercises) > 0 {
+			events = append(events, &pb.UpdateExercisesFileResponse_Event{
+				Tag:       string(conf.Tag),
+				Exercises: exercises,
+			})
+		}
+	}
+
+	return events
+}
+
+func (d *daemon) updateExercises(dryRun bool) (*pb.UpdateExercisesFileResponse, error) {
+	d.exercisesLock.Lock()
+	defer d.exercisesLock.Unlock()
+
+	exercises, err := d.exercises.UpdateExercisesFile(d.conf.ExercisesFile)
+	if err != nil {
+		return &pb.UpdateExercisesFileResponse{
+			Msg:             "Exercises file is invalid",
+			ValidationError: err.Error(),
+		}, nil
+	}
+
+	diff := store.DiffExercises(d.exercises.ListExercises(), exercises.ListExercises())
+	resp := &pb.UpdateExercisesFileResponse{
+		Diff: &pb.ExercisesDiff{
+			Added:   toPbExerciseChanges(diff.Added),
+			Removed: toPbExerciseChanges(diff.Removed),
+			Changed: toPbExerciseChanges(diff.Changed),
+		},
+		AffectedEvents: d.affectedEvents(diff),
+	}
+
+	if dryRun {
+		resp.Msg = "Exercises file is valid, no changes applied"
+		return resp, nil
+	}
+
+	// update event host exercises store
+	if err := d.ehost.UpdateEventHostExercisesFile(exercises); err != nil {
+		return nil, err
+	}
+	// update daemons' exercises store
+	d.exercises = exercises
+
+	resp.Msg = "Exercises file updated"
+	resp.Applied = true
+	return resp, nil
+}
+
+func (d *daemon) UpdateExercisesFile(ctx context.Context, req *pb.UpdateExercisesFileRequest) (*pb.UpdateExercisesFileResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Bool("dry-run", req.DryRun).
+		Msg("update exercises file")
+
+	return d.updateExercises(req.DryRun)
+}
+
+// ValidateExercisesFile lints the given exercises file, or the one of the
+// daemon if no content is given
+func (d *daemon) ValidateExercisesFile(ctx context.Context, req *pb.ValidateExercisesFileRequest) (*pb.ValidateExercisesFileResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Int("size", len(req.Content)).
+		Msg("validate exercises file")
+
+	content := req.Content
+	if len(content) == 0 {
+		var err error
+		content, err = ioutil.ReadFile(d.conf.ExercisesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	issues, err := store.LintExercisesFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*pb.ValidateExercisesFileResponse_Issue
+	for _, i := range issues {
+		res = append(res, &pb.ValidateExercisesFileResponse_Issue{
+			Exercise: i.Exercise,
+			Msg:      i.Msg,
+		})
+	}
+
+	return &pb.ValidateExercisesFileResponse{Issues: res}, nil
+}
+
+func (d *daemon) reloadExercisesFile(autoApply bool) {
+	resp, err := d.updateExercises(!autoApply)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to reload exercises file")
+		return
+	}
+
+	if resp.ValidationError != "" {
+		log.Warn().
+			Str("error", resp.ValidationError).
+			Str("file", d.conf.ExercisesFile).
+			Msg("Exercises file is invalid")
+		return
+	}
+
+	diff := resp.Diff
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0 {
+		return
+	}
+
+	var events []string
+	for _, ev := range resp.AffectedEvents {
+		events = append(events, ev.Tag)
+	}
+
+	log.Info().
+		Int("added", len(diff.Added)).
+		Int("removed", len(diff.Removed)).
+		Int("changed", len(diff.Changed)).
+		Strs("affected-events", events).
+		Bool("applied", resp.Applied).
+		Msg("Exercises file changed")
+}
+
+// watchExercisesFile reloads the exercises file whenever it changes on disk,
+// valid changes are only applied if autoApply is set
+func (d *daemon) watchExercisesFile(autoApply bool) (io.Closer, error) {
+	path, err := filepath.Abs(d.conf.ExercisesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// editors tend to replace the file rather than writing to it, which
+	// would end a watch on the file itself
+	if err := w.Add(filepath.Dir(path)); err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	go func() {
+		var timer *time.Timer
+		for {
+			select {
+			case ev, ok := <-w.Events:
+				if !ok {
+					if timer != nil {
+						timer.Stop()
+					}
+					return
+				}
+
+				if filepath.Clean(ev.Name) != path || ev.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(exercisesReloadDelay, func() {
+					d.reloadExercisesFile(autoApply)
+				})
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+
+				log.Warn().Err(err).Msg("Error while watching exercises file")
+			}
+		}
+	}()
+
+	return w, nil
+}
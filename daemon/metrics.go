@@ -0,0 +1,104 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aau-network-security/haaukins/event"
+	"github.com/aau-network-security/haaukins/metrics"
+	"google.golang.org/grpc/status"
+)
+
+// eventMetrics reports the state of every running event whenever the
+// metrics endpoint is scraped.
+func (d *daemon) eventMetrics() []metrics.Collector {
+	events := func(f func(tag string, set func(float64, ...string), ev event.Event)) func(func(float64, ...string)) {
+		return func(set func(float64, ...string)) {
+			if d.eventPool == nil {
+				return
+			}
+
+			for _, ev := range d.eventPool.GetAllEvents() {
+				f(string(ev.GetConfig().Tag), set, ev)
+			}
+		}
+	}
+
+	return []metrics.Collector{
+		metrics.NewGaugeFunc(
+			"haaukins_event_teams",
+			"Number of teams signed up for an event.",
+			events(func(tag string, set func(float64, ...string), ev event.Event) {
+				set(float64(len(ev.GetTeams())), tag)
+			}),
+			"event",
+		),
+		metrics.NewGaugeFunc(
+			"haaukins_event_labs_queued",
+			"Number of labs ready to be assigned to teams.",
+			events(func(tag string, set func(float64, ...string), ev event.Event) {
+				if hub := ev.GetHub(); hub != nil {
+					set(float64(hub.Available()), tag)
+				}
+			}),
+			"event",
+		),
+		metrics.NewGaugeFunc(
+			"haaukins_event_labs_assigned",
+			"Number of labs assigned to teams.",
+			events(func(tag string, set func(float64, ...string), ev event.Event) {
+				var assigned int
+				for _, t := range ev.GetTeams() {
+					if _, ok := ev.GetLabByTeam(t.Id); ok {
+						assigned += 1
+					}
+				}
+				set(float64(assigned), tag)
+			}),
+			"event",
+		),
+		metrics.NewGaugeFunc(
+			"haaukins_event_instances",
+			"Number of containers and virtual machines of assigned labs by state.",
+			events(func(tag string, set func(float64, ...string), ev event.Event) {
+				for _, t := range ev.GetTeams() {
+					l, ok := ev.GetLabByTeam(t.Id)
+					if !ok {
+						continue
+					}
+
+					for _, i := range l.InstanceInfo() {
+						set(1, tag, i.Type, i.State.String())
+					}
+				}
+			}),
+			"event", "type", "state",
+		),
+	}
+}
+
+func observeCall(fullMethod string, started time.Time, err error) {
+	metrics.GrpcDuration.Observe(
+		time.Since(started).Seconds(),
+		methodName(fullMethod),
+		status.Code(err).String(),
+	)
+}
+
+func (d *daemon) metricsHandler() http.Handler {
+	reg := metrics.NewRegistry(
+		metrics.FlagSubmissions,
+		metrics.LabCreationDuration,
+		metrics.GrpcDuration,
+	)
+	reg.Register(d.eventMetrics()...)
+
+	m := http.NewServeMux()
+	m.Handle("/metrics", reg)
+
+	return m
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+)
+
+type fakeHub struct {
+	available int
+	lab.Hub
+}
+
+func (fh *fakeHub) Available() int {
+	return fh.available
+}
+
+type metricsEvent struct {
+	hub lab.Hub
+	*fakeEvent
+}
+
+func (me *metricsEvent) GetHub() lab.Hub {
+	return me.hub
+}
+
+func TestMetrics(t *testing.T) {
+	ev := &metricsEvent{
+		hub: &fakeHub{available: 3},
+		fakeEvent: &fakeEvent{
+			conf:  store.EventConfig{Tag: "tst"},
+			teams: []store.Team{{Id: "a"}, {Id: "b"}},
+			lab: &fakeLab{instances: []virtual.InstanceInfo{
+				{Image: "kali", Type: "vbox", State: virtual.Running},
+				{Image: "sql", Type: "docker", State: virtual.Running},
+				{Image: "dns", Type: "docker", State: virtual.Stopped},
+			}},
+		},
+	}
+
+	ep := NewEventPool("")
+	ep.AddEvent(ev)
+
+	d := &daemon{eventPool: ep}
+
+	w := httptest.NewRecorder()
+	d.metricsHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status OK, but received: %d", w.Code)
+	}
+
+	body := w.Body.String()
+	expected := []string{
+		`haaukins_event_teams{event="tst"} 2`,
+		`haaukins_event_labs_queued{event="tst"} 3`,
+		`haaukins_event_labs_assigned{event="tst"} 2`,
+		`haaukins_event_instances{event="tst",type="docker",state="running"} 2`,
+		`haaukins_event_instances{event="tst",type="docker",state="stopped"} 2`,
+		`haaukins_event_instances{event="tst",type="vbox",state="running"} 2`,
+		"# TYPE haaukins_flag_submissions_total counter",
+		"# TYPE haaukins_grpc_request_duration_seconds histogram",
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(body, e+"\n") {
+			t.Fatalf("expected metrics to contain \"%s\", but received:\n%s", e, body)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aau-network-security/haaukins/store"
+	"google.golang.org/grpc"
+)
+
+var (
+	NotEventOwnerErr = errors.New("Only the creator of the event is allowed to manage it")
+)
+
+// permission describes who is allowed to call a given RPC, admins are
+// always allowed. If owned is set, the caller must furthermore be the
+// creator of the event referenced by the request.
+type permission struct {
+	roles []store.Role
+	owned bool
+}
+
+var (
+	anyRole     = []store.Role{store.RoleEventManager, store.RoleObserver, store.RoleExerciseAuthor}
+	eventReader = []store.Role{store.RoleEventManager, store.RoleObserver}
+
+	permissions = map[string]permission{
+		"Version": {roles: anyRole},
+
+		"InviteUser":      {},
+		"SetUserRoles":    {},
+		"ListUsers":       {},
+		"DeleteUser":      {},
+		"ListSignupKeys":  {},
+		"RevokeSignupKey": {},
+		"QueryAuditLog":   {},
+
+		// users may only manage their own credentials unless admin
+		"ChangePassword": {roles: anyRole},
+		"RevokeTokens":   {roles: anyRole},
+
+		"CreateEvent":    {roles: []store.Role{store.RoleEventManager}},
+		"StopEvent":      {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"SuspendEvent":   {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"ResumeEvent":    {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"RestartTeamLab": {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"ResetExercise":  {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"ResetFrontends": {roles: []store.Role{store.RoleEventManager}, owned: true},
+
+		"AddExercisesToEvent":      {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"RemoveExercisesFromEvent": {roles: []store.Role{store.RoleEventManager}, owned: true},
+
+		"ResizeFrontends": {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"SnapshotTeamLab": {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"RestoreTeamLab":  {roles: []store.Role{store.RoleEventManager}, owned: true},
+
+		"CreateTeams":       {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"DeleteTeam":        {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"RenameTeam":        {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"ResetTeamPassword": {roles: []store.Role{store.RoleEventManager}, owned: true},
+		"MoveTeamLab":       {roles: []store.Role{store.RoleEventManager}, owned: true},
+
+		"ListEvents":      {roles: eventReader},
+		"ListEventTeams":  {roles: eventReader},
+		"GetScoreboard":   {roles: eventReader},
+		"StreamSolves":    {roles: eventReader},
+		"ExportEvent":     {roles: eventReader},
+		"GetTeamInfo":     {roles: eventReader},
+		"ListIncidents":   {roles: eventReader},
+		"ListSubmissions": {roles: eventReader},
+		"MonitorHost":     {roles: eventReader},
+		"ListWorkers":     {roles: eventReader},
+
+		"ListExercises":         {roles: anyRole},
+		"ListFrontends":         {roles: anyRole},
+		"UpdateExercisesFile":   {roles: []store.Role{store.RoleExerciseAuthor}},
+		"ValidateExercisesFile": {roles: []store.Role{store.RoleExerciseAuthor}},
+		"SetFrontendMemory":     {roles: []store.Role{store.RoleExerciseAuthor}},
+		"SetFrontendCpu":        {roles: []store.Role{store.RoleExerciseAuthor}},
+	}
+)
+
+type PermissionErr struct {
+	Roles []store.Role
+}
+
+func (pe *PermissionErr) Error() string {
+	if len(pe.Roles) == 0 {
+		return "This action requires super user permissions"
+	}
+
+	roles := make([]string, len(pe.Roles))
+	for i, r := range pe.Roles {
+		roles[i] = string(r)
+	}
+
+	return fmt.Sprintf("This action requires one of the roles: %s", strings.Join(roles, ", "))
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// unknown methods are restricted to admins
+func getPermission(fullMethod string) permission {
+	return permissions[methodName(fullMethod)]
+}
+
+func checkRoles(ctx context.Context, p permission) error {
+	u, _ := ctx.Value(us{}).(store.User)
+	if !u.HasRole(p.roles...) {
+		return &PermissionErr{Roles: p.roles}
+	}
+
+	return nil
+}
+
+type eventTagRequest interface {
+	GetEventTag() string
+}
+
+type tagRequest interface {
+	GetTag() string
+}
+
+func requestEventTag(req interface{}) (store.Tag, bool) {
+	switch r := req.(type) {
+	case eventTagRequest:
+		return store.Tag(r.GetEventTag()), true
+	case tagRequest:
+		return store.Tag(r.GetTag()), true
+	}
+
+	return "", false
+}
+
+func (d *daemon) eventOwner(tag store.Tag) string {
+	if d.eventPool != nil {
+		if ev, err := d.eventPool.GetEvent(tag); err == nil {
+			return ev.GetConfig().CreatedBy
+		}
+	}
+
+	if ef, ok := d.scheduler.getPendingByTag(tag); ok {
+		return ef.Read().CreatedBy
+	}
+
+	return ""
+}
+
+// checkOwnership ensures that non-admins only manage their own events,
+// events without a creator (from before ownership was recorded) can be
+// managed by anyone with the required role. Requests without an event
+// cannot be checked and are refused.
+func (d *daemon) checkOwnership(ctx context.Context, req interface{}) error {
+	u, _ := ctx.Value(us{}).(store.User)
+	if u.HasRole(store.RoleAdmin) {
+		return nil
+	}
+
+	tag, ok := requestEventTag(req)
+	if !ok {
+		return NotEventOwnerErr
+	}
+
+	owner := d.eventOwner(tag)
+	if owner != "" && owner != u.Username {
+		return NotEventOwnerErr
+	}
+
+	return nil
+}
+
+// ownershipStream checks the ownership of the event referenced by the
+// request, as streaming requests are only available once received.
+type ownershipStream struct {
+	grpc.ServerStream
+	d *daemon
+}
+
+func (s *ownershipStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.d.checkOwnership(s.Context(), m)
+}
@@ -214,6 +214,7 @@ func (m *SignupUserRequest) GetPassword() string {
 
 type InviteUserRequest struct {
 	SuperUser            bool     `protobuf:"varint,1,opt,name=super_user,json=superUser,proto3" json:"super_user,omitempty"`
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -251,6 +252,13 @@ func (m *InviteUserRequest) GetSuperUser() bool {
 	return false
 }
 
+func (m *InviteUserRequest) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type InviteUserResponse struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -298,2132 +306,6154 @@ func (m *InviteUserResponse) GetError() string {
 	return ""
 }
 
-type CreateEventRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Frontends            []string `protobuf:"bytes,3,rep,name=frontends,proto3" json:"frontends,omitempty"`
-	Exercises            []string `protobuf:"bytes,4,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	Available            int32    `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
-	Capacity             int32    `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	FinishTime           string   `protobuf:"bytes,7,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+type SetUserRolesRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateEventRequest) Reset()         { *m = CreateEventRequest{} }
-func (m *CreateEventRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateEventRequest) ProtoMessage()    {}
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+func (m *SetUserRolesRequest) Reset()         { *m = SetUserRolesRequest{} }
+func (m *SetUserRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUserRolesRequest) ProtoMessage()    {}
+func (*SetUserRolesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{6}
 }
 
-func (m *CreateEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateEventRequest.Unmarshal(m, b)
+func (m *SetUserRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRolesRequest.Unmarshal(m, b)
 }
-func (m *CreateEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateEventRequest.Marshal(b, m, deterministic)
+func (m *SetUserRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRolesRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateEventRequest.Merge(m, src)
+func (m *SetUserRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRolesRequest.Merge(m, src)
 }
-func (m *CreateEventRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateEventRequest.Size(m)
+func (m *SetUserRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserRolesRequest.Size(m)
 }
-func (m *CreateEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateEventRequest.DiscardUnknown(m)
+func (m *SetUserRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateEventRequest proto.InternalMessageInfo
-
-func (m *CreateEventRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_SetUserRolesRequest proto.InternalMessageInfo
 
-func (m *CreateEventRequest) GetTag() string {
+func (m *SetUserRolesRequest) GetUsername() string {
 	if m != nil {
-		return m.Tag
+		return m.Username
 	}
 	return ""
 }
 
-func (m *CreateEventRequest) GetFrontends() []string {
-	if m != nil {
-		return m.Frontends
-	}
-	return nil
-}
-
-func (m *CreateEventRequest) GetExercises() []string {
+func (m *SetUserRolesRequest) GetRoles() []string {
 	if m != nil {
-		return m.Exercises
+		return m.Roles
 	}
 	return nil
 }
 
-func (m *CreateEventRequest) GetAvailable() int32 {
-	if m != nil {
-		return m.Available
-	}
-	return 0
-}
-
-func (m *CreateEventRequest) GetCapacity() int32 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
-}
-
-func (m *CreateEventRequest) GetFinishTime() string {
-	if m != nil {
-		return m.FinishTime
-	}
-	return ""
-}
-
-type ListEventsRequest struct {
+type SetUserRolesResponse struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
-func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListEventsRequest) ProtoMessage()    {}
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+func (m *SetUserRolesResponse) Reset()         { *m = SetUserRolesResponse{} }
+func (m *SetUserRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*SetUserRolesResponse) ProtoMessage()    {}
+func (*SetUserRolesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{7}
 }
 
-func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
+func (m *SetUserRolesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRolesResponse.Unmarshal(m, b)
 }
-func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
+func (m *SetUserRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRolesResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEventsRequest.Merge(m, src)
+func (m *SetUserRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRolesResponse.Merge(m, src)
 }
-func (m *ListEventsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListEventsRequest.Size(m)
+func (m *SetUserRolesResponse) XXX_Size() int {
+	return xxx_messageInfo_SetUserRolesResponse.Size(m)
 }
-func (m *ListEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
+func (m *SetUserRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRolesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetUserRolesResponse proto.InternalMessageInfo
 
-type ListEventsResponse struct {
-	Events               []*ListEventsResponse_Events `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+func (m *SetUserRolesResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
-func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEventsResponse) ProtoMessage()    {}
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+type ListUsersResponse struct {
+	Users                []*ListUsersResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{8}
 }
 
-func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
 }
-func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEventsResponse.Merge(m, src)
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
 }
-func (m *ListEventsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEventsResponse.Size(m)
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
 }
-func (m *ListEventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
 
-func (m *ListEventsResponse) GetEvents() []*ListEventsResponse_Events {
+func (m *ListUsersResponse) GetUsers() []*ListUsersResponse_User {
 	if m != nil {
-		return m.Events
+		return m.Users
 	}
 	return nil
 }
 
-type ListEventsResponse_Events struct {
-	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TeamCount            int32    `protobuf:"varint,3,opt,name=teamCount,proto3" json:"teamCount,omitempty"`
-	Exercises            string   `protobuf:"bytes,4,opt,name=exercises,proto3" json:"exercises,omitempty"`
-	Capacity             int32    `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	CreationTime         string   `protobuf:"bytes,6,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
-	FinishTime           string   `protobuf:"bytes,7,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+type ListUsersResponse_User struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SuperUser            bool     `protobuf:"varint,2,opt,name=superUser,proto3" json:"superUser,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	CreatedAt            string   `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEventsResponse_Events) Reset()         { *m = ListEventsResponse_Events{} }
-func (m *ListEventsResponse_Events) String() string { return proto.CompactTextString(m) }
-func (*ListEventsResponse_Events) ProtoMessage()    {}
-func (*ListEventsResponse_Events) Descriptor() ([]byte, []int) {
+func (m *ListUsersResponse_User) Reset()         { *m = ListUsersResponse_User{} }
+func (m *ListUsersResponse_User) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse_User) ProtoMessage()    {}
+func (*ListUsersResponse_User) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{8, 0}
 }
 
-func (m *ListEventsResponse_Events) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEventsResponse_Events.Unmarshal(m, b)
+func (m *ListUsersResponse_User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse_User.Unmarshal(m, b)
 }
-func (m *ListEventsResponse_Events) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEventsResponse_Events.Marshal(b, m, deterministic)
-}
-func (m *ListEventsResponse_Events) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEventsResponse_Events.Merge(m, src)
-}
-func (m *ListEventsResponse_Events) XXX_Size() int {
-	return xxx_messageInfo_ListEventsResponse_Events.Size(m)
+func (m *ListUsersResponse_User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse_User.Marshal(b, m, deterministic)
 }
-func (m *ListEventsResponse_Events) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEventsResponse_Events.DiscardUnknown(m)
+func (m *ListUsersResponse_User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse_User.Merge(m, src)
 }
-
-var xxx_messageInfo_ListEventsResponse_Events proto.InternalMessageInfo
-
-func (m *ListEventsResponse_Events) GetTag() string {
-	if m != nil {
-		return m.Tag
-	}
-	return ""
+func (m *ListUsersResponse_User) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse_User.Size(m)
 }
-
-func (m *ListEventsResponse_Events) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *ListUsersResponse_User) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse_User.DiscardUnknown(m)
 }
 
-func (m *ListEventsResponse_Events) GetTeamCount() int32 {
-	if m != nil {
-		return m.TeamCount
-	}
-	return 0
-}
+var xxx_messageInfo_ListUsersResponse_User proto.InternalMessageInfo
 
-func (m *ListEventsResponse_Events) GetExercises() string {
+func (m *ListUsersResponse_User) GetUsername() string {
 	if m != nil {
-		return m.Exercises
+		return m.Username
 	}
 	return ""
 }
 
-func (m *ListEventsResponse_Events) GetCapacity() int32 {
+func (m *ListUsersResponse_User) GetSuperUser() bool {
 	if m != nil {
-		return m.Capacity
+		return m.SuperUser
 	}
-	return 0
+	return false
 }
 
-func (m *ListEventsResponse_Events) GetCreationTime() string {
+func (m *ListUsersResponse_User) GetRoles() []string {
 	if m != nil {
-		return m.CreationTime
+		return m.Roles
 	}
-	return ""
+	return nil
 }
 
-func (m *ListEventsResponse_Events) GetFinishTime() string {
+func (m *ListUsersResponse_User) GetCreatedAt() string {
 	if m != nil {
-		return m.FinishTime
+		return m.CreatedAt
 	}
 	return ""
 }
 
-type ListEventTeamsRequest struct {
-	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+type DeleteUserRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEventTeamsRequest) Reset()         { *m = ListEventTeamsRequest{} }
-func (m *ListEventTeamsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListEventTeamsRequest) ProtoMessage()    {}
-func (*ListEventTeamsRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{9}
 }
 
-func (m *ListEventTeamsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEventTeamsRequest.Unmarshal(m, b)
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
 }
-func (m *ListEventTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEventTeamsRequest.Marshal(b, m, deterministic)
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
 }
-func (m *ListEventTeamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEventTeamsRequest.Merge(m, src)
+func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
 }
-func (m *ListEventTeamsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListEventTeamsRequest.Size(m)
+func (m *DeleteUserRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserRequest.Size(m)
 }
-func (m *ListEventTeamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEventTeamsRequest.DiscardUnknown(m)
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEventTeamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
 
-func (m *ListEventTeamsRequest) GetTag() string {
+func (m *DeleteUserRequest) GetUsername() string {
 	if m != nil {
-		return m.Tag
+		return m.Username
 	}
 	return ""
 }
 
-type ListEventTeamsResponse struct {
-	Teams                []*ListEventTeamsResponse_Teams `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+type DeleteUserResponse struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEventTeamsResponse) Reset()         { *m = ListEventTeamsResponse{} }
-func (m *ListEventTeamsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListEventTeamsResponse) ProtoMessage()    {}
-func (*ListEventTeamsResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteUserResponse) Reset()         { *m = DeleteUserResponse{} }
+func (m *DeleteUserResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserResponse) ProtoMessage()    {}
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{10}
 }
 
-func (m *ListEventTeamsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEventTeamsResponse.Unmarshal(m, b)
+func (m *DeleteUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserResponse.Unmarshal(m, b)
 }
-func (m *ListEventTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEventTeamsResponse.Marshal(b, m, deterministic)
+func (m *DeleteUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserResponse.Marshal(b, m, deterministic)
 }
-func (m *ListEventTeamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEventTeamsResponse.Merge(m, src)
+func (m *DeleteUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserResponse.Merge(m, src)
 }
-func (m *ListEventTeamsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListEventTeamsResponse.Size(m)
+func (m *DeleteUserResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserResponse.Size(m)
 }
-func (m *ListEventTeamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEventTeamsResponse.DiscardUnknown(m)
+func (m *DeleteUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEventTeamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteUserResponse proto.InternalMessageInfo
 
-func (m *ListEventTeamsResponse) GetTeams() []*ListEventTeamsResponse_Teams {
+func (m *DeleteUserResponse) GetError() string {
 	if m != nil {
-		return m.Teams
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-type ListEventTeamsResponse_Teams struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Email                string   `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
-	AccessedAt           string   `protobuf:"bytes,4,opt,name=AccessedAt,proto3" json:"AccessedAt,omitempty"`
+type ChangePasswordRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword          string   `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListEventTeamsResponse_Teams) Reset()         { *m = ListEventTeamsResponse_Teams{} }
-func (m *ListEventTeamsResponse_Teams) String() string { return proto.CompactTextString(m) }
-func (*ListEventTeamsResponse_Teams) ProtoMessage()    {}
-func (*ListEventTeamsResponse_Teams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{10, 0}
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{11}
 }
 
-func (m *ListEventTeamsResponse_Teams) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEventTeamsResponse_Teams.Unmarshal(m, b)
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
 }
-func (m *ListEventTeamsResponse_Teams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEventTeamsResponse_Teams.Marshal(b, m, deterministic)
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
 }
-func (m *ListEventTeamsResponse_Teams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEventTeamsResponse_Teams.Merge(m, src)
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
 }
-func (m *ListEventTeamsResponse_Teams) XXX_Size() int {
-	return xxx_messageInfo_ListEventTeamsResponse_Teams.Size(m)
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
 }
-func (m *ListEventTeamsResponse_Teams) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEventTeamsResponse_Teams.DiscardUnknown(m)
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListEventTeamsResponse_Teams proto.InternalMessageInfo
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
 
-func (m *ListEventTeamsResponse_Teams) GetId() string {
+func (m *ChangePasswordRequest) GetUsername() string {
 	if m != nil {
-		return m.Id
+		return m.Username
 	}
 	return ""
 }
 
-func (m *ListEventTeamsResponse_Teams) GetName() string {
+func (m *ChangePasswordRequest) GetOldPassword() string {
 	if m != nil {
-		return m.Name
+		return m.OldPassword
 	}
 	return ""
 }
 
-func (m *ListEventTeamsResponse_Teams) GetEmail() string {
+func (m *ChangePasswordRequest) GetNewPassword() string {
 	if m != nil {
-		return m.Email
+		return m.NewPassword
 	}
 	return ""
 }
 
-func (m *ListEventTeamsResponse_Teams) GetAccessedAt() string {
-	if m != nil {
-		return m.AccessedAt
-	}
-	return ""
-}
-
-type RestartTeamLabRequest struct {
-	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+type RevokeTokensRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestartTeamLabRequest) Reset()         { *m = RestartTeamLabRequest{} }
-func (m *RestartTeamLabRequest) String() string { return proto.CompactTextString(m) }
-func (*RestartTeamLabRequest) ProtoMessage()    {}
-func (*RestartTeamLabRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{11}
+func (m *RevokeTokensRequest) Reset()         { *m = RevokeTokensRequest{} }
+func (m *RevokeTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokensRequest) ProtoMessage()    {}
+func (*RevokeTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{12}
 }
 
-func (m *RestartTeamLabRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestartTeamLabRequest.Unmarshal(m, b)
+func (m *RevokeTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokensRequest.Unmarshal(m, b)
 }
-func (m *RestartTeamLabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestartTeamLabRequest.Marshal(b, m, deterministic)
+func (m *RevokeTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokensRequest.Marshal(b, m, deterministic)
 }
-func (m *RestartTeamLabRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestartTeamLabRequest.Merge(m, src)
+func (m *RevokeTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokensRequest.Merge(m, src)
 }
-func (m *RestartTeamLabRequest) XXX_Size() int {
-	return xxx_messageInfo_RestartTeamLabRequest.Size(m)
+func (m *RevokeTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokensRequest.Size(m)
 }
-func (m *RestartTeamLabRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestartTeamLabRequest.DiscardUnknown(m)
+func (m *RevokeTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokensRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestartTeamLabRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevokeTokensRequest proto.InternalMessageInfo
 
-func (m *RestartTeamLabRequest) GetEventTag() string {
+func (m *RevokeTokensRequest) GetUsername() string {
 	if m != nil {
-		return m.EventTag
+		return m.Username
 	}
 	return ""
 }
 
-func (m *RestartTeamLabRequest) GetTeamId() string {
+type RevokeTokensResponse struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokensResponse) Reset()         { *m = RevokeTokensResponse{} }
+func (m *RevokeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokensResponse) ProtoMessage()    {}
+func (*RevokeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{13}
+}
+
+func (m *RevokeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokensResponse.Unmarshal(m, b)
+}
+func (m *RevokeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokensResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokensResponse.Merge(m, src)
+}
+func (m *RevokeTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokensResponse.Size(m)
+}
+func (m *RevokeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokensResponse proto.InternalMessageInfo
+
+func (m *RevokeTokensResponse) GetError() string {
 	if m != nil {
-		return m.TeamId
+		return m.Error
 	}
 	return ""
 }
 
-type ResetExerciseRequest struct {
-	ExerciseTag          string   `protobuf:"bytes,1,opt,name=exerciseTag,proto3" json:"exerciseTag,omitempty"`
-	EventTag             string   `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	Teams                []*Team  `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+type ListSignupKeysResponse struct {
+	Keys                 []*ListSignupKeysResponse_SignupKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ListSignupKeysResponse) Reset()         { *m = ListSignupKeysResponse{} }
+func (m *ListSignupKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSignupKeysResponse) ProtoMessage()    {}
+func (*ListSignupKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{14}
+}
+
+func (m *ListSignupKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSignupKeysResponse.Unmarshal(m, b)
+}
+func (m *ListSignupKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSignupKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSignupKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSignupKeysResponse.Merge(m, src)
+}
+func (m *ListSignupKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSignupKeysResponse.Size(m)
+}
+func (m *ListSignupKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSignupKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSignupKeysResponse proto.InternalMessageInfo
+
+func (m *ListSignupKeysResponse) GetKeys() []*ListSignupKeysResponse_SignupKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ListSignupKeysResponse_SignupKey struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	SuperUser            bool     `protobuf:"varint,2,opt,name=superUser,proto3" json:"superUser,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResetExerciseRequest) Reset()         { *m = ResetExerciseRequest{} }
-func (m *ResetExerciseRequest) String() string { return proto.CompactTextString(m) }
-func (*ResetExerciseRequest) ProtoMessage()    {}
-func (*ResetExerciseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{12}
+func (m *ListSignupKeysResponse_SignupKey) Reset()         { *m = ListSignupKeysResponse_SignupKey{} }
+func (m *ListSignupKeysResponse_SignupKey) String() string { return proto.CompactTextString(m) }
+func (*ListSignupKeysResponse_SignupKey) ProtoMessage()    {}
+func (*ListSignupKeysResponse_SignupKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{14, 0}
 }
 
-func (m *ResetExerciseRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResetExerciseRequest.Unmarshal(m, b)
+func (m *ListSignupKeysResponse_SignupKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSignupKeysResponse_SignupKey.Unmarshal(m, b)
 }
-func (m *ResetExerciseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResetExerciseRequest.Marshal(b, m, deterministic)
+func (m *ListSignupKeysResponse_SignupKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSignupKeysResponse_SignupKey.Marshal(b, m, deterministic)
 }
-func (m *ResetExerciseRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetExerciseRequest.Merge(m, src)
+func (m *ListSignupKeysResponse_SignupKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSignupKeysResponse_SignupKey.Merge(m, src)
 }
-func (m *ResetExerciseRequest) XXX_Size() int {
-	return xxx_messageInfo_ResetExerciseRequest.Size(m)
+func (m *ListSignupKeysResponse_SignupKey) XXX_Size() int {
+	return xxx_messageInfo_ListSignupKeysResponse_SignupKey.Size(m)
 }
-func (m *ResetExerciseRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetExerciseRequest.DiscardUnknown(m)
+func (m *ListSignupKeysResponse_SignupKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSignupKeysResponse_SignupKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResetExerciseRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListSignupKeysResponse_SignupKey proto.InternalMessageInfo
 
-func (m *ResetExerciseRequest) GetExerciseTag() string {
+func (m *ListSignupKeysResponse_SignupKey) GetKey() string {
 	if m != nil {
-		return m.ExerciseTag
+		return m.Key
 	}
 	return ""
 }
 
-func (m *ResetExerciseRequest) GetEventTag() string {
+func (m *ListSignupKeysResponse_SignupKey) GetSuperUser() bool {
 	if m != nil {
-		return m.EventTag
+		return m.SuperUser
 	}
-	return ""
+	return false
 }
 
-func (m *ResetExerciseRequest) GetTeams() []*Team {
+func (m *ListSignupKeysResponse_SignupKey) GetRoles() []string {
 	if m != nil {
-		return m.Teams
+		return m.Roles
 	}
 	return nil
 }
 
-type UpdateExercisesFileResponse struct {
-	Msg                  string   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+type RevokeSignupKeyRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateExercisesFileResponse) Reset()         { *m = UpdateExercisesFileResponse{} }
-func (m *UpdateExercisesFileResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateExercisesFileResponse) ProtoMessage()    {}
-func (*UpdateExercisesFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{13}
+func (m *RevokeSignupKeyRequest) Reset()         { *m = RevokeSignupKeyRequest{} }
+func (m *RevokeSignupKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSignupKeyRequest) ProtoMessage()    {}
+func (*RevokeSignupKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{15}
 }
 
-func (m *UpdateExercisesFileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateExercisesFileResponse.Unmarshal(m, b)
+func (m *RevokeSignupKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSignupKeyRequest.Unmarshal(m, b)
 }
-func (m *UpdateExercisesFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateExercisesFileResponse.Marshal(b, m, deterministic)
+func (m *RevokeSignupKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSignupKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateExercisesFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateExercisesFileResponse.Merge(m, src)
+func (m *RevokeSignupKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSignupKeyRequest.Merge(m, src)
 }
-func (m *UpdateExercisesFileResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateExercisesFileResponse.Size(m)
+func (m *RevokeSignupKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSignupKeyRequest.Size(m)
 }
-func (m *UpdateExercisesFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateExercisesFileResponse.DiscardUnknown(m)
+func (m *RevokeSignupKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSignupKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateExercisesFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_RevokeSignupKeyRequest proto.InternalMessageInfo
 
-func (m *UpdateExercisesFileResponse) GetMsg() string {
+func (m *RevokeSignupKeyRequest) GetKey() string {
 	if m != nil {
-		return m.Msg
+		return m.Key
 	}
 	return ""
 }
 
-type ListExercisesResponse struct {
-	Exercises            []*ListExercisesResponse_Exercise `protobuf:"bytes,1,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+type RevokeSignupKeyResponse struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListExercisesResponse) Reset()         { *m = ListExercisesResponse{} }
-func (m *ListExercisesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListExercisesResponse) ProtoMessage()    {}
-func (*ListExercisesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{14}
+func (m *RevokeSignupKeyResponse) Reset()         { *m = RevokeSignupKeyResponse{} }
+func (m *RevokeSignupKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeSignupKeyResponse) ProtoMessage()    {}
+func (*RevokeSignupKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{16}
 }
 
-func (m *ListExercisesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListExercisesResponse.Unmarshal(m, b)
+func (m *RevokeSignupKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSignupKeyResponse.Unmarshal(m, b)
 }
-func (m *ListExercisesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListExercisesResponse.Marshal(b, m, deterministic)
+func (m *RevokeSignupKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSignupKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *ListExercisesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListExercisesResponse.Merge(m, src)
+func (m *RevokeSignupKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSignupKeyResponse.Merge(m, src)
 }
-func (m *ListExercisesResponse) XXX_Size() int {
-	return xxx_messageInfo_ListExercisesResponse.Size(m)
+func (m *RevokeSignupKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeSignupKeyResponse.Size(m)
 }
-func (m *ListExercisesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListExercisesResponse.DiscardUnknown(m)
+func (m *RevokeSignupKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSignupKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListExercisesResponse proto.InternalMessageInfo
+var xxx_messageInfo_RevokeSignupKeyResponse proto.InternalMessageInfo
 
-func (m *ListExercisesResponse) GetExercises() []*ListExercisesResponse_Exercise {
+func (m *RevokeSignupKeyResponse) GetError() string {
 	if m != nil {
-		return m.Exercises
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-type ListExercisesResponse_Exercise struct {
-	Tags                 []string                                       `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	Name                 string                                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DockerImageCount     int32                                          `protobuf:"varint,3,opt,name=dockerImageCount,proto3" json:"dockerImageCount,omitempty"`
-	VboxImageCount       int32                                          `protobuf:"varint,4,opt,name=vboxImageCount,proto3" json:"vboxImageCount,omitempty"`
-	Exerciseinfo         []*ListExercisesResponse_Exercise_ExerciseInfo `protobuf:"bytes,5,rep,name=exerciseinfo,proto3" json:"exerciseinfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+type QueryAuditLogRequest struct {
+	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	EventTag             string   `protobuf:"bytes,3,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Since                string   `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until                string   `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListExercisesResponse_Exercise) Reset()         { *m = ListExercisesResponse_Exercise{} }
-func (m *ListExercisesResponse_Exercise) String() string { return proto.CompactTextString(m) }
-func (*ListExercisesResponse_Exercise) ProtoMessage()    {}
-func (*ListExercisesResponse_Exercise) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{14, 0}
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{17}
 }
 
-func (m *ListExercisesResponse_Exercise) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListExercisesResponse_Exercise.Unmarshal(m, b)
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuditLogRequest.Unmarshal(m, b)
 }
-func (m *ListExercisesResponse_Exercise) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListExercisesResponse_Exercise.Marshal(b, m, deterministic)
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
 }
-func (m *ListExercisesResponse_Exercise) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListExercisesResponse_Exercise.Merge(m, src)
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
 }
-func (m *ListExercisesResponse_Exercise) XXX_Size() int {
-	return xxx_messageInfo_ListExercisesResponse_Exercise.Size(m)
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryAuditLogRequest.Size(m)
 }
-func (m *ListExercisesResponse_Exercise) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListExercisesResponse_Exercise.DiscardUnknown(m)
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListExercisesResponse_Exercise proto.InternalMessageInfo
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
 
-func (m *ListExercisesResponse_Exercise) GetTags() []string {
+func (m *QueryAuditLogRequest) GetUser() string {
 	if m != nil {
-		return m.Tags
+		return m.User
 	}
-	return nil
+	return ""
 }
 
-func (m *ListExercisesResponse_Exercise) GetName() string {
+func (m *QueryAuditLogRequest) GetMethod() string {
 	if m != nil {
-		return m.Name
+		return m.Method
 	}
 	return ""
 }
 
-func (m *ListExercisesResponse_Exercise) GetDockerImageCount() int32 {
+func (m *QueryAuditLogRequest) GetEventTag() string {
 	if m != nil {
-		return m.DockerImageCount
+		return m.EventTag
 	}
-	return 0
+	return ""
 }
 
-func (m *ListExercisesResponse_Exercise) GetVboxImageCount() int32 {
+func (m *QueryAuditLogRequest) GetSince() string {
 	if m != nil {
-		return m.VboxImageCount
+		return m.Since
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetUntil() string {
+	if m != nil {
+		return m.Until
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *ListExercisesResponse_Exercise) GetExerciseinfo() []*ListExercisesResponse_Exercise_ExerciseInfo {
+type QueryAuditLogResponse struct {
+	Entries              []*QueryAuditLogResponse_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{18}
+}
+
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuditLogResponse.Unmarshal(m, b)
+}
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
+}
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryAuditLogResponse.Size(m)
+}
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetEntries() []*QueryAuditLogResponse_Entry {
 	if m != nil {
-		return m.Exerciseinfo
+		return m.Entries
 	}
 	return nil
 }
 
-type ListExercisesResponse_Exercise_ExerciseInfo struct {
-	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Points               int32    `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
-	Category             string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Description          string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+type QueryAuditLogResponse_Entry struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Method               string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	EventTag             string   `protobuf:"bytes,4,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Msg                  string   `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	Fields               string   `protobuf:"bytes,6,opt,name=fields,proto3" json:"fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) Reset() {
-	*m = ListExercisesResponse_Exercise_ExerciseInfo{}
-}
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) String() string {
-	return proto.CompactTextString(m)
-}
-func (*ListExercisesResponse_Exercise_ExerciseInfo) ProtoMessage() {}
-func (*ListExercisesResponse_Exercise_ExerciseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{14, 0, 0}
+func (m *QueryAuditLogResponse_Entry) Reset()         { *m = QueryAuditLogResponse_Entry{} }
+func (m *QueryAuditLogResponse_Entry) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse_Entry) ProtoMessage()    {}
+func (*QueryAuditLogResponse_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{18, 0}
 }
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Unmarshal(m, b)
+func (m *QueryAuditLogResponse_Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuditLogResponse_Entry.Unmarshal(m, b)
 }
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Marshal(b, m, deterministic)
+func (m *QueryAuditLogResponse_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuditLogResponse_Entry.Marshal(b, m, deterministic)
 }
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Merge(m, src)
+func (m *QueryAuditLogResponse_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse_Entry.Merge(m, src)
 }
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Size() int {
-	return xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Size(m)
+func (m *QueryAuditLogResponse_Entry) XXX_Size() int {
+	return xxx_messageInfo_QueryAuditLogResponse_Entry.Size(m)
 }
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.DiscardUnknown(m)
+func (m *QueryAuditLogResponse_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse_Entry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo proto.InternalMessageInfo
+var xxx_messageInfo_QueryAuditLogResponse_Entry proto.InternalMessageInfo
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetTag() string {
+func (m *QueryAuditLogResponse_Entry) GetTime() string {
 	if m != nil {
-		return m.Tag
+		return m.Time
 	}
 	return ""
 }
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetName() string {
+func (m *QueryAuditLogResponse_Entry) GetUser() string {
 	if m != nil {
-		return m.Name
+		return m.User
 	}
 	return ""
 }
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetPoints() int32 {
+func (m *QueryAuditLogResponse_Entry) GetMethod() string {
 	if m != nil {
-		return m.Points
+		return m.Method
 	}
-	return 0
+	return ""
 }
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetCategory() string {
+func (m *QueryAuditLogResponse_Entry) GetEventTag() string {
 	if m != nil {
-		return m.Category
+		return m.EventTag
 	}
 	return ""
 }
 
-func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetDescription() string {
+func (m *QueryAuditLogResponse_Entry) GetMsg() string {
 	if m != nil {
-		return m.Description
+		return m.Msg
 	}
 	return ""
 }
 
-type ResetTeamStatus struct {
-	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
-	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ResetTeamStatus) Reset()         { *m = ResetTeamStatus{} }
-func (m *ResetTeamStatus) String() string { return proto.CompactTextString(m) }
-func (*ResetTeamStatus) ProtoMessage()    {}
-func (*ResetTeamStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{15}
-}
-
-func (m *ResetTeamStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResetTeamStatus.Unmarshal(m, b)
-}
-func (m *ResetTeamStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResetTeamStatus.Marshal(b, m, deterministic)
-}
-func (m *ResetTeamStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetTeamStatus.Merge(m, src)
-}
-func (m *ResetTeamStatus) XXX_Size() int {
-	return xxx_messageInfo_ResetTeamStatus.Size(m)
-}
-func (m *ResetTeamStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetTeamStatus.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ResetTeamStatus proto.InternalMessageInfo
-
-func (m *ResetTeamStatus) GetTeamId() string {
-	if m != nil {
-		return m.TeamId
-	}
-	return ""
-}
-
-func (m *ResetTeamStatus) GetStatus() string {
+func (m *QueryAuditLogResponse_Entry) GetFields() string {
 	if m != nil {
-		return m.Status
+		return m.Fields
 	}
 	return ""
 }
 
-type StopEventRequest struct {
-	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+type CreateEventRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Frontends            []string `protobuf:"bytes,3,rep,name=frontends,proto3" json:"frontends,omitempty"`
+	Exercises            []string `protobuf:"bytes,4,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	Available            int32    `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	Capacity             int32    `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FinishTime           string   `protobuf:"bytes,7,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	StartTime            string   `protobuf:"bytes,8,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Scoring              *Scoring `protobuf:"bytes,9,opt,name=scoring,proto3" json:"scoring,omitempty"`
+	FlagFormat           string   `protobuf:"bytes,10,opt,name=flagFormat,proto3" json:"flagFormat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopEventRequest) Reset()         { *m = StopEventRequest{} }
-func (m *StopEventRequest) String() string { return proto.CompactTextString(m) }
-func (*StopEventRequest) ProtoMessage()    {}
-func (*StopEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{16}
+func (m *CreateEventRequest) Reset()         { *m = CreateEventRequest{} }
+func (m *CreateEventRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateEventRequest) ProtoMessage()    {}
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{19}
 }
 
-func (m *StopEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopEventRequest.Unmarshal(m, b)
+func (m *CreateEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateEventRequest.Unmarshal(m, b)
 }
-func (m *StopEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopEventRequest.Marshal(b, m, deterministic)
+func (m *CreateEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateEventRequest.Marshal(b, m, deterministic)
 }
-func (m *StopEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopEventRequest.Merge(m, src)
+func (m *CreateEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateEventRequest.Merge(m, src)
 }
-func (m *StopEventRequest) XXX_Size() int {
-	return xxx_messageInfo_StopEventRequest.Size(m)
+func (m *CreateEventRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateEventRequest.Size(m)
 }
-func (m *StopEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopEventRequest.DiscardUnknown(m)
+func (m *CreateEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateEventRequest proto.InternalMessageInfo
 
-func (m *StopEventRequest) GetTag() string {
+func (m *CreateEventRequest) GetName() string {
 	if m != nil {
-		return m.Tag
+		return m.Name
 	}
 	return ""
 }
 
-type EventStatus struct {
-	Entity               string   `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *EventStatus) Reset()         { *m = EventStatus{} }
-func (m *EventStatus) String() string { return proto.CompactTextString(m) }
-func (*EventStatus) ProtoMessage()    {}
-func (*EventStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{17}
+func (m *CreateEventRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
 }
 
-func (m *EventStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EventStatus.Unmarshal(m, b)
-}
-func (m *EventStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EventStatus.Marshal(b, m, deterministic)
-}
-func (m *EventStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventStatus.Merge(m, src)
-}
-func (m *EventStatus) XXX_Size() int {
-	return xxx_messageInfo_EventStatus.Size(m)
-}
-func (m *EventStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventStatus.DiscardUnknown(m)
+func (m *CreateEventRequest) GetFrontends() []string {
+	if m != nil {
+		return m.Frontends
+	}
+	return nil
 }
 
-var xxx_messageInfo_EventStatus proto.InternalMessageInfo
-
-func (m *EventStatus) GetEntity() string {
+func (m *CreateEventRequest) GetExercises() []string {
 	if m != nil {
-		return m.Entity
+		return m.Exercises
 	}
-	return ""
+	return nil
 }
 
-func (m *EventStatus) GetStatus() string {
+func (m *CreateEventRequest) GetAvailable() int32 {
 	if m != nil {
-		return m.Status
+		return m.Available
 	}
-	return ""
+	return 0
 }
 
-type LabStatus struct {
-	Message              string   `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateEventRequest) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func (m *LabStatus) Reset()         { *m = LabStatus{} }
-func (m *LabStatus) String() string { return proto.CompactTextString(m) }
-func (*LabStatus) ProtoMessage()    {}
-func (*LabStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{18}
+func (m *CreateEventRequest) GetFinishTime() string {
+	if m != nil {
+		return m.FinishTime
+	}
+	return ""
 }
 
-func (m *LabStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LabStatus.Unmarshal(m, b)
-}
-func (m *LabStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LabStatus.Marshal(b, m, deterministic)
-}
-func (m *LabStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LabStatus.Merge(m, src)
-}
-func (m *LabStatus) XXX_Size() int {
-	return xxx_messageInfo_LabStatus.Size(m)
-}
-func (m *LabStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_LabStatus.DiscardUnknown(m)
+func (m *CreateEventRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
 }
 
-var xxx_messageInfo_LabStatus proto.InternalMessageInfo
-
-func (m *LabStatus) GetMessage() string {
+func (m *CreateEventRequest) GetScoring() *Scoring {
 	if m != nil {
-		return m.Message
+		return m.Scoring
 	}
-	return ""
+	return nil
 }
 
-func (m *LabStatus) GetErrorMessage() string {
+func (m *CreateEventRequest) GetFlagFormat() string {
 	if m != nil {
-		return m.ErrorMessage
+		return m.FlagFormat
 	}
 	return ""
 }
 
-type MonitorHostResponse struct {
-	MemoryPercent        float32  `protobuf:"fixed32,1,opt,name=MemoryPercent,proto3" json:"MemoryPercent,omitempty"`
-	MemoryReadError      string   `protobuf:"bytes,2,opt,name=MemoryReadError,proto3" json:"MemoryReadError,omitempty"`
-	CPUPercent           float32  `protobuf:"fixed32,3,opt,name=CPUPercent,proto3" json:"CPUPercent,omitempty"`
-	CPUReadError         string   `protobuf:"bytes,4,opt,name=CPUReadError,proto3" json:"CPUReadError,omitempty"`
+type Scoring struct {
+	Mode                 string   `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Initial              int32    `protobuf:"varint,2,opt,name=initial,proto3" json:"initial,omitempty"`
+	Minimum              int32    `protobuf:"varint,3,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Decay                int32    `protobuf:"varint,4,opt,name=decay,proto3" json:"decay,omitempty"`
+	FirstBloodBonus      int32    `protobuf:"varint,5,opt,name=firstBloodBonus,proto3" json:"firstBloodBonus,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MonitorHostResponse) Reset()         { *m = MonitorHostResponse{} }
-func (m *MonitorHostResponse) String() string { return proto.CompactTextString(m) }
-func (*MonitorHostResponse) ProtoMessage()    {}
-func (*MonitorHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{19}
+func (m *Scoring) Reset()         { *m = Scoring{} }
+func (m *Scoring) String() string { return proto.CompactTextString(m) }
+func (*Scoring) ProtoMessage()    {}
+func (*Scoring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{20}
 }
 
-func (m *MonitorHostResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MonitorHostResponse.Unmarshal(m, b)
+func (m *Scoring) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Scoring.Unmarshal(m, b)
 }
-func (m *MonitorHostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MonitorHostResponse.Marshal(b, m, deterministic)
+func (m *Scoring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Scoring.Marshal(b, m, deterministic)
 }
-func (m *MonitorHostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MonitorHostResponse.Merge(m, src)
+func (m *Scoring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Scoring.Merge(m, src)
 }
-func (m *MonitorHostResponse) XXX_Size() int {
-	return xxx_messageInfo_MonitorHostResponse.Size(m)
+func (m *Scoring) XXX_Size() int {
+	return xxx_messageInfo_Scoring.Size(m)
 }
-func (m *MonitorHostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MonitorHostResponse.DiscardUnknown(m)
+func (m *Scoring) XXX_DiscardUnknown() {
+	xxx_messageInfo_Scoring.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MonitorHostResponse proto.InternalMessageInfo
+var xxx_messageInfo_Scoring proto.InternalMessageInfo
 
-func (m *MonitorHostResponse) GetMemoryPercent() float32 {
+func (m *Scoring) GetMode() string {
 	if m != nil {
-		return m.MemoryPercent
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *Scoring) GetInitial() int32 {
+	if m != nil {
+		return m.Initial
 	}
 	return 0
 }
 
-func (m *MonitorHostResponse) GetMemoryReadError() string {
+func (m *Scoring) GetMinimum() int32 {
 	if m != nil {
-		return m.MemoryReadError
+		return m.Minimum
 	}
-	return ""
+	return 0
 }
 
-func (m *MonitorHostResponse) GetCPUPercent() float32 {
+func (m *Scoring) GetDecay() int32 {
 	if m != nil {
-		return m.CPUPercent
+		return m.Decay
 	}
 	return 0
 }
 
-func (m *MonitorHostResponse) GetCPUReadError() string {
+func (m *Scoring) GetFirstBloodBonus() int32 {
 	if m != nil {
-		return m.CPUReadError
+		return m.FirstBloodBonus
 	}
-	return ""
+	return 0
 }
 
-type Empty struct {
+type ListEventsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Empty) Reset()         { *m = Empty{} }
-func (m *Empty) String() string { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()    {}
-func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{20}
+func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()    {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{21}
 }
 
-func (m *Empty) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Empty.Unmarshal(m, b)
+func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
 }
-func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *Empty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Empty.Merge(m, src)
+func (m *ListEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsRequest.Merge(m, src)
 }
-func (m *Empty) XXX_Size() int {
-	return xxx_messageInfo_Empty.Size(m)
+func (m *ListEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventsRequest.Size(m)
 }
-func (m *Empty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Empty.DiscardUnknown(m)
+func (m *ListEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Empty proto.InternalMessageInfo
+var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
 
-type VersionResponse struct {
-	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
-func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
-func (*VersionResponse) ProtoMessage()    {}
-func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{21}
-}
-
-func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
-}
-func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
-}
-func (m *VersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VersionResponse.Merge(m, src)
-}
-func (m *VersionResponse) XXX_Size() int {
-	return xxx_messageInfo_VersionResponse.Size(m)
-}
-func (m *VersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
-
-func (m *VersionResponse) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
-}
-
-type ListFrontendsResponse struct {
-	Frontends            []*ListFrontendsResponse_Frontend `protobuf:"bytes,1,rep,name=frontends,proto3" json:"frontends,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+type ListEventsResponse struct {
+	Events               []*ListEventsResponse_Events `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (m *ListFrontendsResponse) Reset()         { *m = ListFrontendsResponse{} }
-func (m *ListFrontendsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListFrontendsResponse) ProtoMessage()    {}
-func (*ListFrontendsResponse) Descriptor() ([]byte, []int) {
+func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
+func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse) ProtoMessage()    {}
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{22}
 }
 
-func (m *ListFrontendsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListFrontendsResponse.Unmarshal(m, b)
+func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
 }
-func (m *ListFrontendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListFrontendsResponse.Marshal(b, m, deterministic)
+func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
 }
-func (m *ListFrontendsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListFrontendsResponse.Merge(m, src)
+func (m *ListEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse.Merge(m, src)
 }
-func (m *ListFrontendsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListFrontendsResponse.Size(m)
+func (m *ListEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse.Size(m)
 }
-func (m *ListFrontendsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListFrontendsResponse.DiscardUnknown(m)
+func (m *ListEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListFrontendsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
 
-func (m *ListFrontendsResponse) GetFrontends() []*ListFrontendsResponse_Frontend {
+func (m *ListEventsResponse) GetEvents() []*ListEventsResponse_Events {
 	if m != nil {
-		return m.Frontends
+		return m.Events
 	}
 	return nil
 }
 
-type ListFrontendsResponse_Frontend struct {
-	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	MemoryMB             int64    `protobuf:"varint,3,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
-	Cpu                  float32  `protobuf:"fixed32,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+type ListEventsResponse_Events struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TeamCount            int32    `protobuf:"varint,3,opt,name=teamCount,proto3" json:"teamCount,omitempty"`
+	Exercises            string   `protobuf:"bytes,4,opt,name=exercises,proto3" json:"exercises,omitempty"`
+	Capacity             int32    `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CreationTime         string   `protobuf:"bytes,6,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
+	FinishTime           string   `protobuf:"bytes,7,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	Status               string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListFrontendsResponse_Frontend) Reset()         { *m = ListFrontendsResponse_Frontend{} }
-func (m *ListFrontendsResponse_Frontend) String() string { return proto.CompactTextString(m) }
-func (*ListFrontendsResponse_Frontend) ProtoMessage()    {}
-func (*ListFrontendsResponse_Frontend) Descriptor() ([]byte, []int) {
+func (m *ListEventsResponse_Events) Reset()         { *m = ListEventsResponse_Events{} }
+func (m *ListEventsResponse_Events) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse_Events) ProtoMessage()    {}
+func (*ListEventsResponse_Events) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ec90cbc4aa12fc6, []int{22, 0}
 }
 
-func (m *ListFrontendsResponse_Frontend) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListFrontendsResponse_Frontend.Unmarshal(m, b)
+func (m *ListEventsResponse_Events) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse_Events.Unmarshal(m, b)
 }
-func (m *ListFrontendsResponse_Frontend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListFrontendsResponse_Frontend.Marshal(b, m, deterministic)
+func (m *ListEventsResponse_Events) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse_Events.Marshal(b, m, deterministic)
 }
-func (m *ListFrontendsResponse_Frontend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListFrontendsResponse_Frontend.Merge(m, src)
+func (m *ListEventsResponse_Events) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse_Events.Merge(m, src)
 }
-func (m *ListFrontendsResponse_Frontend) XXX_Size() int {
-	return xxx_messageInfo_ListFrontendsResponse_Frontend.Size(m)
+func (m *ListEventsResponse_Events) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse_Events.Size(m)
 }
-func (m *ListFrontendsResponse_Frontend) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListFrontendsResponse_Frontend.DiscardUnknown(m)
+func (m *ListEventsResponse_Events) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse_Events.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListFrontendsResponse_Frontend proto.InternalMessageInfo
+var xxx_messageInfo_ListEventsResponse_Events proto.InternalMessageInfo
 
-func (m *ListFrontendsResponse_Frontend) GetImage() string {
+func (m *ListEventsResponse_Events) GetTag() string {
 	if m != nil {
-		return m.Image
+		return m.Tag
 	}
 	return ""
 }
 
-func (m *ListFrontendsResponse_Frontend) GetSize() int64 {
+func (m *ListEventsResponse_Events) GetName() string {
 	if m != nil {
-		return m.Size
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *ListFrontendsResponse_Frontend) GetMemoryMB() int64 {
+func (m *ListEventsResponse_Events) GetTeamCount() int32 {
 	if m != nil {
-		return m.MemoryMB
+		return m.TeamCount
 	}
 	return 0
 }
 
-func (m *ListFrontendsResponse_Frontend) GetCpu() float32 {
+func (m *ListEventsResponse_Events) GetExercises() string {
 	if m != nil {
-		return m.Cpu
+		return m.Exercises
 	}
-	return 0
-}
-
-type ResetFrontendsRequest struct {
-	EventTag             string   `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	Teams                []*Team  `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *ResetFrontendsRequest) Reset()         { *m = ResetFrontendsRequest{} }
-func (m *ResetFrontendsRequest) String() string { return proto.CompactTextString(m) }
-func (*ResetFrontendsRequest) ProtoMessage()    {}
-func (*ResetFrontendsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{23}
+func (m *ListEventsResponse_Events) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func (m *ResetFrontendsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResetFrontendsRequest.Unmarshal(m, b)
-}
-func (m *ResetFrontendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResetFrontendsRequest.Marshal(b, m, deterministic)
-}
-func (m *ResetFrontendsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetFrontendsRequest.Merge(m, src)
-}
-func (m *ResetFrontendsRequest) XXX_Size() int {
-	return xxx_messageInfo_ResetFrontendsRequest.Size(m)
-}
-func (m *ResetFrontendsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetFrontendsRequest.DiscardUnknown(m)
+func (m *ListEventsResponse_Events) GetCreationTime() string {
+	if m != nil {
+		return m.CreationTime
+	}
+	return ""
 }
 
-var xxx_messageInfo_ResetFrontendsRequest proto.InternalMessageInfo
-
-func (m *ResetFrontendsRequest) GetEventTag() string {
+func (m *ListEventsResponse_Events) GetFinishTime() string {
 	if m != nil {
-		return m.EventTag
+		return m.FinishTime
 	}
 	return ""
 }
 
-func (m *ResetFrontendsRequest) GetTeams() []*Team {
+func (m *ListEventsResponse_Events) GetStatus() string {
 	if m != nil {
-		return m.Teams
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-type SetFrontendMemoryRequest struct {
-	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	MemoryMB             int64    `protobuf:"varint,2,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+type ListEventTeamsRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetFrontendMemoryRequest) Reset()         { *m = SetFrontendMemoryRequest{} }
-func (m *SetFrontendMemoryRequest) String() string { return proto.CompactTextString(m) }
-func (*SetFrontendMemoryRequest) ProtoMessage()    {}
-func (*SetFrontendMemoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{24}
+func (m *ListEventTeamsRequest) Reset()         { *m = ListEventTeamsRequest{} }
+func (m *ListEventTeamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventTeamsRequest) ProtoMessage()    {}
+func (*ListEventTeamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{23}
 }
 
-func (m *SetFrontendMemoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetFrontendMemoryRequest.Unmarshal(m, b)
+func (m *ListEventTeamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventTeamsRequest.Unmarshal(m, b)
 }
-func (m *SetFrontendMemoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetFrontendMemoryRequest.Marshal(b, m, deterministic)
+func (m *ListEventTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventTeamsRequest.Marshal(b, m, deterministic)
 }
-func (m *SetFrontendMemoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetFrontendMemoryRequest.Merge(m, src)
+func (m *ListEventTeamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventTeamsRequest.Merge(m, src)
 }
-func (m *SetFrontendMemoryRequest) XXX_Size() int {
-	return xxx_messageInfo_SetFrontendMemoryRequest.Size(m)
+func (m *ListEventTeamsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventTeamsRequest.Size(m)
 }
-func (m *SetFrontendMemoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetFrontendMemoryRequest.DiscardUnknown(m)
+func (m *ListEventTeamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventTeamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetFrontendMemoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListEventTeamsRequest proto.InternalMessageInfo
 
-func (m *SetFrontendMemoryRequest) GetImage() string {
+func (m *ListEventTeamsRequest) GetTag() string {
 	if m != nil {
-		return m.Image
+		return m.Tag
 	}
 	return ""
 }
 
-func (m *SetFrontendMemoryRequest) GetMemoryMB() int64 {
-	if m != nil {
-		return m.MemoryMB
-	}
-	return 0
+type ListEventTeamsResponse struct {
+	Teams                []*ListEventTeamsResponse_Teams `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-type SetFrontendCpuRequest struct {
-	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Cpu                  float32  `protobuf:"fixed32,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListEventTeamsResponse) Reset()         { *m = ListEventTeamsResponse{} }
+func (m *ListEventTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventTeamsResponse) ProtoMessage()    {}
+func (*ListEventTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{24}
 }
 
-func (m *SetFrontendCpuRequest) Reset()         { *m = SetFrontendCpuRequest{} }
-func (m *SetFrontendCpuRequest) String() string { return proto.CompactTextString(m) }
-func (*SetFrontendCpuRequest) ProtoMessage()    {}
-func (*SetFrontendCpuRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{25}
+func (m *ListEventTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventTeamsResponse.Unmarshal(m, b)
+}
+func (m *ListEventTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventTeamsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEventTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventTeamsResponse.Merge(m, src)
+}
+func (m *ListEventTeamsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventTeamsResponse.Size(m)
+}
+func (m *ListEventTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventTeamsResponse.DiscardUnknown(m)
 }
 
-func (m *SetFrontendCpuRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetFrontendCpuRequest.Unmarshal(m, b)
+var xxx_messageInfo_ListEventTeamsResponse proto.InternalMessageInfo
+
+func (m *ListEventTeamsResponse) GetTeams() []*ListEventTeamsResponse_Teams {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
 }
-func (m *SetFrontendCpuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetFrontendCpuRequest.Marshal(b, m, deterministic)
+
+type ListEventTeamsResponse_Teams struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	AccessedAt           string   `protobuf:"bytes,4,opt,name=AccessedAt,proto3" json:"AccessedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *SetFrontendCpuRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetFrontendCpuRequest.Merge(m, src)
+
+func (m *ListEventTeamsResponse_Teams) Reset()         { *m = ListEventTeamsResponse_Teams{} }
+func (m *ListEventTeamsResponse_Teams) String() string { return proto.CompactTextString(m) }
+func (*ListEventTeamsResponse_Teams) ProtoMessage()    {}
+func (*ListEventTeamsResponse_Teams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{24, 0}
 }
-func (m *SetFrontendCpuRequest) XXX_Size() int {
-	return xxx_messageInfo_SetFrontendCpuRequest.Size(m)
+
+func (m *ListEventTeamsResponse_Teams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventTeamsResponse_Teams.Unmarshal(m, b)
 }
-func (m *SetFrontendCpuRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetFrontendCpuRequest.DiscardUnknown(m)
+func (m *ListEventTeamsResponse_Teams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventTeamsResponse_Teams.Marshal(b, m, deterministic)
+}
+func (m *ListEventTeamsResponse_Teams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventTeamsResponse_Teams.Merge(m, src)
+}
+func (m *ListEventTeamsResponse_Teams) XXX_Size() int {
+	return xxx_messageInfo_ListEventTeamsResponse_Teams.Size(m)
+}
+func (m *ListEventTeamsResponse_Teams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventTeamsResponse_Teams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetFrontendCpuRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListEventTeamsResponse_Teams proto.InternalMessageInfo
 
-func (m *SetFrontendCpuRequest) GetImage() string {
+func (m *ListEventTeamsResponse_Teams) GetId() string {
 	if m != nil {
-		return m.Image
+		return m.Id
 	}
 	return ""
 }
 
-func (m *SetFrontendCpuRequest) GetCpu() float32 {
+func (m *ListEventTeamsResponse_Teams) GetName() string {
 	if m != nil {
-		return m.Cpu
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-type GetTeamInfoRequest struct {
-	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
-	EventTag             string   `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+func (m *ListEventTeamsResponse_Teams) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *ListEventTeamsResponse_Teams) GetAccessedAt() string {
+	if m != nil {
+		return m.AccessedAt
+	}
+	return ""
+}
+
+type ListIncidentsRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTeamInfoRequest) Reset()         { *m = GetTeamInfoRequest{} }
-func (m *GetTeamInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTeamInfoRequest) ProtoMessage()    {}
-func (*GetTeamInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{26}
+func (m *ListIncidentsRequest) Reset()         { *m = ListIncidentsRequest{} }
+func (m *ListIncidentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIncidentsRequest) ProtoMessage()    {}
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{25}
 }
 
-func (m *GetTeamInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamInfoRequest.Unmarshal(m, b)
+func (m *ListIncidentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIncidentsRequest.Unmarshal(m, b)
 }
-func (m *GetTeamInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamInfoRequest.Marshal(b, m, deterministic)
+func (m *ListIncidentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIncidentsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetTeamInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamInfoRequest.Merge(m, src)
+func (m *ListIncidentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIncidentsRequest.Merge(m, src)
 }
-func (m *GetTeamInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTeamInfoRequest.Size(m)
+func (m *ListIncidentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIncidentsRequest.Size(m)
 }
-func (m *GetTeamInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamInfoRequest.DiscardUnknown(m)
+func (m *ListIncidentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIncidentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTeamInfoRequest proto.InternalMessageInfo
-
-func (m *GetTeamInfoRequest) GetTeamId() string {
-	if m != nil {
-		return m.TeamId
-	}
-	return ""
-}
+var xxx_messageInfo_ListIncidentsRequest proto.InternalMessageInfo
 
-func (m *GetTeamInfoRequest) GetEventTag() string {
+func (m *ListIncidentsRequest) GetEventTag() string {
 	if m != nil {
 		return m.EventTag
 	}
 	return ""
 }
 
-type GetTeamInfoResponse struct {
-	Instances            []*GetTeamInfoResponse_Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+type ListIncidentsResponse struct {
+	Incidents            []*ListIncidentsResponse_Incident `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *GetTeamInfoResponse) Reset()         { *m = GetTeamInfoResponse{} }
-func (m *GetTeamInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTeamInfoResponse) ProtoMessage()    {}
-func (*GetTeamInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{27}
+func (m *ListIncidentsResponse) Reset()         { *m = ListIncidentsResponse{} }
+func (m *ListIncidentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIncidentsResponse) ProtoMessage()    {}
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{26}
 }
 
-func (m *GetTeamInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamInfoResponse.Unmarshal(m, b)
+func (m *ListIncidentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIncidentsResponse.Unmarshal(m, b)
 }
-func (m *GetTeamInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamInfoResponse.Marshal(b, m, deterministic)
+func (m *ListIncidentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIncidentsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetTeamInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamInfoResponse.Merge(m, src)
+func (m *ListIncidentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIncidentsResponse.Merge(m, src)
 }
-func (m *GetTeamInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTeamInfoResponse.Size(m)
+func (m *ListIncidentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIncidentsResponse.Size(m)
 }
-func (m *GetTeamInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamInfoResponse.DiscardUnknown(m)
+func (m *ListIncidentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIncidentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTeamInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListIncidentsResponse proto.InternalMessageInfo
 
-func (m *GetTeamInfoResponse) GetInstances() []*GetTeamInfoResponse_Instance {
+func (m *ListIncidentsResponse) GetIncidents() []*ListIncidentsResponse_Incident {
 	if m != nil {
-		return m.Instances
+		return m.Incidents
 	}
 	return nil
 }
 
-type GetTeamInfoResponse_Instance struct {
-	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	State                int32    `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+type ListIncidentsResponse_Incident struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	InstanceId           string   `protobuf:"bytes,2,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
+	Image                string   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	State                string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Action               string   `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Restarts             int32    `protobuf:"varint,7,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Time                 string   `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTeamInfoResponse_Instance) Reset()         { *m = GetTeamInfoResponse_Instance{} }
-func (m *GetTeamInfoResponse_Instance) String() string { return proto.CompactTextString(m) }
-func (*GetTeamInfoResponse_Instance) ProtoMessage()    {}
-func (*GetTeamInfoResponse_Instance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ec90cbc4aa12fc6, []int{27, 0}
+func (m *ListIncidentsResponse_Incident) Reset()         { *m = ListIncidentsResponse_Incident{} }
+func (m *ListIncidentsResponse_Incident) String() string { return proto.CompactTextString(m) }
+func (*ListIncidentsResponse_Incident) ProtoMessage()    {}
+func (*ListIncidentsResponse_Incident) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{26, 0}
 }
 
-func (m *GetTeamInfoResponse_Instance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamInfoResponse_Instance.Unmarshal(m, b)
+func (m *ListIncidentsResponse_Incident) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIncidentsResponse_Incident.Unmarshal(m, b)
 }
-func (m *GetTeamInfoResponse_Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamInfoResponse_Instance.Marshal(b, m, deterministic)
+func (m *ListIncidentsResponse_Incident) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIncidentsResponse_Incident.Marshal(b, m, deterministic)
 }
-func (m *GetTeamInfoResponse_Instance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamInfoResponse_Instance.Merge(m, src)
+func (m *ListIncidentsResponse_Incident) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIncidentsResponse_Incident.Merge(m, src)
 }
-func (m *GetTeamInfoResponse_Instance) XXX_Size() int {
-	return xxx_messageInfo_GetTeamInfoResponse_Instance.Size(m)
+func (m *ListIncidentsResponse_Incident) XXX_Size() int {
+	return xxx_messageInfo_ListIncidentsResponse_Incident.Size(m)
 }
-func (m *GetTeamInfoResponse_Instance) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamInfoResponse_Instance.DiscardUnknown(m)
+func (m *ListIncidentsResponse_Incident) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIncidentsResponse_Incident.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTeamInfoResponse_Instance proto.InternalMessageInfo
+var xxx_messageInfo_ListIncidentsResponse_Incident proto.InternalMessageInfo
 
-func (m *GetTeamInfoResponse_Instance) GetImage() string {
+func (m *ListIncidentsResponse_Incident) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ListIncidentsResponse_Incident) GetInstanceId() string {
+	if m != nil {
+		return m.InstanceId
+	}
+	return ""
+}
+
+func (m *ListIncidentsResponse_Incident) GetImage() string {
 	if m != nil {
 		return m.Image
 	}
 	return ""
 }
 
-func (m *GetTeamInfoResponse_Instance) GetType() string {
+func (m *ListIncidentsResponse_Incident) GetType() string {
 	if m != nil {
 		return m.Type
 	}
 	return ""
 }
 
-func (m *GetTeamInfoResponse_Instance) GetId() string {
+func (m *ListIncidentsResponse_Incident) GetState() string {
 	if m != nil {
-		return m.Id
+		return m.State
 	}
 	return ""
 }
 
-func (m *GetTeamInfoResponse_Instance) GetState() int32 {
+func (m *ListIncidentsResponse_Incident) GetAction() string {
 	if m != nil {
-		return m.State
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListIncidentsResponse_Incident) GetRestarts() int32 {
+	if m != nil {
+		return m.Restarts
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Team)(nil), "Team")
-	proto.RegisterType((*LoginUserRequest)(nil), "LoginUserRequest")
-	proto.RegisterType((*LoginUserResponse)(nil), "LoginUserResponse")
-	proto.RegisterType((*SignupUserRequest)(nil), "SignupUserRequest")
-	proto.RegisterType((*InviteUserRequest)(nil), "InviteUserRequest")
-	proto.RegisterType((*InviteUserResponse)(nil), "InviteUserResponse")
-	proto.RegisterType((*CreateEventRequest)(nil), "CreateEventRequest")
-	proto.RegisterType((*ListEventsRequest)(nil), "ListEventsRequest")
-	proto.RegisterType((*ListEventsResponse)(nil), "ListEventsResponse")
-	proto.RegisterType((*ListEventsResponse_Events)(nil), "ListEventsResponse.Events")
-	proto.RegisterType((*ListEventTeamsRequest)(nil), "ListEventTeamsRequest")
-	proto.RegisterType((*ListEventTeamsResponse)(nil), "ListEventTeamsResponse")
-	proto.RegisterType((*ListEventTeamsResponse_Teams)(nil), "ListEventTeamsResponse.Teams")
-	proto.RegisterType((*RestartTeamLabRequest)(nil), "RestartTeamLabRequest")
-	proto.RegisterType((*ResetExerciseRequest)(nil), "ResetExerciseRequest")
-	proto.RegisterType((*UpdateExercisesFileResponse)(nil), "UpdateExercisesFileResponse")
-	proto.RegisterType((*ListExercisesResponse)(nil), "ListExercisesResponse")
-	proto.RegisterType((*ListExercisesResponse_Exercise)(nil), "ListExercisesResponse.Exercise")
-	proto.RegisterType((*ListExercisesResponse_Exercise_ExerciseInfo)(nil), "ListExercisesResponse.Exercise.ExerciseInfo")
-	proto.RegisterType((*ResetTeamStatus)(nil), "ResetTeamStatus")
-	proto.RegisterType((*StopEventRequest)(nil), "StopEventRequest")
-	proto.RegisterType((*EventStatus)(nil), "EventStatus")
-	proto.RegisterType((*LabStatus)(nil), "LabStatus")
-	proto.RegisterType((*MonitorHostResponse)(nil), "MonitorHostResponse")
-	proto.RegisterType((*Empty)(nil), "Empty")
-	proto.RegisterType((*VersionResponse)(nil), "VersionResponse")
-	proto.RegisterType((*ListFrontendsResponse)(nil), "ListFrontendsResponse")
-	proto.RegisterType((*ListFrontendsResponse_Frontend)(nil), "ListFrontendsResponse.Frontend")
-	proto.RegisterType((*ResetFrontendsRequest)(nil), "ResetFrontendsRequest")
-	proto.RegisterType((*SetFrontendMemoryRequest)(nil), "SetFrontendMemoryRequest")
-	proto.RegisterType((*SetFrontendCpuRequest)(nil), "SetFrontendCpuRequest")
-	proto.RegisterType((*GetTeamInfoRequest)(nil), "GetTeamInfoRequest")
-	proto.RegisterType((*GetTeamInfoResponse)(nil), "GetTeamInfoResponse")
-	proto.RegisterType((*GetTeamInfoResponse_Instance)(nil), "GetTeamInfoResponse.Instance")
+func (m *ListIncidentsResponse_Incident) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("daemon.proto", fileDescriptor_3ec90cbc4aa12fc6) }
+func (m *ListIncidentsResponse_Incident) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
 
-var fileDescriptor_3ec90cbc4aa12fc6 = []byte{
-	// 1387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xd7, 0xae, 0x2c, 0xd9, 0x6a, 0x3b, 0x8e, 0x35, 0xb2, 0xf5, 0xd7, 0x7f, 0x93, 0x80, 0x6b,
-	0x2a, 0x50, 0xe6, 0xa3, 0x26, 0xc1, 0xa1, 0x08, 0x15, 0x12, 0x52, 0xc1, 0x38, 0x44, 0x60, 0x53,
-	0xae, 0x75, 0xcc, 0x81, 0x2a, 0x8a, 0x5a, 0x4b, 0x63, 0xb1, 0x65, 0x6b, 0x77, 0xb3, 0x33, 0x32,
-	0x11, 0x8f, 0xc0, 0x9d, 0x87, 0xe0, 0x42, 0x71, 0xe6, 0xc0, 0x03, 0x70, 0xe2, 0x25, 0x78, 0x03,
-	0x1e, 0x80, 0x9a, 0xaf, 0xdd, 0xd9, 0x0f, 0x39, 0x70, 0x9b, 0xee, 0xed, 0xe9, 0xe9, 0xfe, 0x4d,
-	0xf7, 0x6f, 0x7a, 0x61, 0x6d, 0x1c, 0xd0, 0x69, 0x1c, 0x91, 0x24, 0x8d, 0x79, 0x8c, 0xfb, 0xb0,
-	0xf4, 0x9c, 0x06, 0x53, 0xb4, 0x0e, 0xee, 0x70, 0x3c, 0x70, 0xb6, 0x9d, 0x9d, 0x8e, 0xef, 0x0e,
-	0xc7, 0xf8, 0x73, 0xd8, 0x38, 0x88, 0x27, 0x61, 0x74, 0xc2, 0x68, 0xea, 0xd3, 0x17, 0x33, 0xca,
-	0x38, 0xf2, 0x60, 0x65, 0xc6, 0x68, 0x1a, 0x05, 0x53, 0xaa, 0x2d, 0x33, 0x59, 0x7c, 0x4b, 0x02,
-	0xc6, 0xbe, 0x8f, 0xd3, 0xf1, 0xc0, 0x55, 0xdf, 0x8c, 0x8c, 0x1f, 0x43, 0xd7, 0xf2, 0xc5, 0x92,
-	0x38, 0x62, 0x14, 0x6d, 0x42, 0x8b, 0xc7, 0xe7, 0x34, 0xd2, 0x9e, 0x94, 0x20, 0xb4, 0x34, 0x4d,
-	0xe3, 0x54, 0xfb, 0x50, 0x02, 0xfe, 0x06, 0xba, 0xc7, 0xe1, 0x24, 0x9a, 0x25, 0x76, 0x34, 0x1b,
-	0xd0, 0x3c, 0xa7, 0x73, 0xbd, 0x5d, 0x2c, 0x0b, 0xf1, 0xb9, 0x57, 0xc4, 0xd7, 0x2c, 0xc5, 0xb7,
-	0x0b, 0xdd, 0x61, 0x74, 0x19, 0x72, 0x6a, 0xbb, 0xbf, 0x05, 0xc0, 0x66, 0x09, 0x4d, 0xbf, 0x15,
-	0x2e, 0xe4, 0x29, 0x2b, 0x7e, 0x47, 0x6a, 0x84, 0x15, 0x7e, 0x08, 0xc8, 0xde, 0xa3, 0x93, 0xaa,
-	0xc6, 0x54, 0x9f, 0xd0, 0x9f, 0x0e, 0xa0, 0xbd, 0x94, 0x06, 0x9c, 0xee, 0x5f, 0xd2, 0x88, 0x9b,
-	0x33, 0x11, 0x2c, 0x59, 0xe0, 0xca, 0xb5, 0x70, 0xc9, 0x83, 0x89, 0xde, 0x2e, 0x96, 0xe8, 0x26,
-	0x74, 0xce, 0xd2, 0x38, 0xe2, 0x34, 0x1a, 0xb3, 0x41, 0x73, 0xbb, 0xb9, 0xd3, 0xf1, 0x73, 0x85,
-	0xf8, 0x4a, 0x5f, 0xd2, 0x74, 0x14, 0x32, 0xca, 0x06, 0x4b, 0xea, 0x6b, 0xa6, 0x10, 0x5f, 0x83,
-	0xcb, 0x20, 0xbc, 0x08, 0x4e, 0x2f, 0xe8, 0xa0, 0xb5, 0xed, 0xec, 0xb4, 0xfc, 0x5c, 0x21, 0x40,
-	0x1a, 0x05, 0x49, 0x30, 0x0a, 0xf9, 0x7c, 0xd0, 0x96, 0x1f, 0x33, 0x19, 0xbd, 0x06, 0x70, 0x16,
-	0x46, 0x21, 0xfb, 0xee, 0x79, 0x38, 0xa5, 0x83, 0x65, 0x19, 0x8e, 0xa5, 0xc1, 0x3d, 0xe8, 0x1e,
-	0x84, 0x8c, 0xcb, 0x7c, 0x98, 0x4e, 0x08, 0xff, 0xe4, 0x02, 0xb2, 0xb5, 0x1a, 0xa6, 0x5d, 0x68,
-	0x53, 0xa9, 0x19, 0x38, 0xdb, 0xcd, 0x9d, 0xd5, 0x5d, 0x8f, 0x54, 0x8d, 0x88, 0x16, 0xb5, 0xa5,
-	0xf7, 0x87, 0x03, 0x6d, 0xa5, 0x32, 0x90, 0x38, 0x39, 0x24, 0x06, 0x38, 0xd7, 0x02, 0xee, 0x26,
-	0x74, 0x38, 0x0d, 0xa6, 0x7b, 0xf1, 0x2c, 0xe2, 0xf2, 0xca, 0x5b, 0x7e, 0xae, 0x28, 0xc3, 0xe4,
-	0x14, 0x61, 0xb2, 0x81, 0x68, 0x95, 0x80, 0xc0, 0xb0, 0x36, 0x12, 0x57, 0x17, 0xc6, 0x91, 0x84,
-	0xa2, 0x2d, 0x37, 0x17, 0x74, 0xaf, 0x04, 0xeb, 0x2d, 0xd8, 0xca, 0x32, 0x16, 0xed, 0xc7, 0xac,
-	0xa2, 0x2e, 0xa6, 0x86, 0x7f, 0x75, 0xa0, 0x5f, 0xb6, 0xd5, 0x30, 0xde, 0x83, 0x96, 0x48, 0xc8,
-	0xa0, 0x78, 0x8b, 0xd4, 0xdb, 0x11, 0x25, 0x29, 0x5b, 0x2f, 0x80, 0x96, 0x94, 0xcb, 0x1d, 0x2f,
-	0x30, 0xfc, 0xd2, 0xc2, 0x50, 0xac, 0x45, 0xf5, 0xee, 0x4f, 0x83, 0xf0, 0x42, 0xb7, 0x8c, 0x12,
-	0x44, 0x76, 0x4f, 0x46, 0x23, 0xca, 0x18, 0x1d, 0x3f, 0xe1, 0x1a, 0x3c, 0x4b, 0x83, 0xbf, 0x80,
-	0x2d, 0x9f, 0x32, 0x1e, 0xa4, 0x32, 0x8e, 0x83, 0xe0, 0xd4, 0x22, 0x10, 0x79, 0x9b, 0xcf, 0xb3,
-	0x14, 0x33, 0x19, 0xf5, 0xa1, 0x2d, 0x02, 0x1c, 0x1a, 0xfa, 0xd0, 0x12, 0x7e, 0x01, 0x9b, 0x3e,
-	0x65, 0x94, 0xef, 0xeb, 0xcb, 0x31, 0xbe, 0xb6, 0x61, 0xd5, 0xdc, 0x57, 0xee, 0xce, 0x56, 0x15,
-	0x4e, 0x73, 0x4b, 0xa7, 0xdd, 0x30, 0xd0, 0x35, 0x25, 0x74, 0x2d, 0x89, 0x91, 0x86, 0x08, 0xdf,
-	0x81, 0x1b, 0x27, 0xc9, 0x58, 0x34, 0xa7, 0x29, 0x88, 0xa7, 0xe1, 0x05, 0xb5, 0x9b, 0x7c, 0xca,
-	0xb2, 0x3b, 0x9a, 0xb2, 0x09, 0xfe, 0xbd, 0xa9, 0xef, 0xd3, 0xd8, 0x67, 0xb6, 0x8f, 0xec, 0x32,
-	0x53, 0xd7, 0xf4, 0x3a, 0xa9, 0x35, 0x25, 0x59, 0x82, 0xf9, 0x0e, 0xef, 0x2f, 0x17, 0x56, 0x8c,
-	0x5e, 0x5c, 0x10, 0x0f, 0x26, 0xca, 0x4d, 0xc7, 0x97, 0xeb, 0xda, 0xc2, 0x7f, 0x1b, 0x36, 0xc6,
-	0xf1, 0xe8, 0x9c, 0xa6, 0xc3, 0x69, 0x30, 0xa1, 0x76, 0xfd, 0x57, 0xf4, 0xe8, 0x4d, 0x58, 0xbf,
-	0x3c, 0x8d, 0x5f, 0x5a, 0x96, 0x4b, 0xd2, 0xb2, 0xa4, 0x45, 0x47, 0xb0, 0x66, 0xa2, 0x0a, 0xa3,
-	0xb3, 0x78, 0xd0, 0x92, 0xa9, 0xbc, 0xfb, 0x8a, 0x54, 0xb2, 0xc5, 0x30, 0x3a, 0x8b, 0xfd, 0x82,
-	0x07, 0xef, 0x47, 0x07, 0xd6, 0xec, 0xcf, 0xff, 0xb2, 0xab, 0xfb, 0xd0, 0x4e, 0xe2, 0x50, 0x50,
-	0x87, 0x4a, 0x49, 0x4b, 0xaa, 0x63, 0x39, 0x9d, 0xc4, 0xe9, 0x5c, 0x57, 0x64, 0x26, 0x8b, 0x52,
-	0x19, 0x53, 0x36, 0x4a, 0xc3, 0x44, 0x34, 0xa8, 0x6c, 0xe8, 0x8e, 0x6f, 0xab, 0xf0, 0x13, 0xb8,
-	0x2e, 0x8b, 0x4c, 0x54, 0xc1, 0x31, 0x0f, 0xf8, 0x8c, 0x59, 0xf5, 0xe8, 0xd8, 0xf5, 0x28, 0xf4,
-	0x4c, 0x5a, 0x98, 0x3a, 0x55, 0x12, 0xbe, 0x0d, 0x1b, 0xc7, 0x3c, 0x4e, 0x0a, 0x7c, 0x5e, 0xed,
-	0xe6, 0x47, 0xb0, 0x2a, 0x2d, 0xf2, 0x43, 0x68, 0xc4, 0x05, 0xcb, 0xe8, 0x43, 0x94, 0xb4, 0xf0,
-	0x90, 0x21, 0x74, 0x0e, 0x82, 0x53, 0xbd, 0x79, 0x00, 0xcb, 0x87, 0x94, 0xb1, 0x60, 0x62, 0x1e,
-	0x0c, 0x23, 0x0a, 0x8a, 0x92, 0xef, 0x8c, 0xf9, 0xac, 0x9c, 0x14, 0x74, 0xf8, 0x67, 0x07, 0x7a,
-	0x87, 0x71, 0x14, 0xf2, 0x38, 0x7d, 0x16, 0x33, 0x9e, 0x55, 0xec, 0x6d, 0xb8, 0x76, 0x48, 0xa7,
-	0x71, 0x3a, 0x3f, 0xa2, 0xe9, 0x88, 0x46, 0x5c, 0xfa, 0x76, 0xfd, 0xa2, 0x12, 0xed, 0xc0, 0x75,
-	0xa5, 0xf0, 0x69, 0x30, 0xde, 0xb7, 0x1e, 0xb8, 0xb2, 0x5a, 0x90, 0xc5, 0xde, 0xd1, 0x89, 0x71,
-	0xd6, 0x94, 0xce, 0x2c, 0x8d, 0x88, 0x75, 0xef, 0xe8, 0x24, 0x77, 0xa3, 0x2e, 0xaf, 0xa0, 0xc3,
-	0xcb, 0x82, 0x86, 0x12, 0x3e, 0xc7, 0xef, 0xc0, 0xf5, 0xaf, 0x68, 0xca, 0xc2, 0x38, 0xca, 0xe2,
-	0x1d, 0xc0, 0xf2, 0xa5, 0x52, 0x19, 0x14, 0xb4, 0x88, 0x7f, 0x73, 0x54, 0x57, 0x3e, 0x35, 0x6f,
-	0xa3, 0xdd, 0x95, 0xf9, 0x0b, 0x6a, 0x77, 0x65, 0xc5, 0x94, 0x18, 0x8d, 0xf5, 0xc4, 0x7a, 0xa7,
-	0xb0, 0x62, 0xd4, 0x82, 0x21, 0xc3, 0x69, 0x7e, 0x05, 0x4a, 0x10, 0x95, 0xcb, 0xc2, 0x1f, 0x14,
-	0xf0, 0x4d, 0x5f, 0xae, 0x45, 0x85, 0x4e, 0x25, 0x36, 0x87, 0x9f, 0x48, 0x18, 0x9a, 0x7e, 0x26,
-	0x8b, 0x42, 0x19, 0x25, 0x33, 0x99, 0xbb, 0xeb, 0x8b, 0x25, 0x3e, 0x92, 0x1c, 0x4a, 0xed, 0x88,
-	0xaa, 0x1c, 0xfa, 0x9f, 0x58, 0xed, 0x00, 0x06, 0xc7, 0xb9, 0x3f, 0x73, 0x4d, 0xca, 0x69, 0x7d,
-	0x16, 0x76, 0xc4, 0x6e, 0x31, 0x62, 0xfc, 0x18, 0xb6, 0x2c, 0x6f, 0x7b, 0xc9, 0xec, 0x6a, 0x57,
-	0x3a, 0x41, 0x37, 0x4f, 0xf0, 0x19, 0xa0, 0xcf, 0x54, 0xc3, 0x49, 0x72, 0xd0, 0xbb, 0x17, 0x75,
-	0xdd, 0x15, 0x59, 0xe3, 0x5f, 0x1c, 0xe8, 0x15, 0x5c, 0xe9, 0x5b, 0xfe, 0x08, 0x3a, 0x61, 0xc4,
-	0x78, 0x10, 0x8d, 0x68, 0xfe, 0x44, 0xd6, 0x18, 0x92, 0xa1, 0xb6, 0xf2, 0x73, 0x7b, 0xef, 0x6b,
-	0x58, 0x31, 0xea, 0xc5, 0x77, 0xcc, 0xe7, 0x49, 0xc6, 0x4e, 0x62, 0x2d, 0xde, 0xd4, 0xd0, 0xcc,
-	0x97, 0x6e, 0x28, 0xab, 0x43, 0x74, 0x2e, 0xd5, 0xac, 0xaa, 0x84, 0xdd, 0xbf, 0x97, 0xa1, 0xfd,
-	0xa9, 0x1c, 0xc2, 0xd1, 0xfb, 0xd0, 0xc9, 0x46, 0x63, 0xd4, 0x25, 0xe5, 0x91, 0xdb, 0x43, 0xa4,
-	0x32, 0x39, 0xe3, 0x06, 0xfa, 0x00, 0x20, 0x9f, 0x87, 0x11, 0x22, 0x95, 0xe1, 0x78, 0xc1, 0xbe,
-	0xfb, 0x00, 0xf9, 0xd0, 0x8a, 0x10, 0xa9, 0x4c, 0xbd, 0x5e, 0x8f, 0x54, 0xa7, 0x5a, 0xdc, 0x40,
-	0xbb, 0xb0, 0x6a, 0x8d, 0xab, 0xa8, 0x47, 0xaa, 0xc3, 0xab, 0x07, 0x24, 0xa3, 0x26, 0xdc, 0xb8,
-	0xeb, 0xa0, 0xbb, 0xd0, 0xc9, 0x08, 0x11, 0x75, 0x49, 0x99, 0x1c, 0xbd, 0x35, 0x62, 0x31, 0xa1,
-	0xdc, 0x71, 0x1f, 0x20, 0x9f, 0x03, 0x11, 0x22, 0x95, 0x79, 0xd2, 0xeb, 0xd5, 0x0c, 0x8a, 0xb8,
-	0x81, 0xf6, 0x60, 0xbd, 0x38, 0xfa, 0xa0, 0x3e, 0xa9, 0x9d, 0xaf, 0xbc, 0xff, 0x2d, 0x98, 0x91,
-	0x70, 0x03, 0x3d, 0x80, 0xf5, 0xe2, 0xd4, 0x82, 0xfa, 0xa4, 0x76, 0x8c, 0xa9, 0x89, 0xfc, 0x11,
-	0xf4, 0x6a, 0x26, 0x06, 0xd4, 0x26, 0x92, 0xb6, 0xbc, 0x9b, 0xe4, 0x8a, 0x79, 0x02, 0x37, 0xd0,
-	0x7b, 0x70, 0xad, 0xf0, 0x90, 0x66, 0x1b, 0xfb, 0xf5, 0x0f, 0x2c, 0x6e, 0xa0, 0x87, 0x70, 0xad,
-	0x30, 0x16, 0xa1, 0x2d, 0x52, 0x37, 0x26, 0x79, 0x1b, 0xa4, 0xf4, 0xb0, 0xc9, 0x78, 0xf5, 0x81,
-	0x19, 0xb9, 0x94, 0x0e, 0xac, 0xd0, 0x20, 0x6e, 0xa0, 0x8f, 0x25, 0x3c, 0x16, 0x21, 0x29, 0x78,
-	0xaa, 0x0c, 0xb5, 0xe0, 0xc8, 0x0f, 0xa1, 0x5b, 0xa1, 0x1f, 0xf4, 0x7f, 0xb2, 0x88, 0x92, 0x3c,
-	0x1d, 0x91, 0x2c, 0xbe, 0xf5, 0x22, 0xd5, 0xa0, 0x3e, 0xa9, 0xe5, 0x1e, 0x6b, 0xcf, 0x03, 0x58,
-	0xb5, 0x3a, 0x1d, 0xf5, 0x48, 0x95, 0x6b, 0xbc, 0xcd, 0x3a, 0x32, 0xc0, 0x0d, 0x74, 0x07, 0x56,
-	0xad, 0x87, 0x31, 0x83, 0x66, 0x93, 0xd4, 0x3c, 0x97, 0x32, 0xb5, 0x37, 0x60, 0x59, 0xbf, 0x4a,
-	0x99, 0xf1, 0x06, 0x29, 0xbd, 0x53, 0xb8, 0x71, 0xda, 0x96, 0x7f, 0xdc, 0xf7, 0xfe, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x35, 0xc9, 0x53, 0xdf, 0x81, 0x0f, 0x00, 0x00,
+type ListSubmissionsRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListSubmissionsRequest) Reset()         { *m = ListSubmissionsRequest{} }
+func (m *ListSubmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubmissionsRequest) ProtoMessage()    {}
+func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{27}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ListSubmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubmissionsRequest.Unmarshal(m, b)
+}
+func (m *ListSubmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubmissionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSubmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubmissionsRequest.Merge(m, src)
+}
+func (m *ListSubmissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubmissionsRequest.Size(m)
+}
+func (m *ListSubmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubmissionsRequest.DiscardUnknown(m)
+}
 
-// DaemonClient is the client API for Daemon service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DaemonClient interface {
-	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
-	SignupUser(ctx context.Context, in *SignupUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
-	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error)
-	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (Daemon_CreateEventClient, error)
-	StopEvent(ctx context.Context, in *StopEventRequest, opts ...grpc.CallOption) (Daemon_StopEventClient, error)
-	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
-	ListEventTeams(ctx context.Context, in *ListEventTeamsRequest, opts ...grpc.CallOption) (*ListEventTeamsResponse, error)
-	RestartTeamLab(ctx context.Context, in *RestartTeamLabRequest, opts ...grpc.CallOption) (Daemon_RestartTeamLabClient, error)
-	UpdateExercisesFile(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UpdateExercisesFileResponse, error)
-	ListExercises(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListExercisesResponse, error)
-	ResetExercise(ctx context.Context, in *ResetExerciseRequest, opts ...grpc.CallOption) (Daemon_ResetExerciseClient, error)
-	ListFrontends(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListFrontendsResponse, error)
-	ResetFrontends(ctx context.Context, in *ResetFrontendsRequest, opts ...grpc.CallOption) (Daemon_ResetFrontendsClient, error)
-	SetFrontendMemory(ctx context.Context, in *SetFrontendMemoryRequest, opts ...grpc.CallOption) (*Empty, error)
-	SetFrontendCpu(ctx context.Context, in *SetFrontendCpuRequest, opts ...grpc.CallOption) (*Empty, error)
-	GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*GetTeamInfoResponse, error)
-	MonitorHost(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_MonitorHostClient, error)
-	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionResponse, error)
-}
+var xxx_messageInfo_ListSubmissionsRequest proto.InternalMessageInfo
 
-type daemonClient struct {
-	cc *grpc.ClientConn
+func (m *ListSubmissionsRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
 }
 
-func NewDaemonClient(cc *grpc.ClientConn) DaemonClient {
-	return &daemonClient{cc}
+func (m *ListSubmissionsRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
 }
 
-func (c *daemonClient) LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
-	out := new(LoginUserResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/LoginUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListSubmissionsResponse struct {
+	Submissions          []*ListSubmissionsResponse_Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func (c *daemonClient) SignupUser(ctx context.Context, in *SignupUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
-	out := new(LoginUserResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/SignupUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListSubmissionsResponse) Reset()         { *m = ListSubmissionsResponse{} }
+func (m *ListSubmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubmissionsResponse) ProtoMessage()    {}
+func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{28}
 }
 
-func (c *daemonClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
-	out := new(InviteUserResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/InviteUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListSubmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubmissionsResponse.Unmarshal(m, b)
+}
+func (m *ListSubmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubmissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSubmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubmissionsResponse.Merge(m, src)
+}
+func (m *ListSubmissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubmissionsResponse.Size(m)
+}
+func (m *ListSubmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubmissionsResponse.DiscardUnknown(m)
 }
 
-func (c *daemonClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (Daemon_CreateEventClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[0], "/Daemon/CreateEvent", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &daemonCreateEventClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ListSubmissionsResponse proto.InternalMessageInfo
+
+func (m *ListSubmissionsResponse) GetSubmissions() []*ListSubmissionsResponse_Submission {
+	if m != nil {
+		return m.Submissions
 	}
-	return x, nil
+	return nil
 }
 
-type Daemon_CreateEventClient interface {
-	Recv() (*LabStatus, error)
-	grpc.ClientStream
+type ListSubmissionsResponse_Submission struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Result               string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Correct              bool     `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Hashed               bool     `protobuf:"varint,6,opt,name=hashed,proto3" json:"hashed,omitempty"`
+	Time                 string   `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type daemonCreateEventClient struct {
-	grpc.ClientStream
+func (m *ListSubmissionsResponse_Submission) Reset()         { *m = ListSubmissionsResponse_Submission{} }
+func (m *ListSubmissionsResponse_Submission) String() string { return proto.CompactTextString(m) }
+func (*ListSubmissionsResponse_Submission) ProtoMessage()    {}
+func (*ListSubmissionsResponse_Submission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{28, 0}
 }
 
-func (x *daemonCreateEventClient) Recv() (*LabStatus, error) {
-	m := new(LabStatus)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *ListSubmissionsResponse_Submission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubmissionsResponse_Submission.Unmarshal(m, b)
+}
+func (m *ListSubmissionsResponse_Submission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubmissionsResponse_Submission.Marshal(b, m, deterministic)
+}
+func (m *ListSubmissionsResponse_Submission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubmissionsResponse_Submission.Merge(m, src)
+}
+func (m *ListSubmissionsResponse_Submission) XXX_Size() int {
+	return xxx_messageInfo_ListSubmissionsResponse_Submission.Size(m)
+}
+func (m *ListSubmissionsResponse_Submission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubmissionsResponse_Submission.DiscardUnknown(m)
 }
 
-func (c *daemonClient) StopEvent(ctx context.Context, in *StopEventRequest, opts ...grpc.CallOption) (Daemon_StopEventClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[1], "/Daemon/StopEvent", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &daemonStopEventClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ListSubmissionsResponse_Submission proto.InternalMessageInfo
+
+func (m *ListSubmissionsResponse_Submission) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
 	}
-	return x, nil
+	return ""
 }
 
-type Daemon_StopEventClient interface {
-	Recv() (*EventStatus, error)
-	grpc.ClientStream
+func (m *ListSubmissionsResponse_Submission) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
 }
 
-type daemonStopEventClient struct {
-	grpc.ClientStream
+func (m *ListSubmissionsResponse_Submission) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
 }
 
-func (x *daemonStopEventClient) Recv() (*EventStatus, error) {
-	m := new(EventStatus)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ListSubmissionsResponse_Submission) GetCorrect() bool {
+	if m != nil {
+		return m.Correct
 	}
-	return m, nil
+	return false
 }
 
-func (c *daemonClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
-	out := new(ListEventsResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/ListEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSubmissionsResponse_Submission) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return ""
 }
 
-func (c *daemonClient) ListEventTeams(ctx context.Context, in *ListEventTeamsRequest, opts ...grpc.CallOption) (*ListEventTeamsResponse, error) {
-	out := new(ListEventTeamsResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/ListEventTeams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSubmissionsResponse_Submission) GetHashed() bool {
+	if m != nil {
+		return m.Hashed
 	}
-	return out, nil
+	return false
 }
 
-func (c *daemonClient) RestartTeamLab(ctx context.Context, in *RestartTeamLabRequest, opts ...grpc.CallOption) (Daemon_RestartTeamLabClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[2], "/Daemon/RestartTeamLab", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &daemonRestartTeamLabClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ListSubmissionsResponse_Submission) GetTime() string {
+	if m != nil {
+		return m.Time
 	}
-	return x, nil
+	return ""
 }
 
-type Daemon_RestartTeamLabClient interface {
-	Recv() (*EventStatus, error)
-	grpc.ClientStream
+type GetScoreboardRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type daemonRestartTeamLabClient struct {
-	grpc.ClientStream
+func (m *GetScoreboardRequest) Reset()         { *m = GetScoreboardRequest{} }
+func (m *GetScoreboardRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScoreboardRequest) ProtoMessage()    {}
+func (*GetScoreboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{29}
 }
 
-func (x *daemonRestartTeamLabClient) Recv() (*EventStatus, error) {
-	m := new(EventStatus)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetScoreboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreboardRequest.Unmarshal(m, b)
 }
-
-func (c *daemonClient) UpdateExercisesFile(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UpdateExercisesFileResponse, error) {
-	out := new(UpdateExercisesFileResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/UpdateExercisesFile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetScoreboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreboardRequest.Marshal(b, m, deterministic)
 }
-
-func (c *daemonClient) ListExercises(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListExercisesResponse, error) {
-	out := new(ListExercisesResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/ListExercises", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetScoreboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreboardRequest.Merge(m, src)
+}
+func (m *GetScoreboardRequest) XXX_Size() int {
+	return xxx_messageInfo_GetScoreboardRequest.Size(m)
+}
+func (m *GetScoreboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreboardRequest.DiscardUnknown(m)
 }
 
-func (c *daemonClient) ResetExercise(ctx context.Context, in *ResetExerciseRequest, opts ...grpc.CallOption) (Daemon_ResetExerciseClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[3], "/Daemon/ResetExercise", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &daemonResetExerciseClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_GetScoreboardRequest proto.InternalMessageInfo
+
+func (m *GetScoreboardRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
 	}
-	return x, nil
+	return ""
 }
 
-type Daemon_ResetExerciseClient interface {
-	Recv() (*ResetTeamStatus, error)
-	grpc.ClientStream
+type GetScoreboardResponse struct {
+	Teams                []*GetScoreboardResponse_TeamScore `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-type daemonResetExerciseClient struct {
-	grpc.ClientStream
+func (m *GetScoreboardResponse) Reset()         { *m = GetScoreboardResponse{} }
+func (m *GetScoreboardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScoreboardResponse) ProtoMessage()    {}
+func (*GetScoreboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{30}
 }
 
-func (x *daemonResetExerciseClient) Recv() (*ResetTeamStatus, error) {
-	m := new(ResetTeamStatus)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetScoreboardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreboardResponse.Unmarshal(m, b)
+}
+func (m *GetScoreboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreboardResponse.Marshal(b, m, deterministic)
+}
+func (m *GetScoreboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreboardResponse.Merge(m, src)
+}
+func (m *GetScoreboardResponse) XXX_Size() int {
+	return xxx_messageInfo_GetScoreboardResponse.Size(m)
+}
+func (m *GetScoreboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreboardResponse.DiscardUnknown(m)
 }
 
-func (c *daemonClient) ListFrontends(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListFrontendsResponse, error) {
-	out := new(ListFrontendsResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/ListFrontends", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetScoreboardResponse proto.InternalMessageInfo
+
+func (m *GetScoreboardResponse) GetTeams() []*GetScoreboardResponse_TeamScore {
+	if m != nil {
+		return m.Teams
 	}
-	return out, nil
+	return nil
 }
 
-func (c *daemonClient) ResetFrontends(ctx context.Context, in *ResetFrontendsRequest, opts ...grpc.CallOption) (Daemon_ResetFrontendsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[4], "/Daemon/ResetFrontends", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &daemonResetFrontendsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GetScoreboardResponse_TeamScore struct {
+	Rank                 int32    `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	TeamName             string   `protobuf:"bytes,3,opt,name=teamName,proto3" json:"teamName,omitempty"`
+	Points               int32    `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Solves               int32    `protobuf:"varint,5,opt,name=solves,proto3" json:"solves,omitempty"`
+	LastSolve            string   `protobuf:"bytes,6,opt,name=lastSolve,proto3" json:"lastSolve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type Daemon_ResetFrontendsClient interface {
-	Recv() (*ResetTeamStatus, error)
-	grpc.ClientStream
+func (m *GetScoreboardResponse_TeamScore) Reset()         { *m = GetScoreboardResponse_TeamScore{} }
+func (m *GetScoreboardResponse_TeamScore) String() string { return proto.CompactTextString(m) }
+func (*GetScoreboardResponse_TeamScore) ProtoMessage()    {}
+func (*GetScoreboardResponse_TeamScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{30, 0}
 }
 
-type daemonResetFrontendsClient struct {
-	grpc.ClientStream
+func (m *GetScoreboardResponse_TeamScore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreboardResponse_TeamScore.Unmarshal(m, b)
+}
+func (m *GetScoreboardResponse_TeamScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreboardResponse_TeamScore.Marshal(b, m, deterministic)
+}
+func (m *GetScoreboardResponse_TeamScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreboardResponse_TeamScore.Merge(m, src)
+}
+func (m *GetScoreboardResponse_TeamScore) XXX_Size() int {
+	return xxx_messageInfo_GetScoreboardResponse_TeamScore.Size(m)
+}
+func (m *GetScoreboardResponse_TeamScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreboardResponse_TeamScore.DiscardUnknown(m)
 }
 
-func (x *daemonResetFrontendsClient) Recv() (*ResetTeamStatus, error) {
-	m := new(ResetTeamStatus)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_GetScoreboardResponse_TeamScore proto.InternalMessageInfo
+
+func (m *GetScoreboardResponse_TeamScore) GetRank() int32 {
+	if m != nil {
+		return m.Rank
 	}
-	return m, nil
+	return 0
 }
 
-func (c *daemonClient) SetFrontendMemory(ctx context.Context, in *SetFrontendMemoryRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (m *GetScoreboardResponse_TeamScore) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *GetScoreboardResponse_TeamScore) GetTeamName() string {
+	if m != nil {
+		return m.TeamName
+	}
+	return ""
+}
+
+func (m *GetScoreboardResponse_TeamScore) GetPoints() int32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *GetScoreboardResponse_TeamScore) GetSolves() int32 {
+	if m != nil {
+		return m.Solves
+	}
+	return 0
+}
+
+func (m *GetScoreboardResponse_TeamScore) GetLastSolve() string {
+	if m != nil {
+		return m.LastSolve
+	}
+	return ""
+}
+
+type StreamSolvesRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamSolvesRequest) Reset()         { *m = StreamSolvesRequest{} }
+func (m *StreamSolvesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamSolvesRequest) ProtoMessage()    {}
+func (*StreamSolvesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{31}
+}
+
+func (m *StreamSolvesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamSolvesRequest.Unmarshal(m, b)
+}
+func (m *StreamSolvesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamSolvesRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamSolvesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamSolvesRequest.Merge(m, src)
+}
+func (m *StreamSolvesRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamSolvesRequest.Size(m)
+}
+func (m *StreamSolvesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamSolvesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamSolvesRequest proto.InternalMessageInfo
+
+func (m *StreamSolvesRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+type Solve struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	TeamName             string   `protobuf:"bytes,2,opt,name=teamName,proto3" json:"teamName,omitempty"`
+	ChallengeTag         string   `protobuf:"bytes,3,opt,name=challengeTag,proto3" json:"challengeTag,omitempty"`
+	Points               int32    `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	CompletedAt          string   `protobuf:"bytes,5,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Solve) Reset()         { *m = Solve{} }
+func (m *Solve) String() string { return proto.CompactTextString(m) }
+func (*Solve) ProtoMessage()    {}
+func (*Solve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{32}
+}
+
+func (m *Solve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Solve.Unmarshal(m, b)
+}
+func (m *Solve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Solve.Marshal(b, m, deterministic)
+}
+func (m *Solve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Solve.Merge(m, src)
+}
+func (m *Solve) XXX_Size() int {
+	return xxx_messageInfo_Solve.Size(m)
+}
+func (m *Solve) XXX_DiscardUnknown() {
+	xxx_messageInfo_Solve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Solve proto.InternalMessageInfo
+
+func (m *Solve) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *Solve) GetTeamName() string {
+	if m != nil {
+		return m.TeamName
+	}
+	return ""
+}
+
+func (m *Solve) GetChallengeTag() string {
+	if m != nil {
+		return m.ChallengeTag
+	}
+	return ""
+}
+
+func (m *Solve) GetPoints() int32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *Solve) GetCompletedAt() string {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return ""
+}
+
+type ExportEventRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportEventRequest) Reset()         { *m = ExportEventRequest{} }
+func (m *ExportEventRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportEventRequest) ProtoMessage()    {}
+func (*ExportEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{33}
+}
+
+func (m *ExportEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportEventRequest.Unmarshal(m, b)
+}
+func (m *ExportEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportEventRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportEventRequest.Merge(m, src)
+}
+func (m *ExportEventRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportEventRequest.Size(m)
+}
+func (m *ExportEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportEventRequest proto.InternalMessageInfo
+
+func (m *ExportEventRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type ExportEventResponse struct {
+	EventTag             string                     `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	EventName            string                     `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	Rows                 []*ExportEventResponse_Row `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ExportEventResponse) Reset()         { *m = ExportEventResponse{} }
+func (m *ExportEventResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportEventResponse) ProtoMessage()    {}
+func (*ExportEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{34}
+}
+
+func (m *ExportEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportEventResponse.Unmarshal(m, b)
+}
+func (m *ExportEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportEventResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportEventResponse.Merge(m, src)
+}
+func (m *ExportEventResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportEventResponse.Size(m)
+}
+func (m *ExportEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportEventResponse proto.InternalMessageInfo
+
+func (m *ExportEventResponse) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *ExportEventResponse) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *ExportEventResponse) GetRows() []*ExportEventResponse_Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+type ExportEventResponse_Row struct {
+	TeamId                   string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	TeamName                 string   `protobuf:"bytes,2,opt,name=teamName,proto3" json:"teamName,omitempty"`
+	ChallengeTag             string   `protobuf:"bytes,3,opt,name=challengeTag,proto3" json:"challengeTag,omitempty"`
+	ChallengeName            string   `protobuf:"bytes,4,opt,name=challengeName,proto3" json:"challengeName,omitempty"`
+	Category                 string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Points                   int32    `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`
+	CompletedAt              string   `protobuf:"bytes,7,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+	SecondsSinceTeamCreation int64    `protobuf:"varint,8,opt,name=secondsSinceTeamCreation,proto3" json:"secondsSinceTeamCreation,omitempty"`
+	HintPenalty              int32    `protobuf:"varint,9,opt,name=hintPenalty,proto3" json:"hintPenalty,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *ExportEventResponse_Row) Reset()         { *m = ExportEventResponse_Row{} }
+func (m *ExportEventResponse_Row) String() string { return proto.CompactTextString(m) }
+func (*ExportEventResponse_Row) ProtoMessage()    {}
+func (*ExportEventResponse_Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{34, 0}
+}
+
+func (m *ExportEventResponse_Row) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportEventResponse_Row.Unmarshal(m, b)
+}
+func (m *ExportEventResponse_Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportEventResponse_Row.Marshal(b, m, deterministic)
+}
+func (m *ExportEventResponse_Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportEventResponse_Row.Merge(m, src)
+}
+func (m *ExportEventResponse_Row) XXX_Size() int {
+	return xxx_messageInfo_ExportEventResponse_Row.Size(m)
+}
+func (m *ExportEventResponse_Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportEventResponse_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportEventResponse_Row proto.InternalMessageInfo
+
+func (m *ExportEventResponse_Row) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ExportEventResponse_Row) GetTeamName() string {
+	if m != nil {
+		return m.TeamName
+	}
+	return ""
+}
+
+func (m *ExportEventResponse_Row) GetChallengeTag() string {
+	if m != nil {
+		return m.ChallengeTag
+	}
+	return ""
+}
+
+func (m *ExportEventResponse_Row) GetChallengeName() string {
+	if m != nil {
+		return m.ChallengeName
+	}
+	return ""
+}
+
+func (m *ExportEventResponse_Row) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *ExportEventResponse_Row) GetPoints() int32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *ExportEventResponse_Row) GetCompletedAt() string {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return ""
+}
+
+func (m *ExportEventResponse_Row) GetSecondsSinceTeamCreation() int64 {
+	if m != nil {
+		return m.SecondsSinceTeamCreation
+	}
+	return 0
+}
+
+func (m *ExportEventResponse_Row) GetHintPenalty() int32 {
+	if m != nil {
+		return m.HintPenalty
+	}
+	return 0
+}
+
+type RestartTeamLabRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartTeamLabRequest) Reset()         { *m = RestartTeamLabRequest{} }
+func (m *RestartTeamLabRequest) String() string { return proto.CompactTextString(m) }
+func (*RestartTeamLabRequest) ProtoMessage()    {}
+func (*RestartTeamLabRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{35}
+}
+
+func (m *RestartTeamLabRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartTeamLabRequest.Unmarshal(m, b)
+}
+func (m *RestartTeamLabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartTeamLabRequest.Marshal(b, m, deterministic)
+}
+func (m *RestartTeamLabRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartTeamLabRequest.Merge(m, src)
+}
+func (m *RestartTeamLabRequest) XXX_Size() int {
+	return xxx_messageInfo_RestartTeamLabRequest.Size(m)
+}
+func (m *RestartTeamLabRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartTeamLabRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartTeamLabRequest proto.InternalMessageInfo
+
+func (m *RestartTeamLabRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *RestartTeamLabRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type SnapshotTeamLabRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotTeamLabRequest) Reset()         { *m = SnapshotTeamLabRequest{} }
+func (m *SnapshotTeamLabRequest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotTeamLabRequest) ProtoMessage()    {}
+func (*SnapshotTeamLabRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{36}
+}
+
+func (m *SnapshotTeamLabRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotTeamLabRequest.Unmarshal(m, b)
+}
+func (m *SnapshotTeamLabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotTeamLabRequest.Marshal(b, m, deterministic)
+}
+func (m *SnapshotTeamLabRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotTeamLabRequest.Merge(m, src)
+}
+func (m *SnapshotTeamLabRequest) XXX_Size() int {
+	return xxx_messageInfo_SnapshotTeamLabRequest.Size(m)
+}
+func (m *SnapshotTeamLabRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotTeamLabRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotTeamLabRequest proto.InternalMessageInfo
+
+func (m *SnapshotTeamLabRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *SnapshotTeamLabRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *SnapshotTeamLabRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RestoreTeamLabRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTeamLabRequest) Reset()         { *m = RestoreTeamLabRequest{} }
+func (m *RestoreTeamLabRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTeamLabRequest) ProtoMessage()    {}
+func (*RestoreTeamLabRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{37}
+}
+
+func (m *RestoreTeamLabRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTeamLabRequest.Unmarshal(m, b)
+}
+func (m *RestoreTeamLabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTeamLabRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTeamLabRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTeamLabRequest.Merge(m, src)
+}
+func (m *RestoreTeamLabRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTeamLabRequest.Size(m)
+}
+func (m *RestoreTeamLabRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTeamLabRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTeamLabRequest proto.InternalMessageInfo
+
+func (m *RestoreTeamLabRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *RestoreTeamLabRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *RestoreTeamLabRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateTeamsRequest struct {
+	EventTag             string                     `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Teams                []*CreateTeamsRequest_Team `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CreateTeamsRequest) Reset()         { *m = CreateTeamsRequest{} }
+func (m *CreateTeamsRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamsRequest) ProtoMessage()    {}
+func (*CreateTeamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{38}
+}
+
+func (m *CreateTeamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamsRequest.Unmarshal(m, b)
+}
+func (m *CreateTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamsRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTeamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamsRequest.Merge(m, src)
+}
+func (m *CreateTeamsRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamsRequest.Size(m)
+}
+func (m *CreateTeamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamsRequest proto.InternalMessageInfo
+
+func (m *CreateTeamsRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *CreateTeamsRequest) GetTeams() []*CreateTeamsRequest_Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type CreateTeamsRequest_Team struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTeamsRequest_Team) Reset()         { *m = CreateTeamsRequest_Team{} }
+func (m *CreateTeamsRequest_Team) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamsRequest_Team) ProtoMessage()    {}
+func (*CreateTeamsRequest_Team) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{38, 0}
+}
+
+func (m *CreateTeamsRequest_Team) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamsRequest_Team.Unmarshal(m, b)
+}
+func (m *CreateTeamsRequest_Team) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamsRequest_Team.Marshal(b, m, deterministic)
+}
+func (m *CreateTeamsRequest_Team) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamsRequest_Team.Merge(m, src)
+}
+func (m *CreateTeamsRequest_Team) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamsRequest_Team.Size(m)
+}
+func (m *CreateTeamsRequest_Team) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamsRequest_Team.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamsRequest_Team proto.InternalMessageInfo
+
+func (m *CreateTeamsRequest_Team) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateTeamsRequest_Team) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type CreateTeamsResponse struct {
+	Teams                []*CreateTeamsResponse_Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CreateTeamsResponse) Reset()         { *m = CreateTeamsResponse{} }
+func (m *CreateTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamsResponse) ProtoMessage()    {}
+func (*CreateTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{39}
+}
+
+func (m *CreateTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamsResponse.Unmarshal(m, b)
+}
+func (m *CreateTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamsResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamsResponse.Merge(m, src)
+}
+func (m *CreateTeamsResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamsResponse.Size(m)
+}
+func (m *CreateTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamsResponse proto.InternalMessageInfo
+
+func (m *CreateTeamsResponse) GetTeams() []*CreateTeamsResponse_Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type CreateTeamsResponse_Team struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTeamsResponse_Team) Reset()         { *m = CreateTeamsResponse_Team{} }
+func (m *CreateTeamsResponse_Team) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamsResponse_Team) ProtoMessage()    {}
+func (*CreateTeamsResponse_Team) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{39, 0}
+}
+
+func (m *CreateTeamsResponse_Team) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamsResponse_Team.Unmarshal(m, b)
+}
+func (m *CreateTeamsResponse_Team) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamsResponse_Team.Marshal(b, m, deterministic)
+}
+func (m *CreateTeamsResponse_Team) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamsResponse_Team.Merge(m, src)
+}
+func (m *CreateTeamsResponse_Team) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamsResponse_Team.Size(m)
+}
+func (m *CreateTeamsResponse_Team) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamsResponse_Team.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamsResponse_Team proto.InternalMessageInfo
+
+func (m *CreateTeamsResponse_Team) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateTeamsResponse_Team) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateTeamsResponse_Team) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *CreateTeamsResponse_Team) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *CreateTeamsResponse_Team) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DeleteTeamRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTeamRequest) Reset()         { *m = DeleteTeamRequest{} }
+func (m *DeleteTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTeamRequest) ProtoMessage()    {}
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{40}
+}
+
+func (m *DeleteTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTeamRequest.Unmarshal(m, b)
+}
+func (m *DeleteTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTeamRequest.Merge(m, src)
+}
+func (m *DeleteTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTeamRequest.Size(m)
+}
+func (m *DeleteTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTeamRequest proto.InternalMessageInfo
+
+func (m *DeleteTeamRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *DeleteTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type RenameTeamRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameTeamRequest) Reset()         { *m = RenameTeamRequest{} }
+func (m *RenameTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameTeamRequest) ProtoMessage()    {}
+func (*RenameTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{41}
+}
+
+func (m *RenameTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameTeamRequest.Unmarshal(m, b)
+}
+func (m *RenameTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameTeamRequest.Merge(m, src)
+}
+func (m *RenameTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameTeamRequest.Size(m)
+}
+func (m *RenameTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameTeamRequest proto.InternalMessageInfo
+
+func (m *RenameTeamRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *RenameTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *RenameTeamRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ResetTeamPasswordRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetTeamPasswordRequest) Reset()         { *m = ResetTeamPasswordRequest{} }
+func (m *ResetTeamPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetTeamPasswordRequest) ProtoMessage()    {}
+func (*ResetTeamPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{42}
+}
+
+func (m *ResetTeamPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetTeamPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetTeamPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetTeamPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetTeamPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetTeamPasswordRequest.Merge(m, src)
+}
+func (m *ResetTeamPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetTeamPasswordRequest.Size(m)
+}
+func (m *ResetTeamPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetTeamPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetTeamPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetTeamPasswordRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *ResetTeamPasswordRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ResetTeamPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ResetTeamPasswordResponse struct {
+	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetTeamPasswordResponse) Reset()         { *m = ResetTeamPasswordResponse{} }
+func (m *ResetTeamPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetTeamPasswordResponse) ProtoMessage()    {}
+func (*ResetTeamPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{43}
+}
+
+func (m *ResetTeamPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetTeamPasswordResponse.Unmarshal(m, b)
+}
+func (m *ResetTeamPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetTeamPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetTeamPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetTeamPasswordResponse.Merge(m, src)
+}
+func (m *ResetTeamPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetTeamPasswordResponse.Size(m)
+}
+func (m *ResetTeamPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetTeamPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetTeamPasswordResponse proto.InternalMessageInfo
+
+func (m *ResetTeamPasswordResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type MoveTeamLabRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId               string   `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTeamLabRequest) Reset()         { *m = MoveTeamLabRequest{} }
+func (m *MoveTeamLabRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveTeamLabRequest) ProtoMessage()    {}
+func (*MoveTeamLabRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{44}
+}
+
+func (m *MoveTeamLabRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveTeamLabRequest.Unmarshal(m, b)
+}
+func (m *MoveTeamLabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveTeamLabRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveTeamLabRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTeamLabRequest.Merge(m, src)
+}
+func (m *MoveTeamLabRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveTeamLabRequest.Size(m)
+}
+func (m *MoveTeamLabRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTeamLabRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTeamLabRequest proto.InternalMessageInfo
+
+func (m *MoveTeamLabRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *MoveTeamLabRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type EventExercisesRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Exercises            []string `protobuf:"bytes,2,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventExercisesRequest) Reset()         { *m = EventExercisesRequest{} }
+func (m *EventExercisesRequest) String() string { return proto.CompactTextString(m) }
+func (*EventExercisesRequest) ProtoMessage()    {}
+func (*EventExercisesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{45}
+}
+
+func (m *EventExercisesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventExercisesRequest.Unmarshal(m, b)
+}
+func (m *EventExercisesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventExercisesRequest.Marshal(b, m, deterministic)
+}
+func (m *EventExercisesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExercisesRequest.Merge(m, src)
+}
+func (m *EventExercisesRequest) XXX_Size() int {
+	return xxx_messageInfo_EventExercisesRequest.Size(m)
+}
+func (m *EventExercisesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExercisesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExercisesRequest proto.InternalMessageInfo
+
+func (m *EventExercisesRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *EventExercisesRequest) GetExercises() []string {
+	if m != nil {
+		return m.Exercises
+	}
+	return nil
+}
+
+type ResetExerciseRequest struct {
+	ExerciseTag          string   `protobuf:"bytes,1,opt,name=exerciseTag,proto3" json:"exerciseTag,omitempty"`
+	EventTag             string   `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Teams                []*Team  `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetExerciseRequest) Reset()         { *m = ResetExerciseRequest{} }
+func (m *ResetExerciseRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetExerciseRequest) ProtoMessage()    {}
+func (*ResetExerciseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{46}
+}
+
+func (m *ResetExerciseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetExerciseRequest.Unmarshal(m, b)
+}
+func (m *ResetExerciseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetExerciseRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetExerciseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetExerciseRequest.Merge(m, src)
+}
+func (m *ResetExerciseRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetExerciseRequest.Size(m)
+}
+func (m *ResetExerciseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetExerciseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetExerciseRequest proto.InternalMessageInfo
+
+func (m *ResetExerciseRequest) GetExerciseTag() string {
+	if m != nil {
+		return m.ExerciseTag
+	}
+	return ""
+}
+
+func (m *ResetExerciseRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *ResetExerciseRequest) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type UpdateExercisesFileRequest struct {
+	DryRun               bool     `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateExercisesFileRequest) Reset()         { *m = UpdateExercisesFileRequest{} }
+func (m *UpdateExercisesFileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateExercisesFileRequest) ProtoMessage()    {}
+func (*UpdateExercisesFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{47}
+}
+
+func (m *UpdateExercisesFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateExercisesFileRequest.Unmarshal(m, b)
+}
+func (m *UpdateExercisesFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateExercisesFileRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateExercisesFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateExercisesFileRequest.Merge(m, src)
+}
+func (m *UpdateExercisesFileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateExercisesFileRequest.Size(m)
+}
+func (m *UpdateExercisesFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateExercisesFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateExercisesFileRequest proto.InternalMessageInfo
+
+func (m *UpdateExercisesFileRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ExercisesDiff struct {
+	Added                []*ExercisesDiff_Exercise `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed              []*ExercisesDiff_Exercise `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Changed              []*ExercisesDiff_Exercise `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ExercisesDiff) Reset()         { *m = ExercisesDiff{} }
+func (m *ExercisesDiff) String() string { return proto.CompactTextString(m) }
+func (*ExercisesDiff) ProtoMessage()    {}
+func (*ExercisesDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{48}
+}
+
+func (m *ExercisesDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExercisesDiff.Unmarshal(m, b)
+}
+func (m *ExercisesDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExercisesDiff.Marshal(b, m, deterministic)
+}
+func (m *ExercisesDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExercisesDiff.Merge(m, src)
+}
+func (m *ExercisesDiff) XXX_Size() int {
+	return xxx_messageInfo_ExercisesDiff.Size(m)
+}
+func (m *ExercisesDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExercisesDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExercisesDiff proto.InternalMessageInfo
+
+func (m *ExercisesDiff) GetAdded() []*ExercisesDiff_Exercise {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *ExercisesDiff) GetRemoved() []*ExercisesDiff_Exercise {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *ExercisesDiff) GetChanged() []*ExercisesDiff_Exercise {
+	if m != nil {
+		return m.Changed
+	}
+	return nil
+}
+
+type ExercisesDiff_Exercise struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AddedFlags           []string `protobuf:"bytes,3,rep,name=addedFlags,proto3" json:"addedFlags,omitempty"`
+	RemovedFlags         []string `protobuf:"bytes,4,rep,name=removedFlags,proto3" json:"removedFlags,omitempty"`
+	ChangedFlags         []string `protobuf:"bytes,5,rep,name=changedFlags,proto3" json:"changedFlags,omitempty"`
+	AddedImages          []string `protobuf:"bytes,6,rep,name=addedImages,proto3" json:"addedImages,omitempty"`
+	RemovedImages        []string `protobuf:"bytes,7,rep,name=removedImages,proto3" json:"removedImages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExercisesDiff_Exercise) Reset()         { *m = ExercisesDiff_Exercise{} }
+func (m *ExercisesDiff_Exercise) String() string { return proto.CompactTextString(m) }
+func (*ExercisesDiff_Exercise) ProtoMessage()    {}
+func (*ExercisesDiff_Exercise) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{48, 0}
+}
+
+func (m *ExercisesDiff_Exercise) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExercisesDiff_Exercise.Unmarshal(m, b)
+}
+func (m *ExercisesDiff_Exercise) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExercisesDiff_Exercise.Marshal(b, m, deterministic)
+}
+func (m *ExercisesDiff_Exercise) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExercisesDiff_Exercise.Merge(m, src)
+}
+func (m *ExercisesDiff_Exercise) XXX_Size() int {
+	return xxx_messageInfo_ExercisesDiff_Exercise.Size(m)
+}
+func (m *ExercisesDiff_Exercise) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExercisesDiff_Exercise.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExercisesDiff_Exercise proto.InternalMessageInfo
+
+func (m *ExercisesDiff_Exercise) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *ExercisesDiff_Exercise) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExercisesDiff_Exercise) GetAddedFlags() []string {
+	if m != nil {
+		return m.AddedFlags
+	}
+	return nil
+}
+
+func (m *ExercisesDiff_Exercise) GetRemovedFlags() []string {
+	if m != nil {
+		return m.RemovedFlags
+	}
+	return nil
+}
+
+func (m *ExercisesDiff_Exercise) GetChangedFlags() []string {
+	if m != nil {
+		return m.ChangedFlags
+	}
+	return nil
+}
+
+func (m *ExercisesDiff_Exercise) GetAddedImages() []string {
+	if m != nil {
+		return m.AddedImages
+	}
+	return nil
+}
+
+func (m *ExercisesDiff_Exercise) GetRemovedImages() []string {
+	if m != nil {
+		return m.RemovedImages
+	}
+	return nil
+}
+
+type UpdateExercisesFileResponse struct {
+	Msg                  string                               `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	ValidationError      string                               `protobuf:"bytes,2,opt,name=validationError,proto3" json:"validationError,omitempty"`
+	Diff                 *ExercisesDiff                       `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	AffectedEvents       []*UpdateExercisesFileResponse_Event `protobuf:"bytes,4,rep,name=affectedEvents,proto3" json:"affectedEvents,omitempty"`
+	Applied              bool                                 `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *UpdateExercisesFileResponse) Reset()         { *m = UpdateExercisesFileResponse{} }
+func (m *UpdateExercisesFileResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateExercisesFileResponse) ProtoMessage()    {}
+func (*UpdateExercisesFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{49}
+}
+
+func (m *UpdateExercisesFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateExercisesFileResponse.Unmarshal(m, b)
+}
+func (m *UpdateExercisesFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateExercisesFileResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateExercisesFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateExercisesFileResponse.Merge(m, src)
+}
+func (m *UpdateExercisesFileResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateExercisesFileResponse.Size(m)
+}
+func (m *UpdateExercisesFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateExercisesFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateExercisesFileResponse proto.InternalMessageInfo
+
+func (m *UpdateExercisesFileResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *UpdateExercisesFileResponse) GetValidationError() string {
+	if m != nil {
+		return m.ValidationError
+	}
+	return ""
+}
+
+func (m *UpdateExercisesFileResponse) GetDiff() *ExercisesDiff {
+	if m != nil {
+		return m.Diff
+	}
+	return nil
+}
+
+func (m *UpdateExercisesFileResponse) GetAffectedEvents() []*UpdateExercisesFileResponse_Event {
+	if m != nil {
+		return m.AffectedEvents
+	}
+	return nil
+}
+
+func (m *UpdateExercisesFileResponse) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+type UpdateExercisesFileResponse_Event struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Exercises            []string `protobuf:"bytes,2,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateExercisesFileResponse_Event) Reset()         { *m = UpdateExercisesFileResponse_Event{} }
+func (m *UpdateExercisesFileResponse_Event) String() string { return proto.CompactTextString(m) }
+func (*UpdateExercisesFileResponse_Event) ProtoMessage()    {}
+func (*UpdateExercisesFileResponse_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{49, 0}
+}
+
+func (m *UpdateExercisesFileResponse_Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateExercisesFileResponse_Event.Unmarshal(m, b)
+}
+func (m *UpdateExercisesFileResponse_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateExercisesFileResponse_Event.Marshal(b, m, deterministic)
+}
+func (m *UpdateExercisesFileResponse_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateExercisesFileResponse_Event.Merge(m, src)
+}
+func (m *UpdateExercisesFileResponse_Event) XXX_Size() int {
+	return xxx_messageInfo_UpdateExercisesFileResponse_Event.Size(m)
+}
+func (m *UpdateExercisesFileResponse_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateExercisesFileResponse_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateExercisesFileResponse_Event proto.InternalMessageInfo
+
+func (m *UpdateExercisesFileResponse_Event) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *UpdateExercisesFileResponse_Event) GetExercises() []string {
+	if m != nil {
+		return m.Exercises
+	}
+	return nil
+}
+
+type ValidateExercisesFileRequest struct {
+	Content              []byte   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateExercisesFileRequest) Reset()         { *m = ValidateExercisesFileRequest{} }
+func (m *ValidateExercisesFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateExercisesFileRequest) ProtoMessage()    {}
+func (*ValidateExercisesFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{50}
+}
+
+func (m *ValidateExercisesFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateExercisesFileRequest.Unmarshal(m, b)
+}
+func (m *ValidateExercisesFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateExercisesFileRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateExercisesFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateExercisesFileRequest.Merge(m, src)
+}
+func (m *ValidateExercisesFileRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateExercisesFileRequest.Size(m)
+}
+func (m *ValidateExercisesFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateExercisesFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateExercisesFileRequest proto.InternalMessageInfo
+
+func (m *ValidateExercisesFileRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type ValidateExercisesFileResponse struct {
+	Issues               []*ValidateExercisesFileResponse_Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *ValidateExercisesFileResponse) Reset()         { *m = ValidateExercisesFileResponse{} }
+func (m *ValidateExercisesFileResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateExercisesFileResponse) ProtoMessage()    {}
+func (*ValidateExercisesFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{51}
+}
+
+func (m *ValidateExercisesFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateExercisesFileResponse.Unmarshal(m, b)
+}
+func (m *ValidateExercisesFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateExercisesFileResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateExercisesFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateExercisesFileResponse.Merge(m, src)
+}
+func (m *ValidateExercisesFileResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateExercisesFileResponse.Size(m)
+}
+func (m *ValidateExercisesFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateExercisesFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateExercisesFileResponse proto.InternalMessageInfo
+
+func (m *ValidateExercisesFileResponse) GetIssues() []*ValidateExercisesFileResponse_Issue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+type ValidateExercisesFileResponse_Issue struct {
+	Exercise             string   `protobuf:"bytes,1,opt,name=exercise,proto3" json:"exercise,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateExercisesFileResponse_Issue) Reset()         { *m = ValidateExercisesFileResponse_Issue{} }
+func (m *ValidateExercisesFileResponse_Issue) String() string { return proto.CompactTextString(m) }
+func (*ValidateExercisesFileResponse_Issue) ProtoMessage()    {}
+func (*ValidateExercisesFileResponse_Issue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{51, 0}
+}
+
+func (m *ValidateExercisesFileResponse_Issue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateExercisesFileResponse_Issue.Unmarshal(m, b)
+}
+func (m *ValidateExercisesFileResponse_Issue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateExercisesFileResponse_Issue.Marshal(b, m, deterministic)
+}
+func (m *ValidateExercisesFileResponse_Issue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateExercisesFileResponse_Issue.Merge(m, src)
+}
+func (m *ValidateExercisesFileResponse_Issue) XXX_Size() int {
+	return xxx_messageInfo_ValidateExercisesFileResponse_Issue.Size(m)
+}
+func (m *ValidateExercisesFileResponse_Issue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateExercisesFileResponse_Issue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateExercisesFileResponse_Issue proto.InternalMessageInfo
+
+func (m *ValidateExercisesFileResponse_Issue) GetExercise() string {
+	if m != nil {
+		return m.Exercise
+	}
+	return ""
+}
+
+func (m *ValidateExercisesFileResponse_Issue) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type ListExercisesResponse struct {
+	Exercises            []*ListExercisesResponse_Exercise `protobuf:"bytes,1,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ListExercisesResponse) Reset()         { *m = ListExercisesResponse{} }
+func (m *ListExercisesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExercisesResponse) ProtoMessage()    {}
+func (*ListExercisesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{52}
+}
+
+func (m *ListExercisesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExercisesResponse.Unmarshal(m, b)
+}
+func (m *ListExercisesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExercisesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListExercisesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExercisesResponse.Merge(m, src)
+}
+func (m *ListExercisesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExercisesResponse.Size(m)
+}
+func (m *ListExercisesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExercisesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExercisesResponse proto.InternalMessageInfo
+
+func (m *ListExercisesResponse) GetExercises() []*ListExercisesResponse_Exercise {
+	if m != nil {
+		return m.Exercises
+	}
+	return nil
+}
+
+type ListExercisesResponse_Exercise struct {
+	Tags                 []string                                       `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Name                 string                                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DockerImageCount     int32                                          `protobuf:"varint,3,opt,name=dockerImageCount,proto3" json:"dockerImageCount,omitempty"`
+	VboxImageCount       int32                                          `protobuf:"varint,4,opt,name=vboxImageCount,proto3" json:"vboxImageCount,omitempty"`
+	Exerciseinfo         []*ListExercisesResponse_Exercise_ExerciseInfo `protobuf:"bytes,5,rep,name=exerciseinfo,proto3" json:"exerciseinfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ListExercisesResponse_Exercise) Reset()         { *m = ListExercisesResponse_Exercise{} }
+func (m *ListExercisesResponse_Exercise) String() string { return proto.CompactTextString(m) }
+func (*ListExercisesResponse_Exercise) ProtoMessage()    {}
+func (*ListExercisesResponse_Exercise) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{52, 0}
+}
+
+func (m *ListExercisesResponse_Exercise) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExercisesResponse_Exercise.Unmarshal(m, b)
+}
+func (m *ListExercisesResponse_Exercise) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExercisesResponse_Exercise.Marshal(b, m, deterministic)
+}
+func (m *ListExercisesResponse_Exercise) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExercisesResponse_Exercise.Merge(m, src)
+}
+func (m *ListExercisesResponse_Exercise) XXX_Size() int {
+	return xxx_messageInfo_ListExercisesResponse_Exercise.Size(m)
+}
+func (m *ListExercisesResponse_Exercise) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExercisesResponse_Exercise.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExercisesResponse_Exercise proto.InternalMessageInfo
+
+func (m *ListExercisesResponse_Exercise) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListExercisesResponse_Exercise) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListExercisesResponse_Exercise) GetDockerImageCount() int32 {
+	if m != nil {
+		return m.DockerImageCount
+	}
+	return 0
+}
+
+func (m *ListExercisesResponse_Exercise) GetVboxImageCount() int32 {
+	if m != nil {
+		return m.VboxImageCount
+	}
+	return 0
+}
+
+func (m *ListExercisesResponse_Exercise) GetExerciseinfo() []*ListExercisesResponse_Exercise_ExerciseInfo {
+	if m != nil {
+		return m.Exerciseinfo
+	}
+	return nil
+}
+
+type ListExercisesResponse_Exercise_ExerciseInfo struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Points               int32    `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Category             string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Description          string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) Reset() {
+	*m = ListExercisesResponse_Exercise_ExerciseInfo{}
+}
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ListExercisesResponse_Exercise_ExerciseInfo) ProtoMessage() {}
+func (*ListExercisesResponse_Exercise_ExerciseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{52, 0, 0}
+}
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Unmarshal(m, b)
+}
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Marshal(b, m, deterministic)
+}
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Merge(m, src)
+}
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_Size() int {
+	return xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.Size(m)
+}
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExercisesResponse_Exercise_ExerciseInfo proto.InternalMessageInfo
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetPoints() int32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *ListExercisesResponse_Exercise_ExerciseInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type ResetTeamStatus struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetTeamStatus) Reset()         { *m = ResetTeamStatus{} }
+func (m *ResetTeamStatus) String() string { return proto.CompactTextString(m) }
+func (*ResetTeamStatus) ProtoMessage()    {}
+func (*ResetTeamStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{53}
+}
+
+func (m *ResetTeamStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetTeamStatus.Unmarshal(m, b)
+}
+func (m *ResetTeamStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetTeamStatus.Marshal(b, m, deterministic)
+}
+func (m *ResetTeamStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetTeamStatus.Merge(m, src)
+}
+func (m *ResetTeamStatus) XXX_Size() int {
+	return xxx_messageInfo_ResetTeamStatus.Size(m)
+}
+func (m *ResetTeamStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetTeamStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetTeamStatus proto.InternalMessageInfo
+
+func (m *ResetTeamStatus) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ResetTeamStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type StopEventRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopEventRequest) Reset()         { *m = StopEventRequest{} }
+func (m *StopEventRequest) String() string { return proto.CompactTextString(m) }
+func (*StopEventRequest) ProtoMessage()    {}
+func (*StopEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{54}
+}
+
+func (m *StopEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopEventRequest.Unmarshal(m, b)
+}
+func (m *StopEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopEventRequest.Marshal(b, m, deterministic)
+}
+func (m *StopEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopEventRequest.Merge(m, src)
+}
+func (m *StopEventRequest) XXX_Size() int {
+	return xxx_messageInfo_StopEventRequest.Size(m)
+}
+func (m *StopEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopEventRequest proto.InternalMessageInfo
+
+func (m *StopEventRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type SuspendEventRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuspendEventRequest) Reset()         { *m = SuspendEventRequest{} }
+func (m *SuspendEventRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendEventRequest) ProtoMessage()    {}
+func (*SuspendEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{55}
+}
+
+func (m *SuspendEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendEventRequest.Unmarshal(m, b)
+}
+func (m *SuspendEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendEventRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendEventRequest.Merge(m, src)
+}
+func (m *SuspendEventRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendEventRequest.Size(m)
+}
+func (m *SuspendEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendEventRequest proto.InternalMessageInfo
+
+func (m *SuspendEventRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type ResumeEventRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeEventRequest) Reset()         { *m = ResumeEventRequest{} }
+func (m *ResumeEventRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeEventRequest) ProtoMessage()    {}
+func (*ResumeEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{56}
+}
+
+func (m *ResumeEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeEventRequest.Unmarshal(m, b)
+}
+func (m *ResumeEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeEventRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeEventRequest.Merge(m, src)
+}
+func (m *ResumeEventRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeEventRequest.Size(m)
+}
+func (m *ResumeEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeEventRequest proto.InternalMessageInfo
+
+func (m *ResumeEventRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type EventStatus struct {
+	Entity               string   `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventStatus) Reset()         { *m = EventStatus{} }
+func (m *EventStatus) String() string { return proto.CompactTextString(m) }
+func (*EventStatus) ProtoMessage()    {}
+func (*EventStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{57}
+}
+
+func (m *EventStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventStatus.Unmarshal(m, b)
+}
+func (m *EventStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventStatus.Marshal(b, m, deterministic)
+}
+func (m *EventStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStatus.Merge(m, src)
+}
+func (m *EventStatus) XXX_Size() int {
+	return xxx_messageInfo_EventStatus.Size(m)
+}
+func (m *EventStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStatus proto.InternalMessageInfo
+
+func (m *EventStatus) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *EventStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type LabStatus struct {
+	Message              string   `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabStatus) Reset()         { *m = LabStatus{} }
+func (m *LabStatus) String() string { return proto.CompactTextString(m) }
+func (*LabStatus) ProtoMessage()    {}
+func (*LabStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{58}
+}
+
+func (m *LabStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabStatus.Unmarshal(m, b)
+}
+func (m *LabStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabStatus.Marshal(b, m, deterministic)
+}
+func (m *LabStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabStatus.Merge(m, src)
+}
+func (m *LabStatus) XXX_Size() int {
+	return xxx_messageInfo_LabStatus.Size(m)
+}
+func (m *LabStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabStatus proto.InternalMessageInfo
+
+func (m *LabStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *LabStatus) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type MonitorHostResponse struct {
+	MemoryPercent        float32  `protobuf:"fixed32,1,opt,name=MemoryPercent,proto3" json:"MemoryPercent,omitempty"`
+	MemoryReadError      string   `protobuf:"bytes,2,opt,name=MemoryReadError,proto3" json:"MemoryReadError,omitempty"`
+	CPUPercent           float32  `protobuf:"fixed32,3,opt,name=CPUPercent,proto3" json:"CPUPercent,omitempty"`
+	CPUReadError         string   `protobuf:"bytes,4,opt,name=CPUReadError,proto3" json:"CPUReadError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonitorHostResponse) Reset()         { *m = MonitorHostResponse{} }
+func (m *MonitorHostResponse) String() string { return proto.CompactTextString(m) }
+func (*MonitorHostResponse) ProtoMessage()    {}
+func (*MonitorHostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{59}
+}
+
+func (m *MonitorHostResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonitorHostResponse.Unmarshal(m, b)
+}
+func (m *MonitorHostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonitorHostResponse.Marshal(b, m, deterministic)
+}
+func (m *MonitorHostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorHostResponse.Merge(m, src)
+}
+func (m *MonitorHostResponse) XXX_Size() int {
+	return xxx_messageInfo_MonitorHostResponse.Size(m)
+}
+func (m *MonitorHostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorHostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorHostResponse proto.InternalMessageInfo
+
+func (m *MonitorHostResponse) GetMemoryPercent() float32 {
+	if m != nil {
+		return m.MemoryPercent
+	}
+	return 0
+}
+
+func (m *MonitorHostResponse) GetMemoryReadError() string {
+	if m != nil {
+		return m.MemoryReadError
+	}
+	return ""
+}
+
+func (m *MonitorHostResponse) GetCPUPercent() float32 {
+	if m != nil {
+		return m.CPUPercent
+	}
+	return 0
+}
+
+func (m *MonitorHostResponse) GetCPUReadError() string {
+	if m != nil {
+		return m.CPUReadError
+	}
+	return ""
+}
+
+type ListWorkersResponse struct {
+	Workers              []*ListWorkersResponse_Worker `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListWorkersResponse) Reset()         { *m = ListWorkersResponse{} }
+func (m *ListWorkersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWorkersResponse) ProtoMessage()    {}
+func (*ListWorkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{60}
+}
+
+func (m *ListWorkersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWorkersResponse.Unmarshal(m, b)
+}
+func (m *ListWorkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWorkersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListWorkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkersResponse.Merge(m, src)
+}
+func (m *ListWorkersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWorkersResponse.Size(m)
+}
+func (m *ListWorkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkersResponse proto.InternalMessageInfo
+
+func (m *ListWorkersResponse) GetWorkers() []*ListWorkersResponse_Worker {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+type ListWorkersResponse_Worker struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CpuPercent           float32  `protobuf:"fixed32,3,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	Cpus                 int32    `protobuf:"varint,4,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	MemoryPercent        float32  `protobuf:"fixed32,5,opt,name=memoryPercent,proto3" json:"memoryPercent,omitempty"`
+	MemoryAvailableMB    int64    `protobuf:"varint,6,opt,name=memoryAvailableMB,proto3" json:"memoryAvailableMB,omitempty"`
+	Labs                 int32    `protobuf:"varint,7,opt,name=labs,proto3" json:"labs,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWorkersResponse_Worker) Reset()         { *m = ListWorkersResponse_Worker{} }
+func (m *ListWorkersResponse_Worker) String() string { return proto.CompactTextString(m) }
+func (*ListWorkersResponse_Worker) ProtoMessage()    {}
+func (*ListWorkersResponse_Worker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{60, 0}
+}
+
+func (m *ListWorkersResponse_Worker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWorkersResponse_Worker.Unmarshal(m, b)
+}
+func (m *ListWorkersResponse_Worker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWorkersResponse_Worker.Marshal(b, m, deterministic)
+}
+func (m *ListWorkersResponse_Worker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkersResponse_Worker.Merge(m, src)
+}
+func (m *ListWorkersResponse_Worker) XXX_Size() int {
+	return xxx_messageInfo_ListWorkersResponse_Worker.Size(m)
+}
+func (m *ListWorkersResponse_Worker) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkersResponse_Worker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkersResponse_Worker proto.InternalMessageInfo
+
+func (m *ListWorkersResponse_Worker) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListWorkersResponse_Worker) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ListWorkersResponse_Worker) GetCpuPercent() float32 {
+	if m != nil {
+		return m.CpuPercent
+	}
+	return 0
+}
+
+func (m *ListWorkersResponse_Worker) GetCpus() int32 {
+	if m != nil {
+		return m.Cpus
+	}
+	return 0
+}
+
+func (m *ListWorkersResponse_Worker) GetMemoryPercent() float32 {
+	if m != nil {
+		return m.MemoryPercent
+	}
+	return 0
+}
+
+func (m *ListWorkersResponse_Worker) GetMemoryAvailableMB() int64 {
+	if m != nil {
+		return m.MemoryAvailableMB
+	}
+	return 0
+}
+
+func (m *ListWorkersResponse_Worker) GetLabs() int32 {
+	if m != nil {
+		return m.Labs
+	}
+	return 0
+}
+
+func (m *ListWorkersResponse_Worker) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{61}
+}
+
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type VersionResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
+func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionResponse) ProtoMessage()    {}
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{62}
+}
+
+func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
+}
+func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
+}
+func (m *VersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionResponse.Merge(m, src)
+}
+func (m *VersionResponse) XXX_Size() int {
+	return xxx_messageInfo_VersionResponse.Size(m)
+}
+func (m *VersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
+
+func (m *VersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type ListFrontendsResponse struct {
+	Frontends            []*ListFrontendsResponse_Frontend `protobuf:"bytes,1,rep,name=frontends,proto3" json:"frontends,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ListFrontendsResponse) Reset()         { *m = ListFrontendsResponse{} }
+func (m *ListFrontendsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFrontendsResponse) ProtoMessage()    {}
+func (*ListFrontendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{63}
+}
+
+func (m *ListFrontendsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFrontendsResponse.Unmarshal(m, b)
+}
+func (m *ListFrontendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFrontendsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListFrontendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFrontendsResponse.Merge(m, src)
+}
+func (m *ListFrontendsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListFrontendsResponse.Size(m)
+}
+func (m *ListFrontendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFrontendsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFrontendsResponse proto.InternalMessageInfo
+
+func (m *ListFrontendsResponse) GetFrontends() []*ListFrontendsResponse_Frontend {
+	if m != nil {
+		return m.Frontends
+	}
+	return nil
+}
+
+type ListFrontendsResponse_Frontend struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	MemoryMB             int64    `protobuf:"varint,3,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	Cpu                  float32  `protobuf:"fixed32,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Type                 string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListFrontendsResponse_Frontend) Reset()         { *m = ListFrontendsResponse_Frontend{} }
+func (m *ListFrontendsResponse_Frontend) String() string { return proto.CompactTextString(m) }
+func (*ListFrontendsResponse_Frontend) ProtoMessage()    {}
+func (*ListFrontendsResponse_Frontend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{63, 0}
+}
+
+func (m *ListFrontendsResponse_Frontend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFrontendsResponse_Frontend.Unmarshal(m, b)
+}
+func (m *ListFrontendsResponse_Frontend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFrontendsResponse_Frontend.Marshal(b, m, deterministic)
+}
+func (m *ListFrontendsResponse_Frontend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFrontendsResponse_Frontend.Merge(m, src)
+}
+func (m *ListFrontendsResponse_Frontend) XXX_Size() int {
+	return xxx_messageInfo_ListFrontendsResponse_Frontend.Size(m)
+}
+func (m *ListFrontendsResponse_Frontend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFrontendsResponse_Frontend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFrontendsResponse_Frontend proto.InternalMessageInfo
+
+func (m *ListFrontendsResponse_Frontend) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ListFrontendsResponse_Frontend) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ListFrontendsResponse_Frontend) GetMemoryMB() int64 {
+	if m != nil {
+		return m.MemoryMB
+	}
+	return 0
+}
+
+func (m *ListFrontendsResponse_Frontend) GetCpu() float32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *ListFrontendsResponse_Frontend) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type ResetFrontendsRequest struct {
+	EventTag             string   `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Teams                []*Team  `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetFrontendsRequest) Reset()         { *m = ResetFrontendsRequest{} }
+func (m *ResetFrontendsRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetFrontendsRequest) ProtoMessage()    {}
+func (*ResetFrontendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{64}
+}
+
+func (m *ResetFrontendsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetFrontendsRequest.Unmarshal(m, b)
+}
+func (m *ResetFrontendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetFrontendsRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetFrontendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetFrontendsRequest.Merge(m, src)
+}
+func (m *ResetFrontendsRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetFrontendsRequest.Size(m)
+}
+func (m *ResetFrontendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetFrontendsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetFrontendsRequest proto.InternalMessageInfo
+
+func (m *ResetFrontendsRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *ResetFrontendsRequest) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type ResizeFrontendsRequest struct {
+	EventTag             string   `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Image                string   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	MemoryMB             int64    `protobuf:"varint,3,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	Cpu                  float32  `protobuf:"fixed32,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Teams                []*Team  `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResizeFrontendsRequest) Reset()         { *m = ResizeFrontendsRequest{} }
+func (m *ResizeFrontendsRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizeFrontendsRequest) ProtoMessage()    {}
+func (*ResizeFrontendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{65}
+}
+
+func (m *ResizeFrontendsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeFrontendsRequest.Unmarshal(m, b)
+}
+func (m *ResizeFrontendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeFrontendsRequest.Marshal(b, m, deterministic)
+}
+func (m *ResizeFrontendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeFrontendsRequest.Merge(m, src)
+}
+func (m *ResizeFrontendsRequest) XXX_Size() int {
+	return xxx_messageInfo_ResizeFrontendsRequest.Size(m)
+}
+func (m *ResizeFrontendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeFrontendsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeFrontendsRequest proto.InternalMessageInfo
+
+func (m *ResizeFrontendsRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+func (m *ResizeFrontendsRequest) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ResizeFrontendsRequest) GetMemoryMB() int64 {
+	if m != nil {
+		return m.MemoryMB
+	}
+	return 0
+}
+
+func (m *ResizeFrontendsRequest) GetCpu() float32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *ResizeFrontendsRequest) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type SetFrontendMemoryRequest struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	MemoryMB             int64    `protobuf:"varint,2,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetFrontendMemoryRequest) Reset()         { *m = SetFrontendMemoryRequest{} }
+func (m *SetFrontendMemoryRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFrontendMemoryRequest) ProtoMessage()    {}
+func (*SetFrontendMemoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{66}
+}
+
+func (m *SetFrontendMemoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFrontendMemoryRequest.Unmarshal(m, b)
+}
+func (m *SetFrontendMemoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFrontendMemoryRequest.Marshal(b, m, deterministic)
+}
+func (m *SetFrontendMemoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFrontendMemoryRequest.Merge(m, src)
+}
+func (m *SetFrontendMemoryRequest) XXX_Size() int {
+	return xxx_messageInfo_SetFrontendMemoryRequest.Size(m)
+}
+func (m *SetFrontendMemoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFrontendMemoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFrontendMemoryRequest proto.InternalMessageInfo
+
+func (m *SetFrontendMemoryRequest) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *SetFrontendMemoryRequest) GetMemoryMB() int64 {
+	if m != nil {
+		return m.MemoryMB
+	}
+	return 0
+}
+
+type SetFrontendCpuRequest struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Cpu                  float32  `protobuf:"fixed32,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetFrontendCpuRequest) Reset()         { *m = SetFrontendCpuRequest{} }
+func (m *SetFrontendCpuRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFrontendCpuRequest) ProtoMessage()    {}
+func (*SetFrontendCpuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{67}
+}
+
+func (m *SetFrontendCpuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFrontendCpuRequest.Unmarshal(m, b)
+}
+func (m *SetFrontendCpuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFrontendCpuRequest.Marshal(b, m, deterministic)
+}
+func (m *SetFrontendCpuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFrontendCpuRequest.Merge(m, src)
+}
+func (m *SetFrontendCpuRequest) XXX_Size() int {
+	return xxx_messageInfo_SetFrontendCpuRequest.Size(m)
+}
+func (m *SetFrontendCpuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFrontendCpuRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFrontendCpuRequest proto.InternalMessageInfo
+
+func (m *SetFrontendCpuRequest) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *SetFrontendCpuRequest) GetCpu() float32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+type GetTeamInfoRequest struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	EventTag             string   `protobuf:"bytes,2,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamInfoRequest) Reset()         { *m = GetTeamInfoRequest{} }
+func (m *GetTeamInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTeamInfoRequest) ProtoMessage()    {}
+func (*GetTeamInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{68}
+}
+
+func (m *GetTeamInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamInfoRequest.Unmarshal(m, b)
+}
+func (m *GetTeamInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTeamInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamInfoRequest.Merge(m, src)
+}
+func (m *GetTeamInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTeamInfoRequest.Size(m)
+}
+func (m *GetTeamInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamInfoRequest proto.InternalMessageInfo
+
+func (m *GetTeamInfoRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *GetTeamInfoRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+type GetTeamInfoResponse struct {
+	Instances            []*GetTeamInfoResponse_Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GetTeamInfoResponse) Reset()         { *m = GetTeamInfoResponse{} }
+func (m *GetTeamInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTeamInfoResponse) ProtoMessage()    {}
+func (*GetTeamInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{69}
+}
+
+func (m *GetTeamInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamInfoResponse.Unmarshal(m, b)
+}
+func (m *GetTeamInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTeamInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamInfoResponse.Merge(m, src)
+}
+func (m *GetTeamInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTeamInfoResponse.Size(m)
+}
+func (m *GetTeamInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamInfoResponse proto.InternalMessageInfo
+
+func (m *GetTeamInfoResponse) GetInstances() []*GetTeamInfoResponse_Instance {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+type GetTeamInfoResponse_Instance struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	State                int32    `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamInfoResponse_Instance) Reset()         { *m = GetTeamInfoResponse_Instance{} }
+func (m *GetTeamInfoResponse_Instance) String() string { return proto.CompactTextString(m) }
+func (*GetTeamInfoResponse_Instance) ProtoMessage()    {}
+func (*GetTeamInfoResponse_Instance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ec90cbc4aa12fc6, []int{69, 0}
+}
+
+func (m *GetTeamInfoResponse_Instance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamInfoResponse_Instance.Unmarshal(m, b)
+}
+func (m *GetTeamInfoResponse_Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamInfoResponse_Instance.Marshal(b, m, deterministic)
+}
+func (m *GetTeamInfoResponse_Instance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamInfoResponse_Instance.Merge(m, src)
+}
+func (m *GetTeamInfoResponse_Instance) XXX_Size() int {
+	return xxx_messageInfo_GetTeamInfoResponse_Instance.Size(m)
+}
+func (m *GetTeamInfoResponse_Instance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamInfoResponse_Instance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamInfoResponse_Instance proto.InternalMessageInfo
+
+func (m *GetTeamInfoResponse_Instance) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *GetTeamInfoResponse_Instance) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GetTeamInfoResponse_Instance) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetTeamInfoResponse_Instance) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Team)(nil), "Team")
+	proto.RegisterType((*LoginUserRequest)(nil), "LoginUserRequest")
+	proto.RegisterType((*LoginUserResponse)(nil), "LoginUserResponse")
+	proto.RegisterType((*SignupUserRequest)(nil), "SignupUserRequest")
+	proto.RegisterType((*InviteUserRequest)(nil), "InviteUserRequest")
+	proto.RegisterType((*InviteUserResponse)(nil), "InviteUserResponse")
+	proto.RegisterType((*SetUserRolesRequest)(nil), "SetUserRolesRequest")
+	proto.RegisterType((*SetUserRolesResponse)(nil), "SetUserRolesResponse")
+	proto.RegisterType((*ListUsersResponse)(nil), "ListUsersResponse")
+	proto.RegisterType((*ListUsersResponse_User)(nil), "ListUsersResponse.User")
+	proto.RegisterType((*DeleteUserRequest)(nil), "DeleteUserRequest")
+	proto.RegisterType((*DeleteUserResponse)(nil), "DeleteUserResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "ChangePasswordRequest")
+	proto.RegisterType((*RevokeTokensRequest)(nil), "RevokeTokensRequest")
+	proto.RegisterType((*RevokeTokensResponse)(nil), "RevokeTokensResponse")
+	proto.RegisterType((*ListSignupKeysResponse)(nil), "ListSignupKeysResponse")
+	proto.RegisterType((*ListSignupKeysResponse_SignupKey)(nil), "ListSignupKeysResponse.SignupKey")
+	proto.RegisterType((*RevokeSignupKeyRequest)(nil), "RevokeSignupKeyRequest")
+	proto.RegisterType((*RevokeSignupKeyResponse)(nil), "RevokeSignupKeyResponse")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "QueryAuditLogResponse")
+	proto.RegisterType((*QueryAuditLogResponse_Entry)(nil), "QueryAuditLogResponse.Entry")
+	proto.RegisterType((*CreateEventRequest)(nil), "CreateEventRequest")
+	proto.RegisterType((*Scoring)(nil), "Scoring")
+	proto.RegisterType((*ListEventsRequest)(nil), "ListEventsRequest")
+	proto.RegisterType((*ListEventsResponse)(nil), "ListEventsResponse")
+	proto.RegisterType((*ListEventsResponse_Events)(nil), "ListEventsResponse.Events")
+	proto.RegisterType((*ListEventTeamsRequest)(nil), "ListEventTeamsRequest")
+	proto.RegisterType((*ListEventTeamsResponse)(nil), "ListEventTeamsResponse")
+	proto.RegisterType((*ListEventTeamsResponse_Teams)(nil), "ListEventTeamsResponse.Teams")
+	proto.RegisterType((*ListIncidentsRequest)(nil), "ListIncidentsRequest")
+	proto.RegisterType((*ListIncidentsResponse)(nil), "ListIncidentsResponse")
+	proto.RegisterType((*ListIncidentsResponse_Incident)(nil), "ListIncidentsResponse.Incident")
+	proto.RegisterType((*ListSubmissionsRequest)(nil), "ListSubmissionsRequest")
+	proto.RegisterType((*ListSubmissionsResponse)(nil), "ListSubmissionsResponse")
+	proto.RegisterType((*ListSubmissionsResponse_Submission)(nil), "ListSubmissionsResponse.Submission")
+	proto.RegisterType((*GetScoreboardRequest)(nil), "GetScoreboardRequest")
+	proto.RegisterType((*GetScoreboardResponse)(nil), "GetScoreboardResponse")
+	proto.RegisterType((*GetScoreboardResponse_TeamScore)(nil), "GetScoreboardResponse.TeamScore")
+	proto.RegisterType((*StreamSolvesRequest)(nil), "StreamSolvesRequest")
+	proto.RegisterType((*Solve)(nil), "Solve")
+	proto.RegisterType((*ExportEventRequest)(nil), "ExportEventRequest")
+	proto.RegisterType((*ExportEventResponse)(nil), "ExportEventResponse")
+	proto.RegisterType((*ExportEventResponse_Row)(nil), "ExportEventResponse.Row")
+	proto.RegisterType((*RestartTeamLabRequest)(nil), "RestartTeamLabRequest")
+	proto.RegisterType((*SnapshotTeamLabRequest)(nil), "SnapshotTeamLabRequest")
+	proto.RegisterType((*RestoreTeamLabRequest)(nil), "RestoreTeamLabRequest")
+	proto.RegisterType((*CreateTeamsRequest)(nil), "CreateTeamsRequest")
+	proto.RegisterType((*CreateTeamsRequest_Team)(nil), "CreateTeamsRequest.Team")
+	proto.RegisterType((*CreateTeamsResponse)(nil), "CreateTeamsResponse")
+	proto.RegisterType((*CreateTeamsResponse_Team)(nil), "CreateTeamsResponse.Team")
+	proto.RegisterType((*DeleteTeamRequest)(nil), "DeleteTeamRequest")
+	proto.RegisterType((*RenameTeamRequest)(nil), "RenameTeamRequest")
+	proto.RegisterType((*ResetTeamPasswordRequest)(nil), "ResetTeamPasswordRequest")
+	proto.RegisterType((*ResetTeamPasswordResponse)(nil), "ResetTeamPasswordResponse")
+	proto.RegisterType((*MoveTeamLabRequest)(nil), "MoveTeamLabRequest")
+	proto.RegisterType((*EventExercisesRequest)(nil), "EventExercisesRequest")
+	proto.RegisterType((*ResetExerciseRequest)(nil), "ResetExerciseRequest")
+	proto.RegisterType((*UpdateExercisesFileRequest)(nil), "UpdateExercisesFileRequest")
+	proto.RegisterType((*ExercisesDiff)(nil), "ExercisesDiff")
+	proto.RegisterType((*ExercisesDiff_Exercise)(nil), "ExercisesDiff.Exercise")
+	proto.RegisterType((*UpdateExercisesFileResponse)(nil), "UpdateExercisesFileResponse")
+	proto.RegisterType((*UpdateExercisesFileResponse_Event)(nil), "UpdateExercisesFileResponse.Event")
+	proto.RegisterType((*ValidateExercisesFileRequest)(nil), "ValidateExercisesFileRequest")
+	proto.RegisterType((*ValidateExercisesFileResponse)(nil), "ValidateExercisesFileResponse")
+	proto.RegisterType((*ValidateExercisesFileResponse_Issue)(nil), "ValidateExercisesFileResponse.Issue")
+	proto.RegisterType((*ListExercisesResponse)(nil), "ListExercisesResponse")
+	proto.RegisterType((*ListExercisesResponse_Exercise)(nil), "ListExercisesResponse.Exercise")
+	proto.RegisterType((*ListExercisesResponse_Exercise_ExerciseInfo)(nil), "ListExercisesResponse.Exercise.ExerciseInfo")
+	proto.RegisterType((*ResetTeamStatus)(nil), "ResetTeamStatus")
+	proto.RegisterType((*StopEventRequest)(nil), "StopEventRequest")
+	proto.RegisterType((*SuspendEventRequest)(nil), "SuspendEventRequest")
+	proto.RegisterType((*ResumeEventRequest)(nil), "ResumeEventRequest")
+	proto.RegisterType((*EventStatus)(nil), "EventStatus")
+	proto.RegisterType((*LabStatus)(nil), "LabStatus")
+	proto.RegisterType((*MonitorHostResponse)(nil), "MonitorHostResponse")
+	proto.RegisterType((*ListWorkersResponse)(nil), "ListWorkersResponse")
+	proto.RegisterType((*ListWorkersResponse_Worker)(nil), "ListWorkersResponse.Worker")
+	proto.RegisterType((*Empty)(nil), "Empty")
+	proto.RegisterType((*VersionResponse)(nil), "VersionResponse")
+	proto.RegisterType((*ListFrontendsResponse)(nil), "ListFrontendsResponse")
+	proto.RegisterType((*ListFrontendsResponse_Frontend)(nil), "ListFrontendsResponse.Frontend")
+	proto.RegisterType((*ResetFrontendsRequest)(nil), "ResetFrontendsRequest")
+	proto.RegisterType((*ResizeFrontendsRequest)(nil), "ResizeFrontendsRequest")
+	proto.RegisterType((*SetFrontendMemoryRequest)(nil), "SetFrontendMemoryRequest")
+	proto.RegisterType((*SetFrontendCpuRequest)(nil), "SetFrontendCpuRequest")
+	proto.RegisterType((*GetTeamInfoRequest)(nil), "GetTeamInfoRequest")
+	proto.RegisterType((*GetTeamInfoResponse)(nil), "GetTeamInfoResponse")
+	proto.RegisterType((*GetTeamInfoResponse_Instance)(nil), "GetTeamInfoResponse.Instance")
+}
+
+func init() { proto.RegisterFile("daemon.proto", fileDescriptor_3ec90cbc4aa12fc6) }
+
+var fileDescriptor_3ec90cbc4aa12fc6 = []byte{
+	// 3360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x5d, 0x6f, 0x1c, 0xc7,
+	0x91, 0x3b, 0xb3, 0x5c, 0x92, 0x5b, 0x4b, 0x51, 0x62, 0x2f, 0x77, 0xb9, 0x1a, 0x49, 0x3e, 0x5e,
+	0x9f, 0xce, 0x27, 0xeb, 0xe4, 0x96, 0x4c, 0x7f, 0xc8, 0xd0, 0x49, 0x67, 0x4b, 0xb4, 0x64, 0xd3,
+	0xa6, 0x0c, 0x7a, 0x28, 0xf9, 0x0e, 0x09, 0x02, 0x67, 0xb8, 0xd3, 0x5c, 0x4e, 0xb4, 0x3b, 0xb3,
+	0x9e, 0x99, 0xa5, 0xcc, 0xfc, 0x81, 0x00, 0x01, 0xfc, 0x90, 0x20, 0x79, 0x08, 0x90, 0x87, 0x24,
+	0x6f, 0x09, 0x90, 0x20, 0xc8, 0x8b, 0x93, 0x3c, 0xe4, 0x27, 0xf8, 0x2f, 0x24, 0x08, 0x10, 0x20,
+	0xef, 0xf9, 0x03, 0x41, 0x7f, 0x4e, 0xcf, 0x4e, 0xef, 0x8a, 0x8e, 0xed, 0xb7, 0xa9, 0x9a, 0xea,
+	0xea, 0xfa, 0xea, 0xea, 0xea, 0xea, 0x86, 0x95, 0x30, 0xa0, 0xa3, 0x24, 0x26, 0xe3, 0x34, 0xc9,
+	0x13, 0xdc, 0x85, 0x85, 0x47, 0x34, 0x18, 0xa1, 0x55, 0x70, 0x77, 0xc2, 0x9e, 0xb3, 0xe9, 0x5c,
+	0x69, 0xfa, 0xee, 0x4e, 0x88, 0xdf, 0x85, 0x73, 0xbb, 0xc9, 0x20, 0x8a, 0x1f, 0x67, 0x34, 0xf5,
+	0xe9, 0xc7, 0x13, 0x9a, 0xe5, 0xc8, 0x83, 0xe5, 0x49, 0x46, 0xd3, 0x38, 0x18, 0x51, 0x49, 0xa9,
+	0x61, 0xf6, 0x6f, 0x1c, 0x64, 0xd9, 0xd3, 0x24, 0x0d, 0x7b, 0xae, 0xf8, 0xa7, 0x60, 0xfc, 0x06,
+	0xac, 0x19, 0xbc, 0xb2, 0x71, 0x12, 0x67, 0x14, 0xad, 0x43, 0x23, 0x4f, 0x9e, 0xd0, 0x58, 0x72,
+	0x12, 0x00, 0xc3, 0xd2, 0x34, 0x4d, 0x52, 0xc9, 0x43, 0x00, 0xf8, 0x5b, 0xb0, 0xb6, 0x1f, 0x0d,
+	0xe2, 0xc9, 0xd8, 0x94, 0xe6, 0x1c, 0xd4, 0x9f, 0xd0, 0x13, 0x39, 0x9c, 0x7d, 0x96, 0xe4, 0x73,
+	0xe7, 0xc8, 0x57, 0x9f, 0x92, 0xef, 0x1d, 0x58, 0xdb, 0x89, 0x8f, 0xa3, 0x9c, 0x9a, 0xec, 0x2f,
+	0x01, 0x64, 0x93, 0x31, 0x4d, 0x3f, 0x62, 0x2c, 0xf8, 0x2c, 0xcb, 0x7e, 0x93, 0x63, 0x18, 0x15,
+	0x13, 0x34, 0x4d, 0x86, 0x34, 0xeb, 0xb9, 0x9b, 0x75, 0x26, 0x28, 0x07, 0xf0, 0x6d, 0x40, 0x26,
+	0x27, 0xa9, 0x6a, 0x55, 0x52, 0xbb, 0x9a, 0x6f, 0x43, 0x7b, 0x9f, 0xe6, 0x7c, 0x28, 0xe3, 0x76,
+	0x1a, 0xb3, 0xdb, 0xc5, 0xb8, 0x06, 0xeb, 0x65, 0x46, 0x85, 0xcd, 0xc5, 0xb4, 0x8e, 0x39, 0xed,
+	0xef, 0x1d, 0x58, 0xdb, 0x8d, 0x32, 0x4e, 0x5f, 0xd0, 0xbe, 0x08, 0x0d, 0x36, 0x4b, 0xd6, 0x73,
+	0x36, 0xeb, 0x57, 0x5a, 0x5b, 0x1b, 0xa4, 0x42, 0x42, 0xf8, 0x04, 0x82, 0xca, 0xcb, 0x61, 0x81,
+	0x81, 0x73, 0x85, 0xbd, 0x08, 0x85, 0x01, 0x7b, 0xee, 0x4c, 0x8b, 0xd6, 0x0d, 0x55, 0xd8, 0x98,
+	0x7e, 0x4a, 0x83, 0x9c, 0x86, 0x77, 0xf3, 0xde, 0x02, 0x67, 0x58, 0x20, 0xf0, 0x75, 0x58, 0x7b,
+	0x8b, 0x0e, 0x69, 0xd9, 0x73, 0x73, 0x44, 0xc0, 0x57, 0x01, 0x99, 0x03, 0xe6, 0xda, 0xe5, 0x29,
+	0x74, 0xb6, 0x8f, 0x82, 0x78, 0x40, 0xf7, 0x64, 0xa0, 0x9c, 0xc6, 0x21, 0x9b, 0xd0, 0x4a, 0x86,
+	0xe1, 0x5e, 0x79, 0x29, 0x98, 0x28, 0x46, 0x11, 0xd3, 0xa7, 0x7b, 0xe5, 0x60, 0x34, 0x51, 0xf8,
+	0x25, 0x68, 0xfb, 0xf4, 0x38, 0x79, 0x42, 0x1f, 0xb1, 0x35, 0x71, 0x9a, 0x38, 0x60, 0x1e, 0x2f,
+	0x0f, 0x99, 0xab, 0xd9, 0x2f, 0x1c, 0xe8, 0x32, 0x77, 0x8a, 0x45, 0xf5, 0x1e, 0x3d, 0x29, 0x06,
+	0xbc, 0x0a, 0x0b, 0x4f, 0xe8, 0x89, 0xf2, 0xfa, 0xbf, 0x13, 0x3b, 0x19, 0xd1, 0x28, 0x9f, 0x93,
+	0x7b, 0x1f, 0x40, 0x53, 0xa3, 0x2c, 0xf1, 0xfe, 0x2f, 0x78, 0x1e, 0x5f, 0x85, 0xae, 0x50, 0xa9,
+	0x98, 0x6b, 0xd6, 0xca, 0xc7, 0xd7, 0x61, 0xa3, 0x42, 0x3b, 0xd7, 0x02, 0x3f, 0x73, 0x60, 0xfd,
+	0x83, 0x09, 0x4d, 0x4f, 0xee, 0x4e, 0xc2, 0x28, 0xdf, 0x4d, 0x06, 0x8a, 0x37, 0x82, 0x05, 0xbd,
+	0xe0, 0x9b, 0x3e, 0xff, 0x46, 0x5d, 0x58, 0x1c, 0xd1, 0xfc, 0x28, 0x51, 0xee, 0x94, 0x10, 0x73,
+	0x08, 0x3d, 0xa6, 0x71, 0xfe, 0x28, 0x18, 0xa8, 0x9c, 0xa2, 0x60, 0x36, 0x6d, 0x16, 0xc5, 0x7d,
+	0x2a, 0x63, 0x56, 0x00, 0x0c, 0x3b, 0x89, 0xf3, 0x68, 0xd8, 0x6b, 0x08, 0x2c, 0x07, 0x18, 0x76,
+	0x18, 0x8d, 0xa2, 0xbc, 0xb7, 0xb8, 0xe9, 0x5c, 0x69, 0xf8, 0x02, 0xc0, 0x7f, 0x76, 0xa0, 0x33,
+	0x25, 0xa2, 0x54, 0xe9, 0x35, 0x58, 0xa2, 0x71, 0x9e, 0x46, 0x54, 0xb9, 0xe9, 0x22, 0xb1, 0x12,
+	0x92, 0xfb, 0x71, 0x9e, 0x9e, 0xf8, 0x8a, 0xd8, 0xfb, 0xd4, 0x81, 0x06, 0x47, 0x31, 0x2d, 0xf3,
+	0x48, 0x87, 0x11, 0xff, 0xd6, 0x9a, 0xbb, 0x56, 0xcd, 0xeb, 0x33, 0x35, 0x5f, 0x98, 0xd2, 0xfc,
+	0x1c, 0xd4, 0x47, 0xd9, 0x40, 0x6a, 0xc8, 0x3e, 0x19, 0x97, 0xc3, 0x88, 0x0e, 0xc3, 0x8c, 0x2b,
+	0xd8, 0xf4, 0x25, 0x84, 0x7f, 0xe7, 0x02, 0xda, 0xe6, 0x6b, 0xf9, 0x3e, 0x1b, 0x6c, 0xb8, 0xc0,
+	0x88, 0x71, 0xfe, 0xcd, 0x98, 0xe6, 0xc1, 0x40, 0xca, 0xc6, 0x3e, 0x59, 0x48, 0x1d, 0xa6, 0x49,
+	0x9c, 0xd3, 0x38, 0x54, 0x81, 0x53, 0x20, 0xd8, 0x5f, 0xfa, 0x09, 0x4d, 0xfb, 0x51, 0x46, 0xb3,
+	0xde, 0x82, 0xf8, 0xab, 0x11, 0xec, 0x6f, 0x70, 0x1c, 0x44, 0xc3, 0xe0, 0x60, 0x48, 0xb9, 0xa0,
+	0x0d, 0xbf, 0x40, 0x30, 0xe5, 0xfa, 0xc1, 0x38, 0xe8, 0x47, 0xf9, 0x89, 0xf4, 0x88, 0x86, 0xd1,
+	0x73, 0x00, 0x87, 0x51, 0x1c, 0x65, 0x47, 0x8f, 0x98, 0xf9, 0x96, 0xb8, 0x38, 0x06, 0x86, 0x07,
+	0x7a, 0x1e, 0xa4, 0x39, 0xff, 0xbd, 0xcc, 0x7f, 0x17, 0x08, 0x84, 0x61, 0x29, 0xeb, 0x27, 0x69,
+	0x14, 0x0f, 0x7a, 0xcd, 0x4d, 0xe7, 0x4a, 0x6b, 0x6b, 0x99, 0xec, 0x0b, 0xd8, 0x57, 0x3f, 0xf8,
+	0x0c, 0xc3, 0x60, 0xf0, 0x20, 0x49, 0x47, 0x41, 0xde, 0x03, 0x39, 0x83, 0xc6, 0xe0, 0x1f, 0x38,
+	0xb0, 0x24, 0x07, 0x31, 0x4b, 0x8d, 0x92, 0x50, 0x5b, 0x8a, 0x7d, 0xa3, 0x1e, 0x2c, 0x45, 0x71,
+	0x94, 0x47, 0xc1, 0x90, 0x5b, 0xab, 0xe1, 0x2b, 0x90, 0xfd, 0x19, 0x45, 0x71, 0x34, 0x9a, 0x8c,
+	0xb8, 0x37, 0x1b, 0xbe, 0x02, 0x59, 0x00, 0x86, 0xb4, 0x1f, 0x9c, 0x70, 0x5f, 0x36, 0x7c, 0x01,
+	0xa0, 0x2b, 0x70, 0xf6, 0x30, 0x4a, 0xb3, 0xfc, 0xde, 0x30, 0x49, 0xc2, 0x7b, 0x49, 0x3c, 0xc9,
+	0xa4, 0xad, 0xa6, 0xd1, 0xb8, 0x2d, 0x36, 0x10, 0xee, 0x45, 0x95, 0xae, 0xf0, 0xaf, 0x5d, 0x40,
+	0x26, 0x56, 0x06, 0xef, 0x16, 0x2c, 0xf2, 0x50, 0x51, 0xb1, 0xeb, 0x91, 0x2a, 0x11, 0x91, 0xa0,
+	0xa4, 0xf4, 0xfe, 0xea, 0xc0, 0xa2, 0x40, 0xa9, 0x40, 0x70, 0x8a, 0x40, 0x50, 0xe1, 0xe2, 0x1a,
+	0xe1, 0x72, 0x11, 0x9a, 0x39, 0x0d, 0x46, 0xdb, 0xc9, 0x24, 0xce, 0xa5, 0xb2, 0x05, 0x62, 0x3a,
+	0x38, 0x9c, 0x72, 0x70, 0x98, 0xee, 0x6f, 0x4c, 0xb9, 0x1f, 0xc3, 0x0a, 0xdf, 0x7c, 0xa2, 0x24,
+	0xe6, 0x1e, 0x16, 0xf1, 0x5c, 0xc2, 0x3d, 0x33, 0x44, 0xba, 0xb0, 0x98, 0xe5, 0x41, 0x3e, 0xc9,
+	0x64, 0x7c, 0x48, 0x08, 0xbf, 0x00, 0x1d, 0x6d, 0x09, 0x56, 0x92, 0x65, 0x46, 0xba, 0x2b, 0xab,
+	0x8c, 0x7f, 0x2b, 0xf3, 0xb7, 0x49, 0x2b, 0xcd, 0xfb, 0x32, 0x34, 0x98, 0xa2, 0xca, 0xba, 0x97,
+	0x88, 0x9d, 0x8e, 0x08, 0x48, 0xd0, 0x7a, 0x01, 0x34, 0x38, 0x3c, 0x5d, 0x05, 0x32, 0xdb, 0xbe,
+	0x6f, 0xd8, 0xf6, 0x7d, 0x59, 0x72, 0xdc, 0x1f, 0x05, 0xd1, 0x50, 0xa6, 0x04, 0x01, 0x30, 0xad,
+	0xef, 0xf6, 0xfb, 0x34, 0xcb, 0x8c, 0x8d, 0xda, 0xc0, 0xe0, 0x2d, 0x58, 0x67, 0x92, 0xec, 0xc4,
+	0xfd, 0x28, 0x34, 0xa2, 0xa4, 0x94, 0x49, 0x9c, 0x72, 0x26, 0xc1, 0x7f, 0x70, 0xa1, 0x33, 0x35,
+	0x48, 0x6a, 0x79, 0x07, 0x9a, 0x91, 0x42, 0x4a, 0x4d, 0xff, 0x8d, 0x58, 0x49, 0x89, 0xc2, 0xf8,
+	0xc5, 0x08, 0xef, 0x2f, 0x0e, 0x2c, 0x2b, 0x3c, 0xf3, 0x07, 0xb3, 0x82, 0xd6, 0x5b, 0x42, 0x4c,
+	0xa3, 0x28, 0xce, 0xf2, 0x20, 0xee, 0xd3, 0x1d, 0x95, 0xf9, 0x0d, 0x0c, 0xb3, 0x43, 0x34, 0x0a,
+	0x06, 0x54, 0xd9, 0x81, 0x03, 0x3c, 0xb3, 0x9e, 0x8c, 0x55, 0xda, 0xe7, 0xdf, 0x8c, 0x92, 0xf9,
+	0x98, 0xaa, 0xac, 0xcf, 0x01, 0x36, 0x6f, 0xd0, 0x67, 0x51, 0xa3, 0xb2, 0xa2, 0x80, 0x98, 0x45,
+	0x52, 0xca, 0x73, 0x46, 0xc6, 0xa3, 0xa7, 0xe1, 0x6b, 0xb8, 0xd8, 0xcc, 0x96, 0x8d, 0xcd, 0x4c,
+	0x67, 0xf3, 0x66, 0x91, 0xcd, 0xf1, 0xae, 0xdc, 0xe1, 0x27, 0x07, 0xa3, 0x28, 0xcb, 0xa2, 0x24,
+	0x3e, 0x8d, 0xc5, 0x0d, 0x5b, 0xb8, 0xa6, 0x2d, 0xf0, 0xa7, 0x2e, 0x6c, 0x54, 0xd8, 0x49, 0x5f,
+	0xdc, 0x87, 0x56, 0x56, 0xa0, 0xa5, 0x37, 0xfe, 0x83, 0xcc, 0x20, 0x27, 0x05, 0xce, 0x37, 0xc7,
+	0x79, 0xbf, 0x72, 0x00, 0x8a, 0x7f, 0x33, 0xbd, 0x52, 0xdd, 0x08, 0xba, 0xb0, 0x98, 0xd2, 0x6c,
+	0x32, 0xcc, 0xd5, 0x1e, 0x25, 0x20, 0x96, 0xee, 0xfa, 0x49, 0x9a, 0xd2, 0xbe, 0x08, 0xc7, 0x65,
+	0x5f, 0x81, 0xcc, 0x8a, 0xc7, 0xc1, 0x70, 0xa2, 0xfd, 0xc1, 0x01, 0xc6, 0xe7, 0x28, 0xc8, 0x8e,
+	0x68, 0xc8, 0xfd, 0xb1, 0xec, 0x4b, 0x48, 0x5b, 0x77, 0xc9, 0xb0, 0xee, 0x16, 0xac, 0xbf, 0x4d,
+	0x73, 0x96, 0x86, 0xe9, 0x41, 0x12, 0x94, 0x2a, 0xc3, 0x99, 0xd1, 0xfc, 0x0f, 0x07, 0x3a, 0x53,
+	0x83, 0xf4, 0x7e, 0x5e, 0x5a, 0xb3, 0x9b, 0xc4, 0x4a, 0xc6, 0x97, 0x2c, 0x47, 0xab, 0x65, 0xfb,
+	0x73, 0x07, 0x9a, 0x1a, 0xc9, 0xe4, 0x4c, 0x83, 0xf8, 0x09, 0x9f, 0xb7, 0xe1, 0xf3, 0xef, 0x59,
+	0xfe, 0x64, 0x72, 0xb2, 0x2f, 0xbe, 0xb6, 0x65, 0xe5, 0xa2, 0x60, 0x36, 0x66, 0x9c, 0x44, 0x6c,
+	0x61, 0x89, 0xdd, 0x40, 0x42, 0x0c, 0x9f, 0x25, 0xc3, 0x63, 0xaa, 0x76, 0x01, 0x09, 0xb1, 0x6c,
+	0x3a, 0x0c, 0xb2, 0x7c, 0x9f, 0x41, 0x32, 0x94, 0x0b, 0x04, 0xab, 0x65, 0xf7, 0xf3, 0x94, 0x09,
+	0xc9, 0xa9, 0x4f, 0x63, 0xa8, 0x9f, 0x38, 0xd0, 0xe0, 0xd4, 0x33, 0x83, 0xc0, 0x14, 0xdf, 0x9d,
+	0x12, 0x9f, 0xa5, 0xe8, 0xa3, 0x60, 0x38, 0xa4, 0xf1, 0x80, 0x16, 0x85, 0x59, 0x09, 0x37, 0x53,
+	0xc5, 0x4d, 0x68, 0xf5, 0x93, 0xd1, 0x78, 0x48, 0xc5, 0x71, 0x43, 0x84, 0x87, 0x89, 0xc2, 0xcf,
+	0x03, 0xba, 0xff, 0xc9, 0x38, 0x49, 0xf3, 0x52, 0xc5, 0x52, 0xcd, 0xd0, 0x9f, 0xd5, 0xa1, 0x5d,
+	0x22, 0x94, 0xae, 0x9e, 0xb7, 0xf8, 0xd8, 0xb6, 0xc4, 0xbe, 0x0d, 0xb5, 0x0a, 0x04, 0xba, 0x06,
+	0x0b, 0x69, 0xf2, 0x54, 0x94, 0x3a, 0xad, 0xad, 0x1e, 0xb1, 0x70, 0x27, 0x7e, 0xf2, 0xd4, 0xe7,
+	0x54, 0xde, 0x67, 0x2e, 0xd4, 0xfd, 0xe4, 0xe9, 0xd7, 0x66, 0xc1, 0xcb, 0x70, 0x46, 0xc3, 0x9c,
+	0x89, 0xc8, 0x77, 0x65, 0xa4, 0xd8, 0x4a, 0x73, 0x3a, 0x48, 0xd2, 0x13, 0x69, 0x4c, 0x0d, 0x1b,
+	0x3e, 0x58, 0x9c, 0xe7, 0x83, 0xa5, 0x8a, 0x0f, 0xd0, 0x2d, 0xe8, 0x65, 0xb4, 0x9f, 0xc4, 0x61,
+	0xb6, 0xcf, 0x8a, 0x6a, 0xb6, 0x02, 0xb6, 0xe5, 0x06, 0xcc, 0xf3, 0x62, 0xdd, 0x9f, 0xf9, 0x9f,
+	0x71, 0x3f, 0x8a, 0xe2, 0x7c, 0x8f, 0xc6, 0xc1, 0x30, 0x3f, 0xe1, 0x19, 0xb3, 0xe1, 0x9b, 0x28,
+	0xfc, 0x1e, 0x74, 0x7c, 0x91, 0x6e, 0xd9, 0xc0, 0xdd, 0xe0, 0xe0, 0xcb, 0xe4, 0xcd, 0x6f, 0x43,
+	0x77, 0x3f, 0x0e, 0xc6, 0xd9, 0x51, 0xf2, 0x15, 0x70, 0xd3, 0x95, 0x4e, 0xbd, 0xa8, 0x74, 0xf0,
+	0x47, 0x42, 0xdc, 0x24, 0xa5, 0x5f, 0xd3, 0x04, 0x3f, 0x74, 0x54, 0x91, 0x5e, 0x2a, 0x4a, 0xe6,
+	0xb1, 0x27, 0x2a, 0x9f, 0xb9, 0x32, 0x56, 0xab, 0xe3, 0x79, 0x32, 0x53, 0x79, 0xec, 0x86, 0xec,
+	0x41, 0xd9, 0x0a, 0x7f, 0xb6, 0xe3, 0xf1, 0x6a, 0x43, 0x75, 0x4a, 0x18, 0x80, 0xff, 0xe8, 0x40,
+	0xbb, 0xc4, 0x54, 0x2e, 0xaf, 0xeb, 0xe5, 0x4c, 0x7a, 0x9e, 0x58, 0x88, 0x4a, 0x53, 0xa7, 0x45,
+	0xfb, 0x2b, 0xd2, 0x85, 0x4f, 0x14, 0x5a, 0x8b, 0x4a, 0x2d, 0x4a, 0xdd, 0x10, 0xa5, 0xd4, 0x58,
+	0x5a, 0x28, 0x37, 0x96, 0x8a, 0xed, 0xba, 0x51, 0x6e, 0xf3, 0xc8, 0xa6, 0x05, 0x17, 0xe4, 0x4b,
+	0x44, 0xd7, 0x37, 0x61, 0xcd, 0xa7, 0x4c, 0xb4, 0x2f, 0xc9, 0xc8, 0xea, 0xf7, 0xef, 0x40, 0xcf,
+	0xa7, 0x19, 0xe5, 0x71, 0x6b, 0x69, 0x80, 0x7c, 0xe1, 0x39, 0xe6, 0x35, 0xe0, 0x6e, 0xc2, 0x79,
+	0xcb, 0x5c, 0x45, 0xca, 0xd4, 0x03, 0x9d, 0x4a, 0xe7, 0x0e, 0x3d, 0x4c, 0x8e, 0xbf, 0x82, 0xd0,
+	0xc7, 0x1f, 0x40, 0x87, 0xe7, 0xd2, 0xfb, 0xea, 0x1c, 0x70, 0x1a, 0x66, 0xa5, 0x83, 0x84, 0x3b,
+	0x75, 0xca, 0xc4, 0x1f, 0xb3, 0x9e, 0x4c, 0x46, 0x35, 0x4b, 0xc5, 0x71, 0x13, 0x5a, 0x8a, 0xa8,
+	0x60, 0x6a, 0xa2, 0x4a, 0x73, 0xba, 0x53, 0x73, 0x5e, 0x50, 0x21, 0x2e, 0x36, 0x82, 0x86, 0x19,
+	0xce, 0xf8, 0x15, 0xf0, 0x1e, 0x8f, 0x43, 0x76, 0xa0, 0x56, 0x52, 0x3c, 0x88, 0x86, 0x7a, 0xe2,
+	0x2e, 0x2c, 0x86, 0xe9, 0x89, 0x3f, 0x89, 0x65, 0x3b, 0x53, 0x42, 0xf8, 0x7b, 0x75, 0x38, 0xa3,
+	0x07, 0xbc, 0x15, 0x1d, 0x1e, 0xb2, 0xe6, 0x5f, 0x10, 0x86, 0x34, 0xd4, 0xcd, 0xbf, 0xd2, 0x6f,
+	0x0d, 0xf9, 0x82, 0x0a, 0xbd, 0x04, 0x4b, 0x29, 0x1d, 0x25, 0xc7, 0x34, 0xec, 0xb9, 0xf3, 0x07,
+	0x28, 0x3a, 0x36, 0xa4, 0xcf, 0x9b, 0x6b, 0x61, 0xaf, 0xfe, 0x8c, 0x21, 0x92, 0x8e, 0x57, 0xed,
+	0x0a, 0x7b, 0xca, 0x73, 0xe0, 0x73, 0x00, 0x5c, 0xc2, 0x07, 0xc3, 0x60, 0xa0, 0xba, 0x04, 0x06,
+	0x86, 0x6d, 0x75, 0x52, 0x20, 0x41, 0x21, 0x3a, 0x05, 0x25, 0x9c, 0xdc, 0x0e, 0xe3, 0x81, 0x84,
+	0x7b, 0x0d, 0x41, 0x63, 0xe2, 0x98, 0x4b, 0x39, 0xd7, 0x1d, 0x76, 0x06, 0x60, 0x3b, 0x1a, 0x23,
+	0x31, 0x51, 0x6c, 0xc3, 0x94, 0x5c, 0x25, 0xcd, 0x12, 0xa7, 0x29, 0x23, 0xf1, 0x4f, 0x5d, 0xb8,
+	0x60, 0x75, 0x60, 0xd1, 0x49, 0x1e, 0x65, 0x5a, 0x6b, 0xd6, 0x5b, 0xb9, 0x02, 0x67, 0x8f, 0x83,
+	0x61, 0x14, 0xf2, 0xed, 0xed, 0xbe, 0xd1, 0x53, 0x9e, 0x46, 0x23, 0x0c, 0x0b, 0x61, 0x74, 0x78,
+	0xc8, 0x17, 0x5f, 0x6b, 0x6b, 0xb5, 0x6c, 0x6e, 0x9f, 0xff, 0x43, 0xef, 0xc2, 0x6a, 0x70, 0x78,
+	0x48, 0xfb, 0x39, 0x0d, 0xc5, 0x79, 0x9b, 0x5b, 0xa4, 0xb5, 0x85, 0xc9, 0x1c, 0xa9, 0xc4, 0x69,
+	0xdd, 0x9f, 0x1a, 0xc9, 0xea, 0xef, 0x60, 0x3c, 0x1e, 0x46, 0x34, 0xe4, 0xe9, 0x6f, 0xd9, 0x57,
+	0xa0, 0x77, 0x13, 0x1a, 0x9c, 0xc6, 0xe2, 0xc4, 0xf9, 0x2b, 0xea, 0x75, 0xb8, 0xf8, 0xa1, 0xd0,
+	0xca, 0x1e, 0xe0, 0xbc, 0xe4, 0x8f, 0x73, 0x1a, 0xe7, 0x9c, 0xe7, 0x8a, 0xaf, 0x40, 0xfc, 0x23,
+	0x07, 0x2e, 0xcd, 0x18, 0x2a, 0x4d, 0x7b, 0x1b, 0x16, 0xa3, 0x2c, 0x9b, 0xe8, 0x9e, 0xda, 0x65,
+	0x32, 0x97, 0x9e, 0xec, 0x30, 0x62, 0x5f, 0x8e, 0xf1, 0x5e, 0x85, 0x06, 0x47, 0xf0, 0xa5, 0x2b,
+	0xe9, 0x75, 0xba, 0x30, 0x62, 0x96, 0x79, 0xcf, 0xd5, 0xde, 0xc3, 0x7f, 0xaa, 0xcb, 0x43, 0x7f,
+	0x91, 0x75, 0x8a, 0x13, 0x6e, 0x61, 0x08, 0xf3, 0x84, 0x5b, 0x21, 0x2d, 0x56, 0x4a, 0x31, 0xc2,
+	0xfb, 0x9b, 0x6b, 0xac, 0x15, 0x76, 0x82, 0x09, 0x06, 0x82, 0x0d, 0x3b, 0xc1, 0xb0, 0x88, 0xb5,
+	0xad, 0x96, 0xab, 0x70, 0x2e, 0x4c, 0xfa, 0x4f, 0x68, 0xca, 0xa3, 0xd1, 0x6c, 0x9e, 0x54, 0xf0,
+	0xe8, 0x79, 0x58, 0x3d, 0x3e, 0x48, 0x3e, 0x31, 0x28, 0x45, 0x29, 0x3d, 0x85, 0x45, 0x7b, 0xb0,
+	0xa2, 0xa4, 0x8a, 0xe2, 0xc3, 0x84, 0xaf, 0x9e, 0xd6, 0xd6, 0xb5, 0x67, 0xa8, 0xa2, 0x3f, 0x76,
+	0xe2, 0xc3, 0xc4, 0x2f, 0x71, 0xf0, 0xbe, 0xef, 0xc0, 0x8a, 0xf9, 0xfb, 0x94, 0xa9, 0xa0, 0xa8,
+	0x37, 0xeb, 0xa5, 0x7a, 0xd3, 0xac, 0x51, 0x17, 0xa6, 0x6a, 0xd4, 0x4d, 0x68, 0x85, 0x34, 0xeb,
+	0xa7, 0xd1, 0x98, 0x17, 0x97, 0xf2, 0x3c, 0x60, 0xa0, 0xf0, 0x5d, 0x38, 0xab, 0x77, 0xae, 0x7d,
+	0xde, 0xc7, 0x99, 0x59, 0x72, 0x17, 0x7d, 0x1f, 0xb7, 0xd4, 0xf7, 0xb9, 0x0c, 0xe7, 0xf6, 0xf3,
+	0x64, 0xfc, 0x8c, 0x03, 0xc5, 0x7f, 0x41, 0x7b, 0x7f, 0x92, 0x8d, 0x69, 0x1c, 0x3e, 0x83, 0xf0,
+	0x79, 0x40, 0x3e, 0xcd, 0x26, 0x23, 0xfa, 0x0c, 0xba, 0x3b, 0xd0, 0xe2, 0x14, 0x85, 0xd4, 0x34,
+	0xce, 0x59, 0xcf, 0x4b, 0x4a, 0x2d, 0xa0, 0x99, 0x52, 0xef, 0x40, 0x73, 0x37, 0x38, 0x90, 0x83,
+	0x7b, 0xb0, 0xf4, 0x90, 0x66, 0x19, 0x6b, 0x86, 0x88, 0xd1, 0x0a, 0x64, 0xc9, 0x93, 0x17, 0x3d,
+	0xea, 0xb7, 0x60, 0x52, 0xc2, 0xe1, 0x5f, 0x3a, 0xd0, 0x7e, 0x98, 0xc4, 0x51, 0x9e, 0xa4, 0xef,
+	0x24, 0x59, 0x71, 0x56, 0xba, 0x0c, 0x67, 0x1e, 0xd2, 0x51, 0x92, 0x9e, 0xec, 0xd1, 0xb4, 0xaf,
+	0xd6, 0xb4, 0xeb, 0x97, 0x91, 0x2c, 0x01, 0x0a, 0x84, 0x4f, 0x83, 0xb0, 0x94, 0x00, 0xa7, 0xd0,
+	0x6c, 0x33, 0xd8, 0xde, 0x7b, 0xac, 0x98, 0xd5, 0x39, 0x33, 0x03, 0xc3, 0x64, 0xdd, 0xde, 0x7b,
+	0x5c, 0xb0, 0x11, 0xd1, 0x50, 0xc2, 0xe1, 0xcf, 0x5c, 0x68, 0xb3, 0xd0, 0xfd, 0xbf, 0x24, 0x7d,
+	0x62, 0xde, 0x96, 0xbd, 0x0a, 0x4b, 0x4f, 0x05, 0x4a, 0x2e, 0xd6, 0x0b, 0xc4, 0x42, 0x46, 0x04,
+	0xec, 0x2b, 0x5a, 0xef, 0xef, 0x0e, 0x2c, 0x0a, 0x9c, 0xb5, 0xf8, 0x65, 0x29, 0x34, 0x0c, 0x53,
+	0x9a, 0x29, 0xeb, 0x2b, 0x90, 0xe9, 0xd2, 0x1f, 0x4f, 0xa6, 0x74, 0x29, 0x30, 0x8c, 0x5b, 0x7f,
+	0x3c, 0x51, 0xe7, 0x5b, 0xfe, 0xcd, 0xec, 0x39, 0x2a, 0xd9, 0xb3, 0x21, 0xec, 0x59, 0x42, 0xa2,
+	0x6b, 0xb0, 0x26, 0x10, 0x77, 0x55, 0x43, 0xfc, 0xe1, 0x3d, 0x7e, 0x44, 0xab, 0xfb, 0xd5, 0x1f,
+	0x6c, 0x9e, 0x61, 0x70, 0xa0, 0x1a, 0x55, 0xfc, 0xdb, 0xde, 0xa4, 0xc2, 0x4b, 0xac, 0x6d, 0x38,
+	0xce, 0x4f, 0xf0, 0x7f, 0xc3, 0xd9, 0x0f, 0x69, 0xca, 0x1b, 0x40, 0xca, 0x7a, 0x3d, 0x58, 0x3a,
+	0x16, 0x28, 0x15, 0x3f, 0x12, 0xc4, 0x9f, 0x3b, 0x22, 0x41, 0x3e, 0x50, 0x9d, 0x7d, 0x33, 0x41,
+	0x16, 0xfd, 0x7f, 0x33, 0x41, 0x56, 0x48, 0x89, 0xc2, 0x18, 0x17, 0x04, 0xde, 0x31, 0x2c, 0x2b,
+	0x74, 0xd1, 0xc9, 0x73, 0xa6, 0x3a, 0x79, 0x59, 0xf4, 0x5d, 0x11, 0xb2, 0x75, 0x9f, 0x7f, 0xb3,
+	0x64, 0x21, 0x6c, 0xf0, 0xf0, 0x1e, 0x37, 0x7a, 0xdd, 0xd7, 0x30, 0x5b, 0x62, 0xfd, 0xf1, 0x84,
+	0x5b, 0xdc, 0xf5, 0xd9, 0xa7, 0xee, 0x05, 0x36, 0x8a, 0x5e, 0x20, 0xde, 0xe3, 0xe7, 0x35, 0x6a,
+	0x4a, 0x59, 0xad, 0x33, 0xbf, 0x50, 0xcd, 0xf7, 0x63, 0x87, 0x5d, 0x94, 0x31, 0xf1, 0xe6, 0xf2,
+	0x74, 0xaa, 0x17, 0x54, 0x42, 0x69, 0xd7, 0x54, 0xfa, 0x8b, 0x29, 0xa8, 0xe5, 0x6a, 0x58, 0xe4,
+	0xda, 0x85, 0xde, 0x7e, 0xa1, 0xa7, 0x5a, 0x8c, 0x42, 0x30, 0xbb, 0xc5, 0xcd, 0xc9, 0xdd, 0xf2,
+	0xe4, 0xf8, 0x0d, 0xe8, 0x18, 0xdc, 0xb6, 0xc7, 0x93, 0xf9, 0xac, 0xa4, 0xac, 0xae, 0x96, 0x95,
+	0x1d, 0x15, 0xde, 0x16, 0x79, 0x9a, 0xef, 0x29, 0x45, 0x49, 0x3c, 0xab, 0x3f, 0x32, 0xcb, 0x1b,
+	0xf8, 0x37, 0x0e, 0xb4, 0x4b, 0xac, 0x64, 0x44, 0xfe, 0x0f, 0x34, 0x55, 0x7b, 0xb8, 0x68, 0xbf,
+	0x5b, 0x08, 0xc9, 0x8e, 0xa4, 0xf2, 0x0b, 0x7a, 0xef, 0x1b, 0xac, 0x23, 0x2d, 0x80, 0xd9, 0xf1,
+	0xc8, 0xa3, 0xc9, 0x35, 0x3a, 0xcb, 0xe2, 0xd8, 0x5a, 0xd7, 0xc7, 0x56, 0xdd, 0x69, 0x96, 0x17,
+	0x39, 0x1c, 0xd8, 0xfa, 0x7c, 0x1d, 0x16, 0xdf, 0xe2, 0x8f, 0x3e, 0xd0, 0x2b, 0xd0, 0xd4, 0x4f,
+	0x31, 0xd0, 0x1a, 0x99, 0x7e, 0xe2, 0xe1, 0x21, 0x52, 0x79, 0xa9, 0x81, 0x6b, 0xe8, 0x35, 0x80,
+	0xe2, 0xfd, 0x05, 0x42, 0xa4, 0xf2, 0x18, 0x63, 0xc6, 0xb8, 0x9b, 0x00, 0xc5, 0x73, 0x08, 0x84,
+	0x48, 0xe5, 0x95, 0x85, 0xd7, 0x26, 0xd5, 0xf7, 0x12, 0xb8, 0x86, 0xee, 0xc0, 0x8a, 0xf9, 0x80,
+	0x01, 0xad, 0x13, 0xcb, 0xc3, 0x08, 0xaf, 0x43, 0x6c, 0xaf, 0x1c, 0x70, 0x0d, 0xbd, 0x00, 0x4d,
+	0xfd, 0x5a, 0x01, 0x2d, 0x12, 0x9e, 0x77, 0x98, 0x88, 0xd3, 0x2f, 0x18, 0x84, 0x88, 0xc5, 0x83,
+	0x00, 0x84, 0x48, 0xe5, 0x39, 0x81, 0xd7, 0x26, 0xd5, 0x17, 0x03, 0xb8, 0x86, 0xfe, 0x17, 0x56,
+	0xcb, 0xaf, 0x03, 0x50, 0x97, 0x58, 0x9f, 0x0b, 0xcc, 0xb0, 0xcd, 0x1d, 0x58, 0x31, 0x6f, 0xec,
+	0xd1, 0x3a, 0xb1, 0xdc, 0xf9, 0x7b, 0x1d, 0x62, 0xbb, 0xd6, 0xc7, 0x35, 0xf4, 0x32, 0xac, 0x96,
+	0xaf, 0xe6, 0xb5, 0x9e, 0x1b, 0x33, 0xee, 0xec, 0x71, 0x0d, 0x3d, 0x80, 0xb3, 0x82, 0x9d, 0xfe,
+	0x8b, 0x36, 0x88, 0xfd, 0x92, 0xdd, 0xeb, 0x91, 0x19, 0x37, 0xea, 0xb8, 0x86, 0xde, 0x84, 0x33,
+	0xa5, 0x0b, 0x67, 0xd4, 0x21, 0xb6, 0xcb, 0x74, 0xaf, 0x6b, 0xbf, 0x97, 0xc6, 0x35, 0xb4, 0x05,
+	0x2d, 0xe3, 0xe6, 0x17, 0xb5, 0x49, 0xf5, 0x1e, 0xd8, 0x03, 0xa2, 0x2b, 0x0c, 0x5c, 0xbb, 0xe1,
+	0xa0, 0x1b, 0xd0, 0xd4, 0x85, 0x12, 0x5a, 0x23, 0xd3, 0x45, 0x93, 0xb7, 0x42, 0x8c, 0x82, 0x86,
+	0x8f, 0x78, 0x0d, 0x56, 0xcc, 0xa2, 0x89, 0x85, 0x51, 0xb5, 0x86, 0xb2, 0x8c, 0x7b, 0x05, 0x5a,
+	0x46, 0x0d, 0x85, 0xda, 0xa4, 0x5a, 0x51, 0x59, 0x46, 0xdd, 0x04, 0x28, 0xae, 0x32, 0x11, 0x22,
+	0x95, 0x2b, 0x51, 0xaf, 0x6d, 0xb9, 0xeb, 0xc4, 0x35, 0xb4, 0x0d, 0xab, 0x1a, 0x2f, 0xee, 0xe1,
+	0xba, 0xc4, 0x7a, 0x15, 0xe8, 0x6d, 0x54, 0xf0, 0x9a, 0xc9, 0x2d, 0x58, 0x2d, 0xf7, 0x2d, 0x51,
+	0x97, 0x58, 0x1b, 0x99, 0x56, 0x7d, 0xcf, 0x4e, 0xb5, 0x29, 0xd1, 0x06, 0xb1, 0x37, 0x2e, 0x3d,
+	0x19, 0x66, 0xdc, 0x87, 0xab, 0xe5, 0xd6, 0xa3, 0x9c, 0xb1, 0xd2, 0x8b, 0x34, 0xc6, 0xdc, 0x52,
+	0x7e, 0x17, 0x7a, 0xb6, 0x2d, 0xad, 0x41, 0x6f, 0xdd, 0xd6, 0xb5, 0xc3, 0x35, 0x74, 0x55, 0x2d,
+	0x55, 0xd1, 0x2c, 0x24, 0x95, 0x26, 0x9a, 0x31, 0xcf, 0x55, 0x80, 0xa2, 0x35, 0x86, 0x10, 0xa9,
+	0xf4, 0xc9, 0x0c, 0xda, 0x5d, 0x58, 0xd3, 0x35, 0xbc, 0x5e, 0xcc, 0xe7, 0xc9, 0xac, 0xee, 0x97,
+	0xe7, 0x91, 0x99, 0xcd, 0x2a, 0x5c, 0x43, 0xd7, 0xa0, 0x65, 0xb4, 0xa4, 0x50, 0x9b, 0x54, 0x1b,
+	0x54, 0xc6, 0xdc, 0x6f, 0xc2, 0x99, 0xd2, 0x65, 0x0f, 0xea, 0x10, 0xdb, 0xc5, 0x92, 0xd7, 0xb5,
+	0xdf, 0x09, 0xe1, 0x1a, 0xba, 0x01, 0x2b, 0xe6, 0x05, 0x0b, 0x8b, 0xf1, 0xea, 0x7d, 0x8b, 0xb7,
+	0x48, 0x38, 0xcc, 0xbd, 0x7d, 0x0b, 0x5a, 0xc6, 0xe5, 0x01, 0x6a, 0x93, 0xea, 0x8d, 0x86, 0xb7,
+	0x6e, 0xbb, 0x5f, 0x10, 0xf2, 0x96, 0xae, 0x59, 0x51, 0x87, 0xd8, 0xae, 0x75, 0xbd, 0xae, 0xfd,
+	0x36, 0x56, 0xe4, 0xa0, 0xa9, 0xab, 0x41, 0xb4, 0x41, 0xec, 0x57, 0x95, 0x5e, 0x6f, 0xd6, 0x2d,
+	0x22, 0xae, 0x21, 0x1f, 0xda, 0x96, 0x9e, 0x04, 0xba, 0x40, 0x66, 0x37, 0xc0, 0xbc, 0x8b, 0xf3,
+	0xda, 0x18, 0xb8, 0x86, 0xfe, 0x1f, 0x3a, 0xd6, 0x43, 0x3f, 0xba, 0x44, 0xe6, 0xf5, 0x1d, 0xbc,
+	0xe7, 0xe6, 0xf7, 0x0a, 0x70, 0x0d, 0xbd, 0x24, 0xec, 0xa6, 0x7f, 0xeb, 0x6c, 0xdd, 0xb5, 0x9f,
+	0x84, 0x71, 0x0d, 0xdd, 0x86, 0x33, 0xa5, 0xf6, 0x21, 0xea, 0x10, 0x5b, 0x3b, 0xd1, 0x3b, 0x47,
+	0xa6, 0x4e, 0xa0, 0x32, 0x19, 0xb5, 0xef, 0x86, 0xa1, 0xe6, 0xfb, 0x28, 0x11, 0xce, 0xee, 0x12,
+	0x6b, 0x97, 0xd3, 0x88, 0xc8, 0xdb, 0xd0, 0xf3, 0x79, 0x4f, 0xaa, 0x50, 0x25, 0x4d, 0x46, 0xa7,
+	0x1d, 0x2d, 0xf5, 0xd4, 0x95, 0xe8, 0x94, 0x9e, 0x95, 0xda, 0x5c, 0x6c, 0xa4, 0xe5, 0x8a, 0x58,
+	0xa4, 0x91, 0x6a, 0x89, 0x3c, 0x43, 0xd3, 0x37, 0xe1, 0xec, 0x54, 0xf9, 0xcb, 0x37, 0x35, 0x5b,
+	0x41, 0x3c, 0x83, 0xc3, 0xeb, 0xb0, 0x56, 0xa9, 0x54, 0xd1, 0x79, 0x32, 0xab, 0x7a, 0x2d, 0xa7,
+	0xc0, 0x72, 0x55, 0x8a, 0xba, 0xc4, 0x5a, 0xa6, 0x96, 0x53, 0xa0, 0x51, 0x14, 0xa2, 0x36, 0xa9,
+	0x96, 0xa5, 0xde, 0xba, 0xad, 0x6e, 0xc4, 0x35, 0x74, 0x1d, 0x5a, 0xc6, 0x49, 0x59, 0x1b, 0x77,
+	0x9d, 0x58, 0xce, 0xcf, 0x5c, 0xb5, 0x17, 0xa1, 0x65, 0x9c, 0x43, 0x8d, 0x01, 0x96, 0xd3, 0x29,
+	0xae, 0xa1, 0xff, 0x84, 0x25, 0x79, 0x36, 0xd3, 0xa4, 0xe7, 0xc8, 0xd4, 0x69, 0x0d, 0xd7, 0x0e,
+	0x16, 0xf9, 0xdb, 0xe1, 0x97, 0xff, 0x39, 0x00, 0xbb, 0xff, 0xac, 0x20, 0x4b, 0x2c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DaemonClient is the client API for Daemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DaemonClient interface {
+	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	SignupUser(ctx context.Context, in *SignupUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error)
+	SetUserRoles(ctx context.Context, in *SetUserRolesRequest, opts ...grpc.CallOption) (*SetUserRolesResponse, error)
+	ListUsers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	RevokeTokens(ctx context.Context, in *RevokeTokensRequest, opts ...grpc.CallOption) (*RevokeTokensResponse, error)
+	ListSignupKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSignupKeysResponse, error)
+	RevokeSignupKey(ctx context.Context, in *RevokeSignupKeyRequest, opts ...grpc.CallOption) (*RevokeSignupKeyResponse, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (Daemon_CreateEventClient, error)
+	StopEvent(ctx context.Context, in *StopEventRequest, opts ...grpc.CallOption) (Daemon_StopEventClient, error)
+	SuspendEvent(ctx context.Context, in *SuspendEventRequest, opts ...grpc.CallOption) (Daemon_SuspendEventClient, error)
+	ResumeEvent(ctx context.Context, in *ResumeEventRequest, opts ...grpc.CallOption) (Daemon_ResumeEventClient, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	ListEventTeams(ctx context.Context, in *ListEventTeamsRequest, opts ...grpc.CallOption) (*ListEventTeamsResponse, error)
+	RestartTeamLab(ctx context.Context, in *RestartTeamLabRequest, opts ...grpc.CallOption) (Daemon_RestartTeamLabClient, error)
+	SnapshotTeamLab(ctx context.Context, in *SnapshotTeamLabRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestoreTeamLab(ctx context.Context, in *RestoreTeamLabRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateTeams(ctx context.Context, in *CreateTeamsRequest, opts ...grpc.CallOption) (*CreateTeamsResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*Empty, error)
+	RenameTeam(ctx context.Context, in *RenameTeamRequest, opts ...grpc.CallOption) (*Empty, error)
+	ResetTeamPassword(ctx context.Context, in *ResetTeamPasswordRequest, opts ...grpc.CallOption) (*ResetTeamPasswordResponse, error)
+	MoveTeamLab(ctx context.Context, in *MoveTeamLabRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...grpc.CallOption) (*GetScoreboardResponse, error)
+	StreamSolves(ctx context.Context, in *StreamSolvesRequest, opts ...grpc.CallOption) (Daemon_StreamSolvesClient, error)
+	ExportEvent(ctx context.Context, in *ExportEventRequest, opts ...grpc.CallOption) (*ExportEventResponse, error)
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
+	UpdateExercisesFile(ctx context.Context, in *UpdateExercisesFileRequest, opts ...grpc.CallOption) (*UpdateExercisesFileResponse, error)
+	ValidateExercisesFile(ctx context.Context, in *ValidateExercisesFileRequest, opts ...grpc.CallOption) (*ValidateExercisesFileResponse, error)
+	ListExercises(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListExercisesResponse, error)
+	ResetExercise(ctx context.Context, in *ResetExerciseRequest, opts ...grpc.CallOption) (Daemon_ResetExerciseClient, error)
+	AddExercisesToEvent(ctx context.Context, in *EventExercisesRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveExercisesFromEvent(ctx context.Context, in *EventExercisesRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListFrontends(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListFrontendsResponse, error)
+	ResetFrontends(ctx context.Context, in *ResetFrontendsRequest, opts ...grpc.CallOption) (Daemon_ResetFrontendsClient, error)
+	ResizeFrontends(ctx context.Context, in *ResizeFrontendsRequest, opts ...grpc.CallOption) (Daemon_ResizeFrontendsClient, error)
+	SetFrontendMemory(ctx context.Context, in *SetFrontendMemoryRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetFrontendCpu(ctx context.Context, in *SetFrontendCpuRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*GetTeamInfoResponse, error)
+	MonitorHost(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_MonitorHostClient, error)
+	ListWorkers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListWorkersResponse, error)
+	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type daemonClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDaemonClient(cc *grpc.ClientConn) DaemonClient {
+	return &daemonClient{cc}
+}
+
+func (c *daemonClient) LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
+	out := new(LoginUserResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/LoginUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SignupUser(ctx context.Context, in *SignupUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
+	out := new(LoginUserResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/SignupUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
+	out := new(InviteUserResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/InviteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetUserRoles(ctx context.Context, in *SetUserRolesRequest, opts ...grpc.CallOption) (*SetUserRolesResponse, error) {
+	out := new(SetUserRolesResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/SetUserRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListUsers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
+	out := new(LoginUserResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RevokeTokens(ctx context.Context, in *RevokeTokensRequest, opts ...grpc.CallOption) (*RevokeTokensResponse, error) {
+	out := new(RevokeTokensResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/RevokeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListSignupKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSignupKeysResponse, error) {
+	out := new(ListSignupKeysResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListSignupKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RevokeSignupKey(ctx context.Context, in *RevokeSignupKeyRequest, opts ...grpc.CallOption) (*RevokeSignupKeyResponse, error) {
+	out := new(RevokeSignupKeyResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/RevokeSignupKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (Daemon_CreateEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[0], "/Daemon/CreateEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonCreateEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_CreateEventClient interface {
+	Recv() (*LabStatus, error)
+	grpc.ClientStream
+}
+
+type daemonCreateEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonCreateEventClient) Recv() (*LabStatus, error) {
+	m := new(LabStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) StopEvent(ctx context.Context, in *StopEventRequest, opts ...grpc.CallOption) (Daemon_StopEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[1], "/Daemon/StopEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonStopEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_StopEventClient interface {
+	Recv() (*EventStatus, error)
+	grpc.ClientStream
+}
+
+type daemonStopEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonStopEventClient) Recv() (*EventStatus, error) {
+	m := new(EventStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) SuspendEvent(ctx context.Context, in *SuspendEventRequest, opts ...grpc.CallOption) (Daemon_SuspendEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[2], "/Daemon/SuspendEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonSuspendEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_SuspendEventClient interface {
+	Recv() (*EventStatus, error)
+	grpc.ClientStream
+}
+
+type daemonSuspendEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonSuspendEventClient) Recv() (*EventStatus, error) {
+	m := new(EventStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ResumeEvent(ctx context.Context, in *ResumeEventRequest, opts ...grpc.CallOption) (Daemon_ResumeEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[3], "/Daemon/ResumeEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonResumeEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_ResumeEventClient interface {
+	Recv() (*EventStatus, error)
+	grpc.ClientStream
+}
+
+type daemonResumeEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonResumeEventClient) Recv() (*EventStatus, error) {
+	m := new(EventStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListEventTeams(ctx context.Context, in *ListEventTeamsRequest, opts ...grpc.CallOption) (*ListEventTeamsResponse, error) {
+	out := new(ListEventTeamsResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListEventTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RestartTeamLab(ctx context.Context, in *RestartTeamLabRequest, opts ...grpc.CallOption) (Daemon_RestartTeamLabClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[4], "/Daemon/RestartTeamLab", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonRestartTeamLabClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_RestartTeamLabClient interface {
+	Recv() (*EventStatus, error)
+	grpc.ClientStream
+}
+
+type daemonRestartTeamLabClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonRestartTeamLabClient) Recv() (*EventStatus, error) {
+	m := new(EventStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) SnapshotTeamLab(ctx context.Context, in *SnapshotTeamLabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/SnapshotTeamLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RestoreTeamLab(ctx context.Context, in *RestoreTeamLabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/RestoreTeamLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CreateTeams(ctx context.Context, in *CreateTeamsRequest, opts ...grpc.CallOption) (*CreateTeamsResponse, error) {
+	out := new(CreateTeamsResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/CreateTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/DeleteTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RenameTeam(ctx context.Context, in *RenameTeamRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/RenameTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ResetTeamPassword(ctx context.Context, in *ResetTeamPasswordRequest, opts ...grpc.CallOption) (*ResetTeamPasswordResponse, error) {
+	out := new(ResetTeamPasswordResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ResetTeamPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) MoveTeamLab(ctx context.Context, in *MoveTeamLabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/MoveTeamLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...grpc.CallOption) (*GetScoreboardResponse, error) {
+	out := new(GetScoreboardResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/GetScoreboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) StreamSolves(ctx context.Context, in *StreamSolvesRequest, opts ...grpc.CallOption) (Daemon_StreamSolvesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[5], "/Daemon/StreamSolves", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonStreamSolvesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_StreamSolvesClient interface {
+	Recv() (*Solve, error)
+	grpc.ClientStream
+}
+
+type daemonStreamSolvesClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonStreamSolvesClient) Recv() (*Solve, error) {
+	m := new(Solve)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ExportEvent(ctx context.Context, in *ExportEventRequest, opts ...grpc.CallOption) (*ExportEventResponse, error) {
+	out := new(ExportEventResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ExportEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListIncidents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error) {
+	out := new(ListSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) UpdateExercisesFile(ctx context.Context, in *UpdateExercisesFileRequest, opts ...grpc.CallOption) (*UpdateExercisesFileResponse, error) {
+	out := new(UpdateExercisesFileResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/UpdateExercisesFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ValidateExercisesFile(ctx context.Context, in *ValidateExercisesFileRequest, opts ...grpc.CallOption) (*ValidateExercisesFileResponse, error) {
+	out := new(ValidateExercisesFileResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ValidateExercisesFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListExercises(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListExercisesResponse, error) {
+	out := new(ListExercisesResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListExercises", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ResetExercise(ctx context.Context, in *ResetExerciseRequest, opts ...grpc.CallOption) (Daemon_ResetExerciseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[6], "/Daemon/ResetExercise", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonResetExerciseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_ResetExerciseClient interface {
+	Recv() (*ResetTeamStatus, error)
+	grpc.ClientStream
+}
+
+type daemonResetExerciseClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonResetExerciseClient) Recv() (*ResetTeamStatus, error) {
+	m := new(ResetTeamStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) AddExercisesToEvent(ctx context.Context, in *EventExercisesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/AddExercisesToEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveExercisesFromEvent(ctx context.Context, in *EventExercisesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/RemoveExercisesFromEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListFrontends(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListFrontendsResponse, error) {
+	out := new(ListFrontendsResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListFrontends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ResetFrontends(ctx context.Context, in *ResetFrontendsRequest, opts ...grpc.CallOption) (Daemon_ResetFrontendsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[7], "/Daemon/ResetFrontends", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonResetFrontendsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_ResetFrontendsClient interface {
+	Recv() (*ResetTeamStatus, error)
+	grpc.ClientStream
+}
+
+type daemonResetFrontendsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonResetFrontendsClient) Recv() (*ResetTeamStatus, error) {
+	m := new(ResetTeamStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ResizeFrontends(ctx context.Context, in *ResizeFrontendsRequest, opts ...grpc.CallOption) (Daemon_ResizeFrontendsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[8], "/Daemon/ResizeFrontends", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonResizeFrontendsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_ResizeFrontendsClient interface {
+	Recv() (*ResetTeamStatus, error)
+	grpc.ClientStream
+}
+
+type daemonResizeFrontendsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonResizeFrontendsClient) Recv() (*ResetTeamStatus, error) {
+	m := new(ResetTeamStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) SetFrontendMemory(ctx context.Context, in *SetFrontendMemoryRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/Daemon/SetFrontendMemory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *daemonClient) SetFrontendCpu(ctx context.Context, in *SetFrontendCpuRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/Daemon/SetFrontendCpu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*GetTeamInfoResponse, error) {
+	out := new(GetTeamInfoResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/GetTeamInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) MonitorHost(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_MonitorHostClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[9], "/Daemon/MonitorHost", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonMonitorHostClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_MonitorHostClient interface {
+	Recv() (*MonitorHostResponse, error)
+	grpc.ClientStream
+}
+
+type daemonMonitorHostClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonMonitorHostClient) Recv() (*MonitorHostResponse, error) {
+	m := new(MonitorHostResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ListWorkers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListWorkersResponse, error) {
+	out := new(ListWorkersResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/ListWorkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/Daemon/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DaemonServer is the server API for Daemon service.
+type DaemonServer interface {
+	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	SignupUser(context.Context, *SignupUserRequest) (*LoginUserResponse, error)
+	InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error)
+	SetUserRoles(context.Context, *SetUserRolesRequest) (*SetUserRolesResponse, error)
+	ListUsers(context.Context, *Empty) (*ListUsersResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*LoginUserResponse, error)
+	RevokeTokens(context.Context, *RevokeTokensRequest) (*RevokeTokensResponse, error)
+	ListSignupKeys(context.Context, *Empty) (*ListSignupKeysResponse, error)
+	RevokeSignupKey(context.Context, *RevokeSignupKeyRequest) (*RevokeSignupKeyResponse, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	CreateEvent(*CreateEventRequest, Daemon_CreateEventServer) error
+	StopEvent(*StopEventRequest, Daemon_StopEventServer) error
+	SuspendEvent(*SuspendEventRequest, Daemon_SuspendEventServer) error
+	ResumeEvent(*ResumeEventRequest, Daemon_ResumeEventServer) error
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	ListEventTeams(context.Context, *ListEventTeamsRequest) (*ListEventTeamsResponse, error)
+	RestartTeamLab(*RestartTeamLabRequest, Daemon_RestartTeamLabServer) error
+	SnapshotTeamLab(context.Context, *SnapshotTeamLabRequest) (*Empty, error)
+	RestoreTeamLab(context.Context, *RestoreTeamLabRequest) (*Empty, error)
+	CreateTeams(context.Context, *CreateTeamsRequest) (*CreateTeamsResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*Empty, error)
+	RenameTeam(context.Context, *RenameTeamRequest) (*Empty, error)
+	ResetTeamPassword(context.Context, *ResetTeamPasswordRequest) (*ResetTeamPasswordResponse, error)
+	MoveTeamLab(context.Context, *MoveTeamLabRequest) (*Empty, error)
+	GetScoreboard(context.Context, *GetScoreboardRequest) (*GetScoreboardResponse, error)
+	StreamSolves(*StreamSolvesRequest, Daemon_StreamSolvesServer) error
+	ExportEvent(context.Context, *ExportEventRequest) (*ExportEventResponse, error)
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error)
+	UpdateExercisesFile(context.Context, *UpdateExercisesFileRequest) (*UpdateExercisesFileResponse, error)
+	ValidateExercisesFile(context.Context, *ValidateExercisesFileRequest) (*ValidateExercisesFileResponse, error)
+	ListExercises(context.Context, *Empty) (*ListExercisesResponse, error)
+	ResetExercise(*ResetExerciseRequest, Daemon_ResetExerciseServer) error
+	AddExercisesToEvent(context.Context, *EventExercisesRequest) (*Empty, error)
+	RemoveExercisesFromEvent(context.Context, *EventExercisesRequest) (*Empty, error)
+	ListFrontends(context.Context, *Empty) (*ListFrontendsResponse, error)
+	ResetFrontends(*ResetFrontendsRequest, Daemon_ResetFrontendsServer) error
+	ResizeFrontends(*ResizeFrontendsRequest, Daemon_ResizeFrontendsServer) error
+	SetFrontendMemory(context.Context, *SetFrontendMemoryRequest) (*Empty, error)
+	SetFrontendCpu(context.Context, *SetFrontendCpuRequest) (*Empty, error)
+	GetTeamInfo(context.Context, *GetTeamInfoRequest) (*GetTeamInfoResponse, error)
+	MonitorHost(*Empty, Daemon_MonitorHostServer) error
+	ListWorkers(context.Context, *Empty) (*ListWorkersResponse, error)
+	Version(context.Context, *Empty) (*VersionResponse, error)
+}
+
+// UnimplementedDaemonServer can be embedded to have forward compatible implementations.
+type UnimplementedDaemonServer struct {
+}
+
+func (*UnimplementedDaemonServer) LoginUser(ctx context.Context, req *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (*UnimplementedDaemonServer) SignupUser(ctx context.Context, req *SignupUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignupUser not implemented")
+}
+func (*UnimplementedDaemonServer) InviteUser(ctx context.Context, req *InviteUserRequest) (*InviteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (*UnimplementedDaemonServer) SetUserRoles(ctx context.Context, req *SetUserRolesRequest) (*SetUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRoles not implemented")
+}
+func (*UnimplementedDaemonServer) ListUsers(ctx context.Context, req *Empty) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedDaemonServer) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedDaemonServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedDaemonServer) RevokeTokens(ctx context.Context, req *RevokeTokensRequest) (*RevokeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTokens not implemented")
+}
+func (*UnimplementedDaemonServer) ListSignupKeys(ctx context.Context, req *Empty) (*ListSignupKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSignupKeys not implemented")
+}
+func (*UnimplementedDaemonServer) RevokeSignupKey(ctx context.Context, req *RevokeSignupKeyRequest) (*RevokeSignupKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSignupKey not implemented")
+}
+func (*UnimplementedDaemonServer) QueryAuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (*UnimplementedDaemonServer) CreateEvent(req *CreateEventRequest, srv Daemon_CreateEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (*UnimplementedDaemonServer) StopEvent(req *StopEventRequest, srv Daemon_StopEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method StopEvent not implemented")
+}
+func (*UnimplementedDaemonServer) SuspendEvent(req *SuspendEventRequest, srv Daemon_SuspendEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method SuspendEvent not implemented")
+}
+func (*UnimplementedDaemonServer) ResumeEvent(req *ResumeEventRequest, srv Daemon_ResumeEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeEvent not implemented")
+}
+func (*UnimplementedDaemonServer) ListEvents(ctx context.Context, req *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (*UnimplementedDaemonServer) ListEventTeams(ctx context.Context, req *ListEventTeamsRequest) (*ListEventTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventTeams not implemented")
+}
+func (*UnimplementedDaemonServer) RestartTeamLab(req *RestartTeamLabRequest, srv Daemon_RestartTeamLabServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestartTeamLab not implemented")
+}
+func (*UnimplementedDaemonServer) SnapshotTeamLab(ctx context.Context, req *SnapshotTeamLabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotTeamLab not implemented")
+}
+func (*UnimplementedDaemonServer) RestoreTeamLab(ctx context.Context, req *RestoreTeamLabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTeamLab not implemented")
+}
+func (*UnimplementedDaemonServer) CreateTeams(ctx context.Context, req *CreateTeamsRequest) (*CreateTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeams not implemented")
+}
+func (*UnimplementedDaemonServer) DeleteTeam(ctx context.Context, req *DeleteTeamRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (*UnimplementedDaemonServer) RenameTeam(ctx context.Context, req *RenameTeamRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTeam not implemented")
+}
+func (*UnimplementedDaemonServer) ResetTeamPassword(ctx context.Context, req *ResetTeamPasswordRequest) (*ResetTeamPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTeamPassword not implemented")
+}
+func (*UnimplementedDaemonServer) MoveTeamLab(ctx context.Context, req *MoveTeamLabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTeamLab not implemented")
+}
+func (*UnimplementedDaemonServer) GetScoreboard(ctx context.Context, req *GetScoreboardRequest) (*GetScoreboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreboard not implemented")
+}
+func (*UnimplementedDaemonServer) StreamSolves(req *StreamSolvesRequest, srv Daemon_StreamSolvesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSolves not implemented")
+}
+func (*UnimplementedDaemonServer) ExportEvent(ctx context.Context, req *ExportEventRequest) (*ExportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEvent not implemented")
+}
+func (*UnimplementedDaemonServer) ListIncidents(ctx context.Context, req *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
+func (*UnimplementedDaemonServer) ListSubmissions(ctx context.Context, req *ListSubmissionsRequest) (*ListSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissions not implemented")
+}
+func (*UnimplementedDaemonServer) UpdateExercisesFile(ctx context.Context, req *UpdateExercisesFileRequest) (*UpdateExercisesFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExercisesFile not implemented")
+}
+func (*UnimplementedDaemonServer) ValidateExercisesFile(ctx context.Context, req *ValidateExercisesFileRequest) (*ValidateExercisesFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateExercisesFile not implemented")
+}
+func (*UnimplementedDaemonServer) ListExercises(ctx context.Context, req *Empty) (*ListExercisesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExercises not implemented")
+}
+func (*UnimplementedDaemonServer) ResetExercise(req *ResetExerciseRequest, srv Daemon_ResetExerciseServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResetExercise not implemented")
+}
+func (*UnimplementedDaemonServer) AddExercisesToEvent(ctx context.Context, req *EventExercisesRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExercisesToEvent not implemented")
+}
+func (*UnimplementedDaemonServer) RemoveExercisesFromEvent(ctx context.Context, req *EventExercisesRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExercisesFromEvent not implemented")
+}
+func (*UnimplementedDaemonServer) ListFrontends(ctx context.Context, req *Empty) (*ListFrontendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrontends not implemented")
+}
+func (*UnimplementedDaemonServer) ResetFrontends(req *ResetFrontendsRequest, srv Daemon_ResetFrontendsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResetFrontends not implemented")
+}
+func (*UnimplementedDaemonServer) ResizeFrontends(req *ResizeFrontendsRequest, srv Daemon_ResizeFrontendsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResizeFrontends not implemented")
+}
+func (*UnimplementedDaemonServer) SetFrontendMemory(ctx context.Context, req *SetFrontendMemoryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrontendMemory not implemented")
+}
+func (*UnimplementedDaemonServer) SetFrontendCpu(ctx context.Context, req *SetFrontendCpuRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrontendCpu not implemented")
+}
+func (*UnimplementedDaemonServer) GetTeamInfo(ctx context.Context, req *GetTeamInfoRequest) (*GetTeamInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamInfo not implemented")
+}
+func (*UnimplementedDaemonServer) MonitorHost(req *Empty, srv Daemon_MonitorHostServer) error {
+	return status.Errorf(codes.Unimplemented, "method MonitorHost not implemented")
+}
+func (*UnimplementedDaemonServer) ListWorkers(ctx context.Context, req *Empty) (*ListWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
+func (*UnimplementedDaemonServer) Version(ctx context.Context, req *Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+
+func RegisterDaemonServer(s *grpc.Server, srv DaemonServer) {
+	s.RegisterService(&_Daemon_serviceDesc, srv)
+}
+
+func _Daemon_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/LoginUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SignupUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignupUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SignupUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/SignupUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SignupUser(ctx, req.(*SignupUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).InviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/InviteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).InviteUser(ctx, req.(*InviteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/SetUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetUserRoles(ctx, req.(*SetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListUsers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *daemonClient) SetFrontendCpu(ctx context.Context, in *SetFrontendCpuRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/Daemon/SetFrontendCpu", in, out, opts...)
-	if err != nil {
+func _Daemon_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DaemonServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *daemonClient) GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*GetTeamInfoResponse, error) {
-	out := new(GetTeamInfoResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/GetTeamInfo", in, out, opts...)
-	if err != nil {
+func _Daemon_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DaemonServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *daemonClient) MonitorHost(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_MonitorHostClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Daemon_serviceDesc.Streams[5], "/Daemon/MonitorHost", opts...)
-	if err != nil {
+func _Daemon_RevokeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokensRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &daemonMonitorHostClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(DaemonServer).RevokeTokens(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/RevokeTokens",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RevokeTokens(ctx, req.(*RevokeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Daemon_MonitorHostClient interface {
-	Recv() (*MonitorHostResponse, error)
-	grpc.ClientStream
+func _Daemon_ListSignupKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListSignupKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ListSignupKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListSignupKeys(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type daemonMonitorHostClient struct {
-	grpc.ClientStream
+func _Daemon_RevokeSignupKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSignupKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RevokeSignupKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/RevokeSignupKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RevokeSignupKey(ctx, req.(*RevokeSignupKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *daemonMonitorHostClient) Recv() (*MonitorHostResponse, error) {
-	m := new(MonitorHostResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _Daemon_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(DaemonServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *daemonClient) Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/Daemon/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _Daemon_CreateEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(DaemonServer).CreateEvent(m, &daemonCreateEventServer{stream})
 }
 
-// DaemonServer is the server API for Daemon service.
-type DaemonServer interface {
-	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
-	SignupUser(context.Context, *SignupUserRequest) (*LoginUserResponse, error)
-	InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error)
-	CreateEvent(*CreateEventRequest, Daemon_CreateEventServer) error
-	StopEvent(*StopEventRequest, Daemon_StopEventServer) error
-	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
-	ListEventTeams(context.Context, *ListEventTeamsRequest) (*ListEventTeamsResponse, error)
-	RestartTeamLab(*RestartTeamLabRequest, Daemon_RestartTeamLabServer) error
-	UpdateExercisesFile(context.Context, *Empty) (*UpdateExercisesFileResponse, error)
-	ListExercises(context.Context, *Empty) (*ListExercisesResponse, error)
-	ResetExercise(*ResetExerciseRequest, Daemon_ResetExerciseServer) error
-	ListFrontends(context.Context, *Empty) (*ListFrontendsResponse, error)
-	ResetFrontends(*ResetFrontendsRequest, Daemon_ResetFrontendsServer) error
-	SetFrontendMemory(context.Context, *SetFrontendMemoryRequest) (*Empty, error)
-	SetFrontendCpu(context.Context, *SetFrontendCpuRequest) (*Empty, error)
-	GetTeamInfo(context.Context, *GetTeamInfoRequest) (*GetTeamInfoResponse, error)
-	MonitorHost(*Empty, Daemon_MonitorHostServer) error
-	Version(context.Context, *Empty) (*VersionResponse, error)
+type Daemon_CreateEventServer interface {
+	Send(*LabStatus) error
+	grpc.ServerStream
 }
 
-// UnimplementedDaemonServer can be embedded to have forward compatible implementations.
-type UnimplementedDaemonServer struct {
+type daemonCreateEventServer struct {
+	grpc.ServerStream
 }
 
-func (*UnimplementedDaemonServer) LoginUser(ctx context.Context, req *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+func (x *daemonCreateEventServer) Send(m *LabStatus) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedDaemonServer) SignupUser(ctx context.Context, req *SignupUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignupUser not implemented")
+
+func _Daemon_StopEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StopEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).StopEvent(m, &daemonStopEventServer{stream})
 }
-func (*UnimplementedDaemonServer) InviteUser(ctx context.Context, req *InviteUserRequest) (*InviteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+
+type Daemon_StopEventServer interface {
+	Send(*EventStatus) error
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) CreateEvent(req *CreateEventRequest, srv Daemon_CreateEventServer) error {
-	return status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+
+type daemonStopEventServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) StopEvent(req *StopEventRequest, srv Daemon_StopEventServer) error {
-	return status.Errorf(codes.Unimplemented, "method StopEvent not implemented")
+
+func (x *daemonStopEventServer) Send(m *EventStatus) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedDaemonServer) ListEvents(ctx context.Context, req *ListEventsRequest) (*ListEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+
+func _Daemon_SuspendEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SuspendEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).SuspendEvent(m, &daemonSuspendEventServer{stream})
 }
-func (*UnimplementedDaemonServer) ListEventTeams(ctx context.Context, req *ListEventTeamsRequest) (*ListEventTeamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEventTeams not implemented")
+
+type Daemon_SuspendEventServer interface {
+	Send(*EventStatus) error
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) RestartTeamLab(req *RestartTeamLabRequest, srv Daemon_RestartTeamLabServer) error {
-	return status.Errorf(codes.Unimplemented, "method RestartTeamLab not implemented")
+
+type daemonSuspendEventServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) UpdateExercisesFile(ctx context.Context, req *Empty) (*UpdateExercisesFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateExercisesFile not implemented")
+
+func (x *daemonSuspendEventServer) Send(m *EventStatus) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedDaemonServer) ListExercises(ctx context.Context, req *Empty) (*ListExercisesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListExercises not implemented")
+
+func _Daemon_ResumeEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).ResumeEvent(m, &daemonResumeEventServer{stream})
 }
-func (*UnimplementedDaemonServer) ResetExercise(req *ResetExerciseRequest, srv Daemon_ResetExerciseServer) error {
-	return status.Errorf(codes.Unimplemented, "method ResetExercise not implemented")
+
+type Daemon_ResumeEventServer interface {
+	Send(*EventStatus) error
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) ListFrontends(ctx context.Context, req *Empty) (*ListFrontendsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListFrontends not implemented")
+
+type daemonResumeEventServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) ResetFrontends(req *ResetFrontendsRequest, srv Daemon_ResetFrontendsServer) error {
-	return status.Errorf(codes.Unimplemented, "method ResetFrontends not implemented")
+
+func (x *daemonResumeEventServer) Send(m *EventStatus) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedDaemonServer) SetFrontendMemory(ctx context.Context, req *SetFrontendMemoryRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFrontendMemory not implemented")
+
+func _Daemon_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDaemonServer) SetFrontendCpu(ctx context.Context, req *SetFrontendCpuRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFrontendCpu not implemented")
+
+func _Daemon_ListEventTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListEventTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ListEventTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListEventTeams(ctx, req.(*ListEventTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDaemonServer) GetTeamInfo(ctx context.Context, req *GetTeamInfoRequest) (*GetTeamInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTeamInfo not implemented")
+
+func _Daemon_RestartTeamLab_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RestartTeamLabRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).RestartTeamLab(m, &daemonRestartTeamLabServer{stream})
 }
-func (*UnimplementedDaemonServer) MonitorHost(req *Empty, srv Daemon_MonitorHostServer) error {
-	return status.Errorf(codes.Unimplemented, "method MonitorHost not implemented")
+
+type Daemon_RestartTeamLabServer interface {
+	Send(*EventStatus) error
+	grpc.ServerStream
 }
-func (*UnimplementedDaemonServer) Version(ctx context.Context, req *Empty) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+
+type daemonRestartTeamLabServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonRestartTeamLabServer) Send(m *EventStatus) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterDaemonServer(s *grpc.Server, srv DaemonServer) {
-	s.RegisterService(&_Daemon_serviceDesc, srv)
+func _Daemon_SnapshotTeamLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotTeamLabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SnapshotTeamLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/SnapshotTeamLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SnapshotTeamLab(ctx, req.(*SnapshotTeamLabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _Daemon_RestoreTeamLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTeamLabRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).LoginUser(ctx, in)
+		return srv.(DaemonServer).RestoreTeamLab(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Daemon/LoginUser",
+		FullMethod: "/Daemon/RestoreTeamLab",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(DaemonServer).RestoreTeamLab(ctx, req.(*RestoreTeamLabRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SignupUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignupUserRequest)
+func _Daemon_CreateTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SignupUser(ctx, in)
+		return srv.(DaemonServer).CreateTeams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Daemon/SignupUser",
+		FullMethod: "/Daemon/CreateTeams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SignupUser(ctx, req.(*SignupUserRequest))
+		return srv.(DaemonServer).CreateTeams(ctx, req.(*CreateTeamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InviteUserRequest)
+func _Daemon_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).InviteUser(ctx, in)
+		return srv.(DaemonServer).DeleteTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Daemon/InviteUser",
+		FullMethod: "/Daemon/DeleteTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).InviteUser(ctx, req.(*InviteUserRequest))
+		return srv.(DaemonServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_CreateEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CreateEventRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_RenameTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).CreateEvent(m, &daemonCreateEventServer{stream})
+	if interceptor == nil {
+		return srv.(DaemonServer).RenameTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/RenameTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RenameTeam(ctx, req.(*RenameTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Daemon_CreateEventServer interface {
-	Send(*LabStatus) error
-	grpc.ServerStream
+func _Daemon_ResetTeamPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTeamPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ResetTeamPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ResetTeamPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ResetTeamPassword(ctx, req.(*ResetTeamPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type daemonCreateEventServer struct {
-	grpc.ServerStream
+func _Daemon_MoveTeamLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTeamLabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).MoveTeamLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/MoveTeamLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).MoveTeamLab(ctx, req.(*MoveTeamLabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *daemonCreateEventServer) Send(m *LabStatus) error {
-	return x.ServerStream.SendMsg(m)
+func _Daemon_GetScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetScoreboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/GetScoreboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetScoreboard(ctx, req.(*GetScoreboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_StopEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StopEventRequest)
+func _Daemon_StreamSolves_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSolvesRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(DaemonServer).StopEvent(m, &daemonStopEventServer{stream})
+	return srv.(DaemonServer).StreamSolves(m, &daemonStreamSolvesServer{stream})
 }
 
-type Daemon_StopEventServer interface {
-	Send(*EventStatus) error
+type Daemon_StreamSolvesServer interface {
+	Send(*Solve) error
 	grpc.ServerStream
 }
 
-type daemonStopEventServer struct {
+type daemonStreamSolvesServer struct {
 	grpc.ServerStream
 }
 
-func (x *daemonStopEventServer) Send(m *EventStatus) error {
+func (x *daemonStreamSolvesServer) Send(m *Solve) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Daemon_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEventsRequest)
+func _Daemon_ExportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).ListEvents(ctx, in)
+		return srv.(DaemonServer).ExportEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Daemon/ListEvents",
+		FullMethod: "/Daemon/ExportEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).ListEvents(ctx, req.(*ListEventsRequest))
+		return srv.(DaemonServer).ExportEvent(ctx, req.(*ExportEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_ListEventTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEventTeamsRequest)
+func _Daemon_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).ListEventTeams(ctx, in)
+		return srv.(DaemonServer).ListIncidents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Daemon/ListEventTeams",
+		FullMethod: "/Daemon/ListIncidents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).ListEventTeams(ctx, req.(*ListEventTeamsRequest))
+		return srv.(DaemonServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_RestartTeamLab_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RestartTeamLabRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_ListSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).RestartTeamLab(m, &daemonRestartTeamLabServer{stream})
-}
-
-type Daemon_RestartTeamLabServer interface {
-	Send(*EventStatus) error
-	grpc.ServerStream
-}
-
-type daemonRestartTeamLabServer struct {
-	grpc.ServerStream
-}
-
-func (x *daemonRestartTeamLabServer) Send(m *EventStatus) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(DaemonServer).ListSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ListSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListSubmissions(ctx, req.(*ListSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 func _Daemon_UpdateExercisesFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(UpdateExercisesFileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2435,7 +6465,25 @@ func _Daemon_UpdateExercisesFile_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: "/Daemon/UpdateExercisesFile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).UpdateExercisesFile(ctx, req.(*Empty))
+		return srv.(DaemonServer).UpdateExercisesFile(ctx, req.(*UpdateExercisesFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ValidateExercisesFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateExercisesFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ValidateExercisesFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ValidateExercisesFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ValidateExercisesFile(ctx, req.(*ValidateExercisesFileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2479,6 +6527,42 @@ func (x *daemonResetExerciseServer) Send(m *ResetTeamStatus) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_AddExercisesToEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventExercisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AddExercisesToEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/AddExercisesToEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AddExercisesToEvent(ctx, req.(*EventExercisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RemoveExercisesFromEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventExercisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RemoveExercisesFromEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/RemoveExercisesFromEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RemoveExercisesFromEvent(ctx, req.(*EventExercisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_ListFrontends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -2518,6 +6602,27 @@ func (x *daemonResetFrontendsServer) Send(m *ResetTeamStatus) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_ResizeFrontends_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResizeFrontendsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).ResizeFrontends(m, &daemonResizeFrontendsServer{stream})
+}
+
+type Daemon_ResizeFrontendsServer interface {
+	Send(*ResetTeamStatus) error
+	grpc.ServerStream
+}
+
+type daemonResizeFrontendsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonResizeFrontendsServer) Send(m *ResetTeamStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Daemon_SetFrontendMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetFrontendMemoryRequest)
 	if err := dec(in); err != nil {
@@ -2593,6 +6698,24 @@ func (x *daemonMonitorHostServer) Send(m *MonitorHostResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Daemon/ListWorkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListWorkers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -2627,6 +6750,38 @@ var _Daemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InviteUser",
 			Handler:    _Daemon_InviteUser_Handler,
 		},
+		{
+			MethodName: "SetUserRoles",
+			Handler:    _Daemon_SetUserRoles_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Daemon_ListUsers_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Daemon_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Daemon_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RevokeTokens",
+			Handler:    _Daemon_RevokeTokens_Handler,
+		},
+		{
+			MethodName: "ListSignupKeys",
+			Handler:    _Daemon_ListSignupKeys_Handler,
+		},
+		{
+			MethodName: "RevokeSignupKey",
+			Handler:    _Daemon_RevokeSignupKey_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Daemon_QueryAuditLog_Handler,
+		},
 		{
 			MethodName: "ListEvents",
 			Handler:    _Daemon_ListEvents_Handler,
@@ -2635,14 +6790,70 @@ var _Daemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListEventTeams",
 			Handler:    _Daemon_ListEventTeams_Handler,
 		},
+		{
+			MethodName: "SnapshotTeamLab",
+			Handler:    _Daemon_SnapshotTeamLab_Handler,
+		},
+		{
+			MethodName: "RestoreTeamLab",
+			Handler:    _Daemon_RestoreTeamLab_Handler,
+		},
+		{
+			MethodName: "CreateTeams",
+			Handler:    _Daemon_CreateTeams_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _Daemon_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "RenameTeam",
+			Handler:    _Daemon_RenameTeam_Handler,
+		},
+		{
+			MethodName: "ResetTeamPassword",
+			Handler:    _Daemon_ResetTeamPassword_Handler,
+		},
+		{
+			MethodName: "MoveTeamLab",
+			Handler:    _Daemon_MoveTeamLab_Handler,
+		},
+		{
+			MethodName: "GetScoreboard",
+			Handler:    _Daemon_GetScoreboard_Handler,
+		},
+		{
+			MethodName: "ExportEvent",
+			Handler:    _Daemon_ExportEvent_Handler,
+		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _Daemon_ListIncidents_Handler,
+		},
+		{
+			MethodName: "ListSubmissions",
+			Handler:    _Daemon_ListSubmissions_Handler,
+		},
 		{
 			MethodName: "UpdateExercisesFile",
 			Handler:    _Daemon_UpdateExercisesFile_Handler,
 		},
+		{
+			MethodName: "ValidateExercisesFile",
+			Handler:    _Daemon_ValidateExercisesFile_Handler,
+		},
 		{
 			MethodName: "ListExercises",
 			Handler:    _Daemon_ListExercises_Handler,
 		},
+		{
+			MethodName: "AddExercisesToEvent",
+			Handler:    _Daemon_AddExercisesToEvent_Handler,
+		},
+		{
+			MethodName: "RemoveExercisesFromEvent",
+			Handler:    _Daemon_RemoveExercisesFromEvent_Handler,
+		},
 		{
 			MethodName: "ListFrontends",
 			Handler:    _Daemon_ListFrontends_Handler,
@@ -2659,6 +6870,10 @@ var _Daemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTeamInfo",
 			Handler:    _Daemon_GetTeamInfo_Handler,
 		},
+		{
+			MethodName: "ListWorkers",
+			Handler:    _Daemon_ListWorkers_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Daemon_Version_Handler,
@@ -2675,11 +6890,26 @@ var _Daemon_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_StopEvent_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SuspendEvent",
+			Handler:       _Daemon_SuspendEvent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ResumeEvent",
+			Handler:       _Daemon_ResumeEvent_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "RestartTeamLab",
 			Handler:       _Daemon_RestartTeamLab_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamSolves",
+			Handler:       _Daemon_StreamSolves_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ResetExercise",
 			Handler:       _Daemon_ResetExercise_Handler,
@@ -2690,6 +6920,11 @@ var _Daemon_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_ResetFrontends_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ResizeFrontends",
+			Handler:       _Daemon_ResizeFrontends_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "MonitorHost",
 			Handler:       _Daemon_MonitorHost_Handler,
@@ -0,0 +1,110 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+// scheduler keeps track of timers for events which should be started or
+// finished at a later point in time, as well as the event files of events
+// which have been scheduled but not yet started. The zero value is ready
+// to use.
+type scheduler struct {
+	m       sync.Mutex
+	timers  map[store.Tag][]*time.Timer
+	pending map[store.Tag]store.EventFile
+}
+
+func (s *scheduler) at(tag store.Tag, t time.Time, f func()) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.timers == nil {
+		s.timers = map[store.Tag][]*time.Timer{}
+	}
+
+	s.timers[tag] = append(s.timers[tag], time.AfterFunc(time.Until(t), f))
+}
+
+func (s *scheduler) cancel(tag store.Tag) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for _, t := range s.timers[tag] {
+		t.Stop()
+	}
+
+	delete(s.timers, tag)
+	delete(s.pending, tag)
+}
+
+func (s *scheduler) addPending(ef store.EventFile) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.pending == nil {
+		s.pending = map[store.Tag]store.EventFile{}
+	}
+
+	s.pending[ef.Read().Tag] = ef
+}
+
+func (s *scheduler) takePending(tag store.Tag) (store.EventFile, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	ef, ok := s.pending[tag]
+	if ok {
+		delete(s.pending, tag)
+	}
+
+	return ef, ok
+}
+
+func (s *scheduler) isPending(tag store.Tag) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	_, ok := s.pending[tag]
+	return ok
+}
+
+func (s *scheduler) getPendingByTag(tag store.Tag) (store.EventFile, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	ef, ok := s.pending[tag]
+	return ef, ok
+}
+
+func (s *scheduler) getPending() []store.EventFile {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var events []store.EventFile
+	for _, ef := range s.pending {
+		events = append(events, ef)
+	}
+
+	return events
+}
+
+func (s *scheduler) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for _, timers := range s.timers {
+		for _, t := range timers {
+			t.Stop()
+		}
+	}
+	s.timers = nil
+
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/event"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ambiguous characters are left out, as passwords are handed out on paper
+	passwordChars  = "abcdefghjkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	passwordLength = 12
+)
+
+func generatePassword() (string, error) {
+	max := big.NewInt(int64(len(passwordChars)))
+	pass := make([]byte, passwordLength)
+	for i := range pass {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		pass[i] = passwordChars[n.Int64()]
+	}
+
+	return string(pass), nil
+}
+
+func (d *daemon) getEvent(eventTag string) (event.Event, error) {
+	evtag, err := store.NewTag(eventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.eventPool.GetEvent(evtag)
+}
+
+func (d *daemon) CreateTeams(ctx context.Context, req *pb.CreateTeamsRequest) (*pb.CreateTeamsResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Int("amount", len(req.Teams)).
+		Msg("create teams")
+
+	if len(req.Teams) == 0 {
+		return nil, InvalidArgumentsErr
+	}
+
+	ev, err := d.getEvent(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*pb.CreateTeamsResponse_Team
+	for _, rt := range req.Teams {
+		pass, err := generatePassword()
+		if err != nil {
+			return nil, err
+		}
+
+		t := store.NewTeam(rt.Email, rt.Name, pass)
+		resp := &pb.CreateTeamsResponse_Team{
+			Name:  t.Name,
+			Email: t.Email,
+		}
+
+		if rt.Name == "" || rt.Email == "" {
+			resp.Error = InvalidArgumentsErr.Error()
+			teams = append(teams, resp)
+			continue
+		}
+
+		if err := ev.CreateTeam(ctx, &t); err != nil {
+			resp.Error = err.Error()
+			teams = append(teams, resp)
+			continue
+		}
+
+		resp.Id = t.Id
+		resp.Password = pass
+		teams = append(teams, resp)
+	}
+
+	return &pb.CreateTeamsResponse{Teams: teams}, nil
+}
+
+func (d *daemon) DeleteTeam(ctx context.Context, req *pb.DeleteTeamRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Str("team", req.TeamId).
+		Msg("delete team")
+
+	ev, err := d.getEvent(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ev.DeleteTeam(req.TeamId); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (d *daemon) RenameTeam(ctx context.Context, req *pb.RenameTeamRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Str("team", req.TeamId).
+		Str("name", req.Name).
+		Msg("rename team")
+
+	if req.Name == "" {
+		return nil, InvalidArgumentsErr
+	}
+
+	ev, err := d.getEvent(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ev.RenameTeam(req.TeamId, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (d *daemon) ResetTeamPassword(ctx context.Context, req *pb.ResetTeamPasswordRequest) (*pb.ResetTeamPasswordResponse, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Str("team", req.TeamId).
+		Msg("reset team password")
+
+	ev, err := d.getEvent(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	pass := req.Password
+	if pass == "" {
+		pass, err = generatePassword()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ev.ResetTeamPassword(req.TeamId, pass); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetTeamPasswordResponse{Password: pass}, nil
+}
+
+func (d *daemon) MoveTeamLab(ctx context.Context, req *pb.MoveTeamLabRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).
+		Info().
+		Str("event", req.EventTag).
+		Str("team", req.TeamId).
+		Msg("move team lab")
+
+	ev, err := d.getEvent(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ev.MoveTeamLab(ctx, req.TeamId); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
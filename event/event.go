@@ -15,11 +15,13 @@ import (
 	"sync"
 
 	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/logging"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/svcs/ctfd"
 	"github.com/aau-network-security/haaukins/svcs/guacamole"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 )
 
@@ -41,27 +43,34 @@ type Host interface {
 	UpdateEventHostExercisesFile(store.ExerciseStore) error
 }
 
-func NewHost(vlib vbox.Library, elib store.ExerciseStore, efh store.EventFileHub) Host {
+// NewHost creates events with labs on this host, or on the hosts chosen by
+// the scheduler if given.
+func NewHost(vlib vbox.Library, elib store.ExerciseStore, efh store.EventFileHub, sched lab.Scheduler) Host {
 	return &eventHost{
-		ctx:  context.Background(),
-		efh:  efh,
-		vlib: vlib,
-		elib: elib,
+		ctx:   context.Background(),
+		efh:   efh,
+		vlib:  vlib,
+		elib:  elib,
+		sched: sched,
 	}
 }
 
 type eventHost struct {
-	ctx  context.Context
-	efh  store.EventFileHub
-	vlib vbox.Library
-	elib store.ExerciseStore
+	m     sync.RWMutex
+	ctx   context.Context
+	efh   store.EventFileHub
+	vlib  vbox.Library
+	elib  store.ExerciseStore
+	sched lab.Scheduler
 }
 
 func (eh *eventHost) UpdateEventHostExercisesFile(es store.ExerciseStore) error {
 	if len(es.ListExercises()) == 0 {
 		return errors.New("Provided exercisestore is empty, be careful next time ! ")
 	}
+	eh.m.Lock()
 	eh.elib = es
+	eh.m.Unlock()
 	return nil
 }
 
@@ -71,10 +80,15 @@ func (eh *eventHost) CreateEventFromEventFile(ctx context.Context, ef store.Even
 		return nil, err
 	}
 
-	exer, err := eh.elib.GetExercisesByTags(conf.Lab.Exercises...)
+	eh.m.RLock()
+	elib := eh.elib
+	eh.m.RUnlock()
+
+	exer, err := elib.GetExercisesByTags(conf.Lab.Exercises...)
 	if err != nil {
 		return nil, err
 	}
+	exer = store.WithFlagFormat(exer, conf.FlagFormat)
 
 	labConf := lab.Config{
 		Exercises: exer,
@@ -82,8 +96,9 @@ func (eh *eventHost) CreateEventFromEventFile(ctx context.Context, ef store.Even
 	}
 
 	lh := lab.LabHost{
-		Vlib: eh.vlib,
-		Conf: labConf,
+		Vlib:      eh.vlib,
+		Conf:      labConf,
+		Scheduler: eh.sched,
 	}
 	hub, err := lab.NewHub(ctx, &lh, conf.Available, conf.Capacity)
 	if err != nil {
@@ -111,13 +126,26 @@ type Event interface {
 	Start(context.Context) error
 	Close() error
 	Finish()
+	Suspend(context.Context) error
+	Resume(context.Context) error
 	AssignLab(*store.Team, lab.Lab) error
+	AddExercises(context.Context, ...store.Exercise) error
+	RemoveExercises(context.Context, ...store.Exercise) error
+	CreateTeam(context.Context, *store.Team) error
+	DeleteTeam(id string) error
+	RenameTeam(id, name string) error
+	ResetTeamPassword(id, password string) error
+	MoveTeamLab(ctx context.Context, id string) error
 	Handler() http.Handler
 
 	GetConfig() store.EventConfig
 	GetTeams() []store.Team
 	GetHub() lab.Hub
 	GetLabByTeam(teamId string) (lab.Lab, bool)
+	GetScoreboard() []TeamScore
+	GetIncidents() ([]Incident, error)
+	GetSubmissions() ([]Submission, error)
+	SubscribeSolves() (<-chan Solve, func())
 }
 
 type event struct {
@@ -125,6 +153,7 @@ type event struct {
 	guac   guacamole.Guacamole
 	labhub lab.Hub
 
+	labsLock      sync.RWMutex
 	labs          map[string]lab.Lab
 	store         store.EventFile
 	keyLoggerPool guacamole.KeyLoggerPool
@@ -132,15 +161,80 @@ type event struct {
 	guacUserStore *guacamole.GuacUserStore
 	dockerHost    docker.Host
 
+	flagsLock sync.RWMutex
+	flags     []store.FlagConfig
+
+	solves      *solveFeed
+	submissions *submissionLog
+	watchdog    *watchdog
+	resets      *resetLimiter
+
 	closers []io.Closer
 }
 
 func NewEvent(ctx context.Context, ef store.EventFile, hub lab.Hub, flags []store.FlagConfig) (Event, error) {
 	conf := ef.Read()
+	solves := newSolveFeed()
+
+	var ev *event
+	onSolve := func(t store.Team, c store.Challenge) {
+		teams := ef.GetTeams()
+		points := ChallengeValues(teams, ev.getFlags(), conf.Scoring)[c.FlagTag]
+		if store.FirstBloods(teams)[c.FlagTag] == t.Id {
+			points += conf.Scoring.FirstBloodBonus
+		}
+
+		solves.publish(Solve{
+			TeamId:      t.Id,
+			TeamName:    t.Name,
+			Tag:         c.FlagTag,
+			Points:      points,
+			CompletedAt: *c.CompletedAt,
+		})
+	}
+
+	logPool, err := logging.NewPool(ef.ArchiveDir())
+	if err != nil {
+		return nil, err
+	}
+
+	subLogger, err := logPool.GetLogger(submissionsLog)
+	if err != nil {
+		return nil, err
+	}
+
+	incidentLogger, err := logPool.GetLogger(incidentsLog)
+	if err != nil {
+		return nil, err
+	}
+
+	// the flags are kept with the event, so it can be exported with the
+	// flags it was run with
+	if err := ef.SetFlags(flags); err != nil {
+		return nil, err
+	}
+
+	// events from before submissions were hashed with a key are given one
+	subKey := conf.SubmissionKey
+	if subKey == "" {
+		subKey = uuid.New().String()
+		if err := ef.SetSubmissionKey(subKey); err != nil {
+			return nil, err
+		}
+	}
+
+	submissions := newSubmissionLog(SubmissionsPath(ef.ArchiveDir()), subKey, subLogger, conf.Submissions, func(i Incident) {
+		ev.watchdog.report(i)
+	})
+
 	ctfdConf := ctfd.Config{
-		Name:  conf.Name,
-		Flags: flags,
-		Teams: ef.GetTeams(),
+		Name:         conf.Name,
+		Flags:        flags,
+		Teams:        ef.GetTeams(),
+		Scoring:      conf.Scoring,
+		SolveHooks:   []func(store.Team, store.Challenge){onSolve},
+		Submissions:  conf.Submissions,
+		AttemptHooks: []func(store.Team, ctfd.Attempt){submissions.record},
 	}
 
 	ctf, err := ctfd.New(ctx, ctfdConf)
@@ -160,18 +254,27 @@ func NewEvent(ctx context.Context, ef store.EventFile, hub lab.Hub, flags []stor
 		return nil, err
 	}
 
-	ev := &event{
+	ev = &event{
 		store:         ef,
 		labhub:        hub,
 		ctfd:          ctf,
 		guac:          guac,
 		labs:          map[string]lab.Lab{},
 		guacUserStore: guacamole.NewGuacUserStore(),
-		closers:       []io.Closer{ctf, guac, hub, keyLoggerPool},
+		closers:       []io.Closer{ctf, guac, hub, keyLoggerPool, logPool, solves},
 		dockerHost:    dockerHost,
 		keyLoggerPool: keyLoggerPool,
+		flags:         flags,
+		solves:        solves,
+		submissions:   submissions,
+		resets:        newResetLimiter(teamResetInterval),
 	}
 
+	ev.watchdog = newWatchdog(ev.assignedLabs, func() bool {
+		return ev.store.Read().Suspended
+	}, IncidentsPath(ef.ArchiveDir()), incidentLogger)
+	ev.closers = append(ev.closers, ev.watchdog)
+
 	return ev, nil
 }
 
@@ -208,6 +311,10 @@ func (ev *event) Start(ctx context.Context) error {
 		ev.store.SaveTeam(team)
 	}
 
+	if ev.watchdog != nil {
+		go ev.watchdog.run(context.Background())
+	}
+
 	return nil
 }
 
@@ -237,6 +344,98 @@ func (ev *event) Finish() {
 	}
 }
 
+// Suspend stops CTFd, Guacamole and every lab of the event in order to
+// free host resources, the event serves a paused page until resumed.
+func (ev *event) Suspend(ctx context.Context) error {
+	// the event is marked first, so the watchdog leaves the labs being
+	// stopped alone and teams are shown the paused page meanwhile
+	if err := ev.store.SetSuspended(true); err != nil {
+		return err
+	}
+
+	// a failed suspension starts what was stopped again, as the event
+	// would otherwise be running with some of its services stopped
+	var stopped []func(context.Context) error
+	rollback := func(err error) error {
+		for i := len(stopped) - 1; i >= 0; i-- {
+			if serr := stopped[i](ctx); serr != nil {
+				log.Warn().Err(serr).Msg("Unable to start service of event after failed suspension")
+			}
+		}
+
+		if serr := ev.store.SetSuspended(false); serr != nil {
+			log.Warn().Err(serr).Msg("Unable to unmark event as suspended")
+		}
+
+		return err
+	}
+
+	if err := ev.guac.Stop(); err != nil {
+		return rollback(err)
+	}
+	stopped = append(stopped, ev.guac.Start)
+
+	if err := ev.ctfd.Stop(); err != nil {
+		return rollback(err)
+	}
+	stopped = append(stopped, ev.ctfd.Start)
+
+	// labs may have been stopped before the error
+	if err := ev.labhub.Suspend(); err != nil {
+		stopped = append(stopped, ev.labhub.Resume)
+		return rollback(err)
+	}
+
+	return nil
+}
+
+func (ev *event) Resume(ctx context.Context) error {
+	// a failed resumption stops what was started again, as the labs would
+	// otherwise be running while the event is still marked as suspended
+	var started []func() error
+	rollback := func(err error) error {
+		for i := len(started) - 1; i >= 0; i-- {
+			if serr := started[i](); serr != nil {
+				log.Warn().Err(serr).Msg("Unable to stop service of event after failed resumption")
+			}
+		}
+
+		return err
+	}
+
+	if err := ev.ctfd.Start(ctx); err != nil {
+		log.
+			Error().
+			Err(err).
+			Msg("error starting ctfd")
+
+		return rollback(StartingCtfdErr)
+	}
+	started = append(started, ev.ctfd.Stop)
+
+	if err := ev.guac.Start(ctx); err != nil {
+		log.
+			Error().
+			Err(err).
+			Msg("error starting guac")
+
+		return rollback(StartingGuacErr)
+	}
+	started = append(started, ev.guac.Stop)
+
+	// labs may have been started before the error
+	started = append(started, ev.labhub.Suspend)
+	if err := ev.labhub.Resume(ctx); err != nil {
+		return rollback(err)
+	}
+
+	if err := ev.store.SetSuspended(false); err != nil {
+		return rollback(err)
+	}
+
+	return nil
+}
+
 func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 	rdpPorts := lab.RdpConnPorts()
 	if n := len(rdpPorts); n == 0 {
@@ -247,9 +446,13 @@ func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 
 		return RdpConfErr
 	}
+	if t.GuacPassword == "" {
+		t.GuacPassword = uuid.New().String()
+	}
+
 	u := guacamole.GuacUser{
 		Username: t.Id,
-		Password: t.HashedPassword,
+		Password: t.GuacPassword,
 	}
 
 	if err := ev.guac.CreateUser(u.Username, u.Password); err != nil {
@@ -262,9 +465,14 @@ func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 
 	ev.guacUserStore.CreateUserForTeam(t.Id, u)
 
-	hostIp, err := ev.dockerHost.GetDockerHostIP()
-	if err != nil {
-		return err
+	// labs on remote workers serve RDP on the worker
+	hostIp := lab.RdpHost()
+	if hostIp == "" {
+		var err error
+		hostIp, err = ev.dockerHost.GetDockerHostIP()
+		if err != nil {
+			return err
+		}
 	}
 
 	for i, port := range rdpPorts {
@@ -284,7 +492,10 @@ func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 		}
 	}
 
+	ev.labsLock.Lock()
 	ev.labs[t.Id] = lab
+	ev.labsLock.Unlock()
+
 	chals := lab.Environment().Challenges()
 	for _, chal := range chals {
 		t.AddChallenge(chal)
@@ -320,9 +531,11 @@ func (ev *event) Handler() http.Handler {
 	m.Handle("/guaclogin", guacHandler)
 	m.Handle("/guacamole", guacHandler)
 	m.Handle("/guacamole/", guacHandler)
+	m.Handle(ctfd.LabResetExercisePath, ev.resetHandler(ev.resetExercise))
+	m.Handle(ctfd.LabResetFrontendsPath, ev.resetHandler(resetFrontends))
 	m.Handle("/", ev.ctfd.ProxyHandler(reghook)(ev.store))
 
-	return m
+	return suspendedHandler(ev.store, m)
 }
 
 func (ev *event) GetHub() lab.Hub {
@@ -338,6 +551,45 @@ func (ev *event) GetTeams() []store.Team {
 }
 
 func (ev *event) GetLabByTeam(teamId string) (lab.Lab, bool) {
+	ev.labsLock.RLock()
+	defer ev.labsLock.RUnlock()
+
 	lab, ok := ev.labs[teamId]
 	return lab, ok
 }
+
+func (ev *event) assignedLabs() map[string]lab.Lab {
+	ev.labsLock.RLock()
+	defer ev.labsLock.RUnlock()
+
+	labs := make(map[string]lab.Lab, len(ev.labs))
+	for teamId, l := range ev.labs {
+		labs[teamId] = l
+	}
+
+	return labs
+}
+
+func (ev *event) GetIncidents() ([]Incident, error) {
+	if ev.watchdog == nil {
+		return nil, nil
+	}
+
+	return ev.watchdog.Incidents()
+}
+
+func (ev *event) GetSubmissions() ([]Submission, error) {
+	if ev.submissions == nil {
+		return nil, nil
+	}
+
+	return ev.submissions.submissions()
+}
+
+func (ev *event) GetScoreboard() []TeamScore {
+	return Scoreboard(ev.store.GetTeams(), ev.getFlags(), ev.store.Read().Scoring)
+}
+
+func (ev *event) SubscribeSolves() (<-chan Solve, func()) {
+	return ev.solves.subscribe()
+}
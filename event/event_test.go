@@ -7,8 +7,12 @@ package event
 
 import (
 	"context"
+	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aau-network-security/haaukins/exercise"
 	"github.com/aau-network-security/haaukins/lab"
@@ -60,6 +64,11 @@ func (guac *testGuac) Close() error {
 	return nil
 }
 
+func (guac *testGuac) Stop() error {
+	guac.status = STOPPED
+	return nil
+}
+
 func (guac *testGuac) CreateUser(username string, password string) error {
 	return nil
 }
@@ -86,6 +95,10 @@ func (lab *testLab) RdpConnPorts() []uint {
 	return lab.rdpPorts
 }
 
+func (lab *testLab) RdpHost() string {
+	return ""
+}
+
 func (lab *testLab) Environment() exercise.Environment {
 	return &testEnvironment{}
 }
@@ -106,6 +119,16 @@ func (hub *testLabHub) Close() error {
 	return nil
 }
 
+func (hub *testLabHub) Suspend() error {
+	hub.status = STOPPED
+	return nil
+}
+
+func (hub *testLabHub) Resume(context.Context) error {
+	hub.status = STARTED
+	return hub.err
+}
+
 func (hub *testLabHub) GetLabByTag(string) (lab.Lab, error) {
 	return nil, nil
 }
@@ -171,3 +194,285 @@ func TestEvent_StartAndClose(t *testing.T) {
 		})
 	}
 }
+
+type testConfigEventFile struct {
+	conf store.EventConfigStore
+	store.EventFile
+}
+
+func (ef *testConfigEventFile) Read() store.EventConfig {
+	return ef.conf.Read()
+}
+
+func (ef *testConfigEventFile) SetSuspended(s bool) error {
+	return ef.conf.SetSuspended(s)
+}
+
+func TestEvent_SuspendAndResume(t *testing.T) {
+	ctfd := testCtfd{}
+	guac := testGuac{}
+	hub := testLabHub{}
+	ef := testConfigEventFile{conf: store.NewEventConfigStore(store.EventConfig{})}
+
+	ev := event{
+		ctfd:   &ctfd,
+		guac:   &guac,
+		labhub: &hub,
+		store:  &ef,
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := suspendedHandler(&ef, next)
+
+	serve := func() int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		return w.Code
+	}
+
+	if code := serve(); code != http.StatusOK {
+		t.Fatalf("expected status %d before suspending, got: %d", http.StatusOK, code)
+	}
+
+	if err := ev.Suspend(context.Background()); err != nil {
+		t.Fatalf("unexpected error when suspending: %s", err)
+	}
+
+	if ctfd.status != STOPPED || guac.status != STOPPED || hub.status != STOPPED {
+		t.Fatalf("expected everything to be stopped (ctfd: %d, guac: %d, hub: %d)", ctfd.status, guac.status, hub.status)
+	}
+
+	if !ef.Read().Suspended {
+		t.Fatalf("expected event to be suspended")
+	}
+
+	if code := serve(); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %d while suspended, got: %d", http.StatusServiceUnavailable, code)
+	}
+
+	if err := ev.Resume(context.Background()); err != nil {
+		t.Fatalf("unexpected error when resuming: %s", err)
+	}
+
+	if ctfd.status != STARTED || guac.status != STARTED || hub.status != STARTED {
+		t.Fatalf("expected everything to be started (ctfd: %d, guac: %d, hub: %d)", ctfd.status, guac.status, hub.status)
+	}
+
+	if ef.Read().Suspended {
+		t.Fatalf("expected event not to be suspended")
+	}
+
+	if code := serve(); code != http.StatusOK {
+		t.Fatalf("expected status %d after resuming, got: %d", http.StatusOK, code)
+	}
+}
+
+type stopErrCtfd struct {
+	testCtfd
+}
+
+func (ctf *stopErrCtfd) Stop() error {
+	return errors.New("unable to stop")
+}
+
+func TestEvent_SuspendFailed(t *testing.T) {
+	ctfd := stopErrCtfd{testCtfd{status: STARTED}}
+	guac := testGuac{status: STARTED}
+	hub := testLabHub{status: STARTED}
+	ef := testConfigEventFile{conf: store.NewEventConfigStore(store.EventConfig{})}
+
+	ev := event{
+		ctfd:   &ctfd,
+		guac:   &guac,
+		labhub: &hub,
+		store:  &ef,
+	}
+
+	if err := ev.Suspend(context.Background()); err == nil {
+		t.Fatalf("expected error when CTFd cannot be stopped")
+	}
+
+	if guac.status != STARTED || hub.status != STARTED {
+		t.Fatalf("expected stopped services to be started again (guac: %d, hub: %d)", guac.status, hub.status)
+	}
+
+	if ef.Read().Suspended {
+		t.Fatalf("expected event not to be marked as suspended")
+	}
+}
+
+type startErrGuac struct {
+	testGuac
+}
+
+func (guac *startErrGuac) Start(ctx context.Context) error {
+	return errors.New("unable to start")
+}
+
+func TestEvent_ResumeFailed(t *testing.T) {
+	tt := []struct {
+		name   string
+		guac   guacamole.Guacamole
+		hubErr error
+	}{
+		{name: "Guacamole", guac: &startErrGuac{testGuac{status: STOPPED}}},
+		{name: "Labs", guac: &testGuac{status: STOPPED}, hubErr: errors.New("unable to resume")},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctfd := testCtfd{status: STOPPED}
+			hub := testLabHub{status: STOPPED, err: tc.hubErr}
+			ef := testConfigEventFile{conf: store.NewEventConfigStore(store.EventConfig{Suspended: true})}
+
+			ev := event{
+				ctfd:   &ctfd,
+				guac:   tc.guac,
+				labhub: &hub,
+				store:  &ef,
+			}
+
+			if err := ev.Resume(context.Background()); err == nil {
+				t.Fatalf("expected error when resuming")
+			}
+
+			if ctfd.status != STOPPED {
+				t.Fatalf("expected CTFd to be stopped again, but has status: %d", ctfd.status)
+			}
+
+			if hub.status != STOPPED {
+				t.Fatalf("expected labs to be stopped, but have status: %d", hub.status)
+			}
+
+			if !ef.Read().Suspended {
+				t.Fatalf("expected event to still be marked as suspended")
+			}
+		})
+	}
+}
+
+func TestScoreboard(t *testing.T) {
+	at := func(min int) *time.Time {
+		ti := time.Date(2020, 1, 1, 12, min, 0, 0, time.UTC)
+		return &ti
+	}
+
+	flags := []store.FlagConfig{
+		{Tag: "sql", Points: 10},
+		{Tag: "xss", Points: 5},
+	}
+
+	teams := []store.Team{
+		{Id: "a", Name: "late", SolvedChallenges: []store.Challenge{
+			{FlagTag: "sql", CompletedAt: at(5)},
+			{FlagTag: "xss", CompletedAt: at(10)},
+		}},
+		{Id: "b", Name: "none"},
+		{Id: "c", Name: "early", SolvedChallenges: []store.Challenge{
+			{FlagTag: "xss", CompletedAt: at(1)},
+			{FlagTag: "sql", CompletedAt: at(2)},
+			{FlagTag: "sql", CompletedAt: at(3)},
+		}},
+		{Id: "d", Name: "partial", SolvedChallenges: []store.Challenge{
+			{FlagTag: "sql", CompletedAt: at(1)},
+		}},
+	}
+
+	type score struct {
+		id     string
+		points int
+		solves int
+	}
+
+	tt := []struct {
+		name     string
+		scoring  store.ScoringConfig
+		hints    map[string][]store.UnlockedHint
+		expected []score
+	}{
+		{name: "Static", expected: []score{
+			{id: "c", points: 15, solves: 2},
+			{id: "a", points: 15, solves: 2},
+			{id: "d", points: 10, solves: 1},
+			{id: "b", points: 0, solves: 0},
+		}},
+		{name: "Dynamic with first blood", scoring: store.ScoringConfig{Mode: store.DynamicScoring, Minimum: 1, Decay: 2, FirstBloodBonus: 3}, expected: []score{
+			{id: "c", points: 8, solves: 2},
+			{id: "a", points: 5, solves: 2},
+			{id: "d", points: 4, solves: 1},
+			{id: "b", points: 0, solves: 0},
+		}},
+		{name: "Static with hints", hints: map[string][]store.UnlockedHint{
+			"c": {{FlagTag: "sql", Index: 0, Cost: 3}},
+			"d": {{FlagTag: "sql", Index: 0, Cost: 2}, {FlagTag: "sql", Index: 1, Cost: 10}},
+		}, expected: []score{
+			{id: "a", points: 15, solves: 2},
+			{id: "c", points: 12, solves: 2},
+			{id: "b", points: 0, solves: 0},
+			{id: "d", points: -2, solves: 1},
+		}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var withHints []store.Team
+			for _, team := range teams {
+				team.UnlockedHints = tc.hints[team.Id]
+				withHints = append(withHints, team)
+			}
+
+			scores := Scoreboard(withHints, flags, tc.scoring)
+			if len(scores) != len(tc.expected) {
+				t.Fatalf("expected %d scores, but received: %d", len(tc.expected), len(scores))
+			}
+
+			for i, e := range tc.expected {
+				s := scores[i]
+				if s.TeamId != e.id {
+					t.Fatalf("expected team %s at rank %d, but received: %s", e.id, i+1, s.TeamId)
+				}
+
+				if s.Points != e.points {
+					t.Fatalf("expected team %s to have %d points, but received: %d", e.id, e.points, s.Points)
+				}
+
+				if s.Solves != e.solves {
+					t.Fatalf("expected team %s to have %d solves, but received: %d", e.id, e.solves, s.Solves)
+				}
+			}
+		})
+	}
+}
+
+func TestSolveFeed(t *testing.T) {
+	feed := newSolveFeed()
+
+	first, unsubscribe := feed.subscribe()
+	second, _ := feed.subscribe()
+
+	feed.publish(Solve{TeamId: "a", Tag: "sql"})
+
+	for _, ch := range []<-chan Solve{first, second} {
+		s := <-ch
+		if s.TeamId != "a" {
+			t.Fatalf("expected solve for team a, but received: %s", s.TeamId)
+		}
+	}
+
+	unsubscribe()
+	if _, ok := <-first; ok {
+		t.Fatalf("expected channel to be closed after unsubscribing")
+	}
+
+	feed.Close()
+	if _, ok := <-second; ok {
+		t.Fatalf("expected channel to be closed after closing feed")
+	}
+
+	late, _ := feed.subscribe()
+	if _, ok := <-late; ok {
+		t.Fatalf("expected channel to be closed when subscribing to closed feed")
+	}
+}
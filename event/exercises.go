@@ -0,0 +1,157 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	DuplicateExerciseErr = errors.New("exercise is already part of the event")
+)
+
+func (ev *event) getFlags() []store.FlagConfig {
+	ev.flagsLock.RLock()
+	defer ev.flagsLock.RUnlock()
+
+	return ev.flags
+}
+
+// addFlags adds flags used for scoring, flags of exercises which have been
+// removed are known already
+func (ev *event) addFlags(flags []store.FlagConfig) {
+	ev.flagsLock.Lock()
+	defer ev.flagsLock.Unlock()
+
+	known := map[store.Tag]bool{}
+	for _, f := range ev.flags {
+		known[f.Tag] = true
+	}
+
+	all := append([]store.FlagConfig{}, ev.flags...)
+	for _, f := range flags {
+		if !known[f.Tag] {
+			all = append(all, f)
+		}
+	}
+
+	ev.flags = all
+}
+
+// hasTag reports whether any of the given tags identify the exercise
+func hasTag(e store.Exercise, tags []store.Tag) bool {
+	for _, t := range e.Tags {
+		for _, tt := range tags {
+			if t == tt {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// AddExercises adds exercises to every lab of the event, including the
+// ones waiting in the queue, and creates their challenges in CTFd
+func (ev *event) AddExercises(ctx context.Context, exercises ...store.Exercise) error {
+	conf := ev.store.Read()
+	current := conf.Lab.Exercises
+	exercises = store.WithFlagFormat(exercises, conf.FlagFormat)
+	var tags []store.Tag
+	var flags []store.FlagConfig
+	for _, e := range exercises {
+		if len(e.Tags) == 0 {
+			return UnknownExerciseErr
+		}
+
+		if hasTag(e, current) {
+			return DuplicateExerciseErr
+		}
+
+		tags = append(tags, e.Tags[0])
+		flags = append(flags, e.Flags()...)
+	}
+
+	if err := ev.labhub.AddExercises(ctx, exercises...); err != nil {
+		return err
+	}
+
+	if err := ev.ctfd.AddFlags(flags...); err != nil {
+		return err
+	}
+	ev.addFlags(flags)
+	if err := ev.store.SetFlags(ev.getFlags()); err != nil {
+		return err
+	}
+
+	for _, t := range ev.store.GetTeams() {
+		l, ok := ev.GetLabByTeam(t.Id)
+		if !ok {
+			continue
+		}
+
+		for _, c := range l.Environment().Challenges() {
+			// solves are kept for exercises added again
+			if prev, ok := t.ChalMap[c.FlagTag]; ok && prev.CompletedAt != nil {
+				continue
+			}
+
+			t.AddChallenge(c)
+		}
+
+		if err := ev.store.SaveTeam(t); err != nil {
+			log.Warn().Err(err).Str("team-id", t.Id).Msg("Unable to save challenges of team")
+		}
+	}
+
+	return ev.store.SetExercises(append(append([]store.Tag{}, current...), tags...))
+}
+
+// RemoveExercises tears down the given exercises in every lab of the event,
+// their challenges are hidden in CTFd while solves are kept for scoring
+func (ev *event) RemoveExercises(ctx context.Context, exercises ...store.Exercise) error {
+	current := ev.store.Read().Lab.Exercises
+	var tags []store.Tag
+	var flagTags []store.Tag
+	for _, e := range exercises {
+		if !hasTag(e, current) {
+			return UnknownExerciseErr
+		}
+
+		tags = append(tags, e.Tags[0])
+		for _, f := range e.Flags() {
+			flagTags = append(flagTags, f.Tag)
+		}
+	}
+
+	if err := ev.labhub.RemoveExercises(ctx, tags...); err != nil {
+		return err
+	}
+
+	if err := ev.ctfd.HideFlags(flagTags...); err != nil {
+		return err
+	}
+
+	var remaining []store.Tag
+	for _, t := range current {
+		removed := false
+		for _, e := range exercises {
+			if hasTag(e, []store.Tag{t}) {
+				removed = true
+				break
+			}
+		}
+
+		if !removed {
+			remaining = append(remaining, t)
+		}
+	}
+
+	return ev.store.SetExercises(remaining)
+}
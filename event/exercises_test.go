@@ -0,0 +1,152 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+)
+
+type exerciseCtfd struct {
+	added  []store.Tag
+	hidden []store.Tag
+	testCtfd
+}
+
+func (ctf *exerciseCtfd) AddFlags(flags ...store.FlagConfig) error {
+	for _, f := range flags {
+		ctf.added = append(ctf.added, f.Tag)
+	}
+	return nil
+}
+
+func (ctf *exerciseCtfd) HideFlags(tags ...store.Tag) error {
+	ctf.hidden = append(ctf.hidden, tags...)
+	return nil
+}
+
+type exerciseLabHub struct {
+	exercises []store.Tag
+	testLabHub
+}
+
+func (hub *exerciseLabHub) AddExercises(_ context.Context, exercises ...store.Exercise) error {
+	for _, e := range exercises {
+		hub.exercises = append(hub.exercises, e.Tags...)
+	}
+	return nil
+}
+
+func (hub *exerciseLabHub) RemoveExercises(_ context.Context, tags ...store.Tag) error {
+	hub.exercises = nil
+	return nil
+}
+
+type challengeEnvironment struct {
+	challenges []store.Challenge
+	exercise.Environment
+}
+
+func (ce *challengeEnvironment) Challenges() []store.Challenge {
+	return ce.challenges
+}
+
+type challengeLab struct {
+	env *challengeEnvironment
+	lab.Lab
+}
+
+func (cl *challengeLab) Environment() exercise.Environment {
+	return cl.env
+}
+
+func TestEvent_AddRemoveExercises(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "event")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	team := store.NewTeam("team@example.com", "team", "secret")
+	ef := store.NewEventFile(tmp, "event.yml", store.RawEventFile{
+		EventConfig: store.EventConfig{Lab: store.Lab{Exercises: []store.Tag{"hb"}}},
+		Teams:       []store.Team{team},
+	})
+
+	env := &challengeEnvironment{challenges: []store.Challenge{{FlagTag: "sql-1", FlagValue: "value"}}}
+	ctf := &exerciseCtfd{}
+	hub := &exerciseLabHub{}
+	ev := event{
+		ctfd:   ctf,
+		labhub: hub,
+		labs:   map[string]lab.Lab{team.Id: &challengeLab{env: env}},
+		store:  ef,
+		flags:  []store.FlagConfig{{Tag: "hb-1"}},
+	}
+
+	sql := store.Exercise{
+		Tags: []store.Tag{"sql"},
+		DockerConfs: []store.DockerConfig{{
+			ExerciseInstanceConfig: store.ExerciseInstanceConfig{
+				Flags: []store.FlagConfig{{Tag: "sql-1", Name: "SQL"}},
+			},
+		}},
+	}
+
+	if err := ev.AddExercises(context.Background(), sql); err != nil {
+		t.Fatalf("unexpected error when adding exercise: %s", err)
+	}
+
+	if err := ev.AddExercises(context.Background(), sql); err != DuplicateExerciseErr {
+		t.Fatalf("expected error (%s) when adding exercise twice, got: %v", DuplicateExerciseErr, err)
+	}
+
+	if len(hub.exercises) != 1 || hub.exercises[0] != "sql" {
+		t.Fatalf("expected exercise to be added to labs, got: %v", hub.exercises)
+	}
+
+	if len(ctf.added) != 1 || ctf.added[0] != "sql-1" {
+		t.Fatalf("expected flag to be added to CTFd, got: %v", ctf.added)
+	}
+
+	if n := len(ev.getFlags()); n != 2 {
+		t.Fatalf("expected two flags to be scored, got %d", n)
+	}
+
+	teams := ef.GetTeams()
+	if _, ok := teams[0].ChalMap["sql-1"]; !ok {
+		t.Fatalf("expected challenge to be assigned to team")
+	}
+
+	if exercises := ef.Read().Lab.Exercises; len(exercises) != 2 || exercises[1] != "sql" {
+		t.Fatalf("expected exercise to be added to event config, got: %v", exercises)
+	}
+
+	if err := ev.RemoveExercises(context.Background(), sql); err != nil {
+		t.Fatalf("unexpected error when removing exercise: %s", err)
+	}
+
+	if len(hub.exercises) != 0 {
+		t.Fatalf("expected exercise to be removed from labs, got: %v", hub.exercises)
+	}
+
+	if len(ctf.hidden) != 1 || ctf.hidden[0] != "sql-1" {
+		t.Fatalf("expected flag to be hidden in CTFd, got: %v", ctf.hidden)
+	}
+
+	if exercises := ef.Read().Lab.Exercises; len(exercises) != 1 || exercises[0] != "hb" {
+		t.Fatalf("expected exercise to be removed from event config, got: %v", exercises)
+	}
+
+	if err := ev.RemoveExercises(context.Background(), sql); err != UnknownExerciseErr {
+		t.Fatalf("expected error (%s) when removing exercise twice, got: %v", UnknownExerciseErr, err)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/svcs/ctfd"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	teamResetInterval = 2 * time.Minute
+)
+
+var (
+	UnknownExerciseErr = errors.New("unknown exercise")
+)
+
+// resetLimiter allows each team a single reset of their lab within an interval
+type resetLimiter struct {
+	m        sync.Mutex
+	interval time.Duration
+	last     map[string]time.Time
+	now      func() time.Time
+}
+
+func newResetLimiter(interval time.Duration) *resetLimiter {
+	return &resetLimiter{
+		interval: interval,
+		last:     map[string]time.Time{},
+		now:      time.Now,
+	}
+}
+
+// allow reports whether the team may reset, or otherwise how long it has to wait
+func (rl *resetLimiter) allow(teamId string) (time.Duration, bool) {
+	rl.m.Lock()
+	defer rl.m.Unlock()
+
+	now := rl.now()
+	if last, ok := rl.last[teamId]; ok {
+		if wait := last.Add(rl.interval).Sub(now); wait > 0 {
+			return wait, false
+		}
+	}
+	rl.last[teamId] = now
+
+	return 0, true
+}
+
+// sameOrigin rejects requests sent from other sites on behalf of a team, as
+// the session cookie alone is not proof of intent
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = r.Header.Get("Referer")
+	}
+
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return u.Host == r.Host
+}
+
+func (ev *event) resetExercise(ctx context.Context, l lab.Lab, r *http.Request) error {
+	tag := r.FormValue("tag")
+	for _, t := range ev.store.Read().Lab.Exercises {
+		if string(t) == tag {
+			return l.Environment().ResetByTag(ctx, tag)
+		}
+	}
+
+	return UnknownExerciseErr
+}
+
+func resetFrontends(ctx context.Context, l lab.Lab, r *http.Request) error {
+	return l.ResetFrontends(ctx)
+}
+
+// resetHandler lets a team logged into CTFd reset their own lab
+func (ev *event) resetHandler(reset func(context.Context, lab.Lab, *http.Request) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if !sameOrigin(r) {
+			http.Error(w, "cross-origin request", http.StatusForbidden)
+			return
+		}
+
+		c, err := r.Cookie("session")
+		if err != nil {
+			http.Error(w, "not logged in", http.StatusUnauthorized)
+			return
+		}
+
+		t, err := ev.store.GetTeamByToken(c.Value)
+		if err != nil {
+			http.Error(w, "not logged in", http.StatusUnauthorized)
+			return
+		}
+
+		// browsers may leave out both origin and referer, so the form has
+		// to prove that it was served to the session
+		token := ctfd.LabResetToken(c.Value)
+		if subtle.ConstantTimeCompare([]byte(r.FormValue(ctfd.LabResetTokenField)), []byte(token)) != 1 {
+			http.Error(w, "invalid form, reload the page and try again", http.StatusForbidden)
+			return
+		}
+
+		l, ok := ev.GetLabByTeam(t.Id)
+		if !ok {
+			http.Error(w, "no lab has been assigned to your team", http.StatusNotFound)
+			return
+		}
+
+		if wait, ok := ev.resets.allow(t.Id); !ok {
+			secs := int(wait.Seconds()) + 1
+			w.Header().Set("Retry-After", fmt.Sprintf("%d", secs))
+			http.Error(w, fmt.Sprintf("your lab was reset recently, try again in %d seconds", secs), http.StatusTooManyRequests)
+			return
+		}
+
+		log.Info().
+			Str("team-id", t.Id).
+			Str("path", r.URL.Path).
+			Msg("Team is resetting lab")
+
+		// the reset should complete even if the team leaves the page
+		if err := reset(context.Background(), l, r); err != nil {
+			log.Warn().
+				Err(err).
+				Str("team-id", t.Id).
+				Str("path", r.URL.Path).
+				Msg("Unable to reset lab for team")
+
+			if err == UnknownExerciseErr {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			http.Error(w, "unable to reset lab", http.StatusInternalServerError)
+			return
+		}
+
+		http.Redirect(w, r, "/challenges", http.StatusSeeOther)
+	})
+}
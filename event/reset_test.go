@@ -0,0 +1,196 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/ctfd"
+)
+
+type resetEnvironment struct {
+	reset []string
+	exercise.Environment
+}
+
+func (re *resetEnvironment) ResetByTag(ctx context.Context, tag string) error {
+	re.reset = append(re.reset, tag)
+	return nil
+}
+
+type resetLab struct {
+	env       *resetEnvironment
+	frontends int
+	lab.Lab
+}
+
+func (rl *resetLab) Environment() exercise.Environment {
+	return rl.env
+}
+
+func (rl *resetLab) ResetFrontends(context.Context) error {
+	rl.frontends++
+	return nil
+}
+
+type resetEventFile struct {
+	ts   store.TeamStore
+	conf store.EventConfig
+	store.EventFile
+}
+
+func (ef *resetEventFile) GetTeamByToken(token string) (store.Team, error) {
+	return ef.ts.GetTeamByToken(token)
+}
+
+func (ef *resetEventFile) Read() store.EventConfig {
+	return ef.conf
+}
+
+func TestResetHandler(t *testing.T) {
+	session := "known_session"
+
+	tt := []struct {
+		name      string
+		method    string
+		path      string
+		tag       string
+		session   string
+		origin    string
+		noToken   bool
+		noLab     bool
+		recent    bool
+		code      int
+		reset     []string
+		frontends int
+	}{
+		{name: "Reset exercise", path: ctfd.LabResetExercisePath, tag: "sql", code: http.StatusSeeOther, reset: []string{"sql"}},
+		{name: "Reset frontends", path: ctfd.LabResetFrontendsPath, code: http.StatusSeeOther, frontends: 1},
+		{name: "Same origin", path: ctfd.LabResetFrontendsPath, origin: "http://ctf.local", code: http.StatusSeeOther, frontends: 1},
+		{name: "Unknown exercise", path: ctfd.LabResetExercisePath, tag: "xss", code: http.StatusBadRequest},
+		{name: "Not logged in", path: ctfd.LabResetFrontendsPath, session: "unknown", code: http.StatusUnauthorized},
+		{name: "Cross origin", path: ctfd.LabResetFrontendsPath, origin: "http://evil.local", code: http.StatusForbidden},
+		{name: "Missing token", path: ctfd.LabResetFrontendsPath, noToken: true, code: http.StatusForbidden},
+		{name: "Token of other session", path: ctfd.LabResetFrontendsPath, session: "other_session", code: http.StatusForbidden},
+		{name: "No lab", path: ctfd.LabResetFrontendsPath, noLab: true, code: http.StatusNotFound},
+		{name: "Reset recently", path: ctfd.LabResetFrontendsPath, recent: true, code: http.StatusTooManyRequests},
+		{name: "Wrong method", method: http.MethodGet, path: ctfd.LabResetFrontendsPath, code: http.StatusMethodNotAllowed},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := store.NewTeamStore()
+			team := store.NewTeam("some@email.com", "team", "passhere")
+			if err := ts.CreateTeam(team); err != nil {
+				t.Fatalf("expected to be able to create team")
+			}
+
+			for _, token := range []string{session, "other_session"} {
+				if err := ts.CreateTokenForTeam(token, team); err != nil {
+					t.Fatalf("expected to be able to create token for team")
+				}
+			}
+
+			l := &resetLab{env: &resetEnvironment{}}
+			ev := &event{
+				store: &resetEventFile{
+					ts:   ts,
+					conf: store.EventConfig{Lab: store.Lab{Exercises: []store.Tag{"sql"}}},
+				},
+				labs:   map[string]lab.Lab{},
+				resets: newResetLimiter(time.Minute),
+			}
+
+			if !tc.noLab {
+				ev.labs[team.Id] = l
+			}
+
+			if tc.recent {
+				ev.resets.allow(team.Id)
+			}
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+
+			s := tc.session
+			if s == "" {
+				s = session
+			}
+
+			// the token is taken from the page shown to the known session
+			form := url.Values{"tag": {tc.tag}}
+			if !tc.noToken {
+				form.Set(ctfd.LabResetTokenField, ctfd.LabResetToken(session))
+			}
+
+			req := httptest.NewRequest(method, "http://ctf.local"+tc.path, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tc.origin != "" {
+				req.Header.Set("Origin", tc.origin)
+			}
+			req.AddCookie(&http.Cookie{Name: "session", Value: s})
+
+			handler := ev.resetHandler(resetFrontends)
+			if tc.path == ctfd.LabResetExercisePath {
+				handler = ev.resetHandler(ev.resetExercise)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tc.code {
+				t.Fatalf("expected status code %d, but received: %d (%s)", tc.code, w.Code, w.Body.String())
+			}
+
+			if len(l.env.reset) != len(tc.reset) {
+				t.Fatalf("expected exercises %v to be reset, but reset: %v", tc.reset, l.env.reset)
+			}
+
+			if l.frontends != tc.frontends {
+				t.Fatalf("expected frontends to be reset %d times, but reset %d times", tc.frontends, l.frontends)
+			}
+		})
+	}
+}
+
+func TestResetLimiter(t *testing.T) {
+	now := time.Now()
+	rl := newResetLimiter(time.Minute)
+	rl.now = func() time.Time { return now }
+
+	if _, ok := rl.allow("team-1"); !ok {
+		t.Fatalf("expected first reset to be allowed")
+	}
+
+	if _, ok := rl.allow("team-2"); !ok {
+		t.Fatalf("expected reset of another team to be allowed")
+	}
+
+	now = now.Add(30 * time.Second)
+	wait, ok := rl.allow("team-1")
+	if ok {
+		t.Fatalf("expected second reset within interval to be denied")
+	}
+
+	if wait != 30*time.Second {
+		t.Fatalf("expected to wait 30s, but received: %s", wait)
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, ok := rl.allow("team-1"); !ok {
+		t.Fatalf("expected reset after interval to be allowed")
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+const solveBufferSize = 64
+
+type Solve struct {
+	TeamId      string
+	TeamName    string
+	Tag         store.Tag
+	Points      uint
+	CompletedAt time.Time
+}
+
+type TeamScore struct {
+	TeamId    string
+	TeamName  string
+	Points    int
+	Solves    int
+	LastSolve *time.Time
+}
+
+// ChallengeValues returns the current value of each challenge according
+// to the scoring of the event.
+func ChallengeValues(teams []store.Team, flags []store.FlagConfig, scoring store.ScoringConfig) map[store.Tag]uint {
+	solved := store.Solvers(teams)
+
+	values := map[store.Tag]uint{}
+	for _, f := range flags {
+		values[f.Tag] = scoring.Value(f.Points, len(solved[f.Tag]))
+	}
+
+	return values
+}
+
+// Scoreboard ranks the teams by their points, less the cost of unlocked
+// hints, breaking ties by whoever reached their score first.
+func Scoreboard(teams []store.Team, flags []store.FlagConfig, scoring store.ScoringConfig) []TeamScore {
+	points := ChallengeValues(teams, flags, scoring)
+	first := store.FirstBloods(teams)
+
+	var scores []TeamScore
+	for _, t := range teams {
+		score := TeamScore{
+			TeamId:   t.Id,
+			TeamName: t.Name,
+		}
+
+		solved := map[store.Tag]bool{}
+		for _, c := range t.SolvedChallenges {
+			if solved[c.FlagTag] || c.CompletedAt == nil {
+				continue
+			}
+			solved[c.FlagTag] = true
+
+			score.Points += int(points[c.FlagTag])
+			if first[c.FlagTag] == t.Id {
+				score.Points += int(scoring.FirstBloodBonus)
+			}
+			score.Solves += 1
+			if score.LastSolve == nil || c.CompletedAt.After(*score.LastSolve) {
+				score.LastSolve = c.CompletedAt
+			}
+		}
+
+		for _, cost := range t.HintCosts() {
+			score.Points -= int(cost)
+		}
+
+		scores = append(scores, score)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		si, sj := scores[i], scores[j]
+		if si.Points != sj.Points {
+			return si.Points > sj.Points
+		}
+
+		if si.LastSolve == nil || sj.LastSolve == nil {
+			return si.LastSolve != nil
+		}
+
+		return si.LastSolve.Before(*sj.LastSolve)
+	})
+
+	return scores
+}
+
+type solveFeed struct {
+	m      sync.Mutex
+	subs   map[chan Solve]struct{}
+	closed bool
+}
+
+func newSolveFeed() *solveFeed {
+	return &solveFeed{
+		subs: map[chan Solve]struct{}{},
+	}
+}
+
+func (sf *solveFeed) subscribe() (<-chan Solve, func()) {
+	sf.m.Lock()
+	defer sf.m.Unlock()
+
+	ch := make(chan Solve, solveBufferSize)
+	if sf.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	sf.subs[ch] = struct{}{}
+
+	unsubscribe := func() {
+		sf.m.Lock()
+		defer sf.m.Unlock()
+
+		if _, ok := sf.subs[ch]; ok {
+			delete(sf.subs, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (sf *solveFeed) publish(s Solve) {
+	sf.m.Lock()
+	defer sf.m.Unlock()
+
+	for ch := range sf.subs {
+		select {
+		case ch <- s:
+		default:
+			log.Warn().
+				Str("team-id", s.TeamId).
+				Str("tag", string(s.Tag)).
+				Msg("Solve subscriber is falling behind, dropping solve")
+		}
+	}
+}
+
+func (sf *solveFeed) Close() error {
+	sf.m.Lock()
+	defer sf.m.Unlock()
+
+	for ch := range sf.subs {
+		delete(sf.subs, ch)
+		close(ch)
+	}
+	sf.closed = true
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"net/http"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+const (
+	pausedpage = `
+<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Event paused &mdash; National Training Platform</title>
+  <style>
+   .h {
+       font-family: -apple-system,BlinkMacSystemFont,avenir next,avenir,helvetica neue,helvetica,ubuntu,roboto,noto,segoe ui,arial,sans-serif;
+   }
+
+   .w {
+       color: white;
+   }
+  </style>
+</head>
+<body style="margin: 0">
+    <div style="margin: 0; width: 100%; padding: 10px 0; background: #211a52;">
+	<center>
+	    <h2 class="h w">National Training Platform</h2>
+	</center>
+    </div>
+    <center>
+	<h1 class="h">
+	    Event paused
+	</h1>
+
+	<p class="h">This event has been paused by your instructor, please come back once it has been resumed.</p>
+    </center>
+
+</body>
+</html>`
+)
+
+// suspendedHandler serves the paused page while the event is suspended
+func suspendedHandler(conf store.EventConfigStore, next http.Handler) http.Handler {
+	p := []byte(pausedpage)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if conf.Read().Suspended {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write(p)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
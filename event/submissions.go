@@ -0,0 +1,175 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/ctfd"
+	"github.com/rs/zerolog"
+)
+
+const (
+	IncidentBruteForce = "brute-force"
+
+	submissionsLog = "submissions"
+	maxLogLine     = 1 << 20
+)
+
+// Submission is a flag submitted by a team, as recorded in the archive of
+// the event. The value is hashed with the submission key of the event
+// unless the team has consented to the collection of their data.
+type Submission struct {
+	TeamId string    `json:"team-id"`
+	Tag    store.Tag `json:"tag"`
+	Result string    `json:"result"`
+	Value  string    `json:"value"`
+	Hashed bool      `json:"hashed"`
+	Time   time.Time `json:"t"`
+}
+
+func (s Submission) Correct() bool {
+	return s.Result == ctfd.AttemptCorrect
+}
+
+type wrongFlag struct {
+	flag string
+	time time.Time
+}
+
+// submissionLog writes every flag submission of the event to its archive,
+// and alerts when a team submits many distinct wrong flags
+type submissionLog struct {
+	m      sync.Mutex
+	path   string
+	key    []byte
+	logger *zerolog.Logger
+	conf   store.SubmissionConfig
+	wrong  map[string][]wrongFlag
+	alert  func(Incident)
+}
+
+func newSubmissionLog(path, key string, logger *zerolog.Logger, conf store.SubmissionConfig, alert func(Incident)) *submissionLog {
+	return &submissionLog{
+		path:   path,
+		key:    []byte(key),
+		logger: logger,
+		conf:   conf.WithDefaults(),
+		wrong:  map[string][]wrongFlag{},
+		alert:  alert,
+	}
+}
+
+// hashFlag is keyed, as flags are short enough for plain hashes to be
+// reversed by guessing
+func hashFlag(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return fmt.Sprintf("%x", mac.Sum(nil))
+}
+
+func (sl *submissionLog) record(t store.Team, a ctfd.Attempt) {
+	value, hashed := a.Flag, false
+	if !t.DataConsent() {
+		value, hashed = hashFlag(sl.key, a.Flag), true
+	}
+
+	// the time is formatted explicitly, as the global time format of
+	// zerolog loses precision and would not be read back by submissions
+	sl.logger.Log().
+		Str("t", a.Time.Format(time.RFC3339Nano)).
+		Str("team-id", t.Id).
+		Str("tag", string(a.Tag)).
+		Str("result", a.Result).
+		Str("value", value).
+		Bool("hashed", hashed).
+		Msg("submission")
+
+	if a.Result != ctfd.AttemptIncorrect && a.Result != ctfd.AttemptWrongFormat {
+		return
+	}
+
+	sl.m.Lock()
+	defer sl.m.Unlock()
+
+	since := a.Time.Add(-sl.conf.AlertWindow)
+	distinct := map[string]bool{a.Flag: true}
+	wrong := []wrongFlag{{a.Flag, a.Time}}
+	for _, w := range sl.wrong[t.Id] {
+		if w.time.After(since) {
+			distinct[w.flag] = true
+			wrong = append(wrong, w)
+		}
+	}
+	sl.wrong[t.Id] = wrong
+
+	if len(distinct) < sl.conf.AlertWrongFlags {
+		return
+	}
+
+	// the team is only reported again once it has submitted as many
+	// wrong flags anew
+	delete(sl.wrong, t.Id)
+
+	sl.alert(Incident{
+		TeamId: t.Id,
+		Action: IncidentBruteForce,
+		Error:  fmt.Sprintf("%d distinct wrong flags within %s", len(distinct), sl.conf.AlertWindow),
+		Time:   a.Time,
+	})
+}
+
+func (sl *submissionLog) submissions() ([]Submission, error) {
+	return ReadSubmissions(sl.path)
+}
+
+// SubmissionsPath is the path of the submissions recorded in an archive
+func SubmissionsPath(archiveDir string) string {
+	return filepath.Join(archiveDir, submissionsLog+".log")
+}
+
+// ReadSubmissions reads the recorded submissions back from the archive,
+// lines which cannot be parsed (e.g. one being written) are skipped
+func ReadSubmissions(path string) ([]Submission, error) {
+	var subs []Submission
+	err := readLog(path, func(line []byte) {
+		var s Submission
+		if err := json.Unmarshal(line, &s); err == nil {
+			subs = append(subs, s)
+		}
+	})
+
+	return subs, err
+}
+
+// readLog calls f with every line of the log at path, a missing log is
+// treated as an empty one
+func readLog(path string, f func([]byte)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxLogLine)
+	for scanner.Scan() {
+		f(scanner.Bytes())
+	}
+
+	return scanner.Err()
+}
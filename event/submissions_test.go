@@ -0,0 +1,100 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/ctfd"
+	"github.com/rs/zerolog"
+)
+
+func TestSubmissionLog(t *testing.T) {
+	// the vbox package disables logging globally
+	lvl := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	defer zerolog.SetGlobalLevel(lvl)
+
+	dir, err := ioutil.TempDir("", "submissions")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "submissions.log")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("unable to create submissions log: %s", err)
+	}
+	defer f.Close()
+	logger := zerolog.New(f)
+
+	var incidents []Incident
+	sl := newSubmissionLog(path, "key", &logger, store.SubmissionConfig{AlertWrongFlags: 3}, func(i Incident) {
+		incidents = append(incidents, i)
+	})
+
+	team := store.NewTeam("team@example.com", "team", "secret")
+	now := time.Now()
+	submit := func(flag, result string, offset time.Duration) {
+		sl.record(team, ctfd.Attempt{Tag: "sql-1", Flag: flag, Result: result, Time: now.Add(offset)})
+	}
+
+	submit("a", ctfd.AttemptIncorrect, 0)
+	submit("a", ctfd.AttemptIncorrect, time.Second)
+	submit("b", ctfd.AttemptWrongFormat, 2*time.Second)
+	submit("flag", ctfd.AttemptCorrect, 3*time.Second)
+	if len(incidents) != 0 {
+		t.Fatalf("expected no incident for two distinct wrong flags, got: %v", incidents)
+	}
+
+	// wrong flags outside of the alert window are forgotten
+	submit("c", ctfd.AttemptIncorrect, time.Hour)
+	if len(incidents) != 0 {
+		t.Fatalf("expected no incident after alert window, got: %v", incidents)
+	}
+
+	submit("d", ctfd.AttemptIncorrect, time.Hour+time.Second)
+	submit("e", ctfd.AttemptIncorrect, time.Hour+2*time.Second)
+	if len(incidents) != 1 || incidents[0].Action != IncidentBruteForce || incidents[0].TeamId != team.Id {
+		t.Fatalf("expected brute force incident for team, got: %v", incidents)
+	}
+
+	consenting := store.NewTeam("other@example.com", "other", "secret")
+	consenting.AddMetadata("consent", "ok")
+	sl.record(consenting, ctfd.Attempt{Tag: "sql-1", Flag: "a", Result: ctfd.AttemptCorrect, Time: now})
+
+	subs, err := sl.submissions()
+	if err != nil {
+		t.Fatalf("unable to read submissions: %s", err)
+	}
+
+	if n := len(subs); n != 8 {
+		t.Fatalf("expected every submission to be recorded, got: %d", n)
+	}
+
+	first := subs[0]
+	if first.TeamId != team.Id || first.Tag != "sql-1" || first.Correct() || !first.Time.Equal(now) {
+		t.Fatalf("unexpected submission: %+v", first)
+	}
+
+	if !first.Hashed || first.Value != hashFlag([]byte("key"), "a") {
+		t.Fatalf("expected value of team without consent to be hashed, got: %s", first.Value)
+	}
+
+	if first.Value == hashFlag([]byte("other"), "a") {
+		t.Fatalf("expected hash to depend on the key of the event")
+	}
+
+	last := subs[len(subs)-1]
+	if last.Hashed || last.Value != "a" || !last.Correct() {
+		t.Fatalf("expected value of consenting team to be kept, got: %+v", last)
+	}
+}
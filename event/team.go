@@ -0,0 +1,262 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	TeamNameTakenErr  = errors.New("a team with that name already exists")
+	TeamEmailTakenErr = errors.New("a team with that email already exists")
+)
+
+func (ev *event) getTeam(id string) (store.Team, error) {
+	for _, t := range ev.store.GetTeams() {
+		if t.Id == id {
+			return t, nil
+		}
+	}
+
+	return store.Team{}, store.UnknownTeamErr
+}
+
+// nextLab takes a lab from the queue of the hub, waiting for the hub to
+// create one until the context is done
+func (ev *event) nextLab(ctx context.Context) (lab.Lab, error) {
+	select {
+	case l, ok := <-ev.labhub.Queue():
+		if !ok {
+			return nil, ErrMaxLabs
+		}
+
+		return l, nil
+	case <-ctx.Done():
+		return nil, ErrNoAvailableLabs
+	}
+}
+
+// CreateTeam adds a team to the event and assigns a lab to it, which
+// spares the team from registering itself in CTFd
+func (ev *event) CreateTeam(ctx context.Context, t *store.Team) error {
+	if _, err := ev.store.GetTeamByName(t.Name); err == nil {
+		return TeamNameTakenErr
+	}
+
+	if _, err := ev.store.GetTeamByEmail(t.Email); err == nil {
+		return TeamEmailTakenErr
+	}
+
+	l, err := ev.nextLab(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := ev.store.CreateTeam(*t); err != nil {
+		ev.labhub.Release(l)
+		return err
+	}
+
+	if err := ev.ctfd.CreateTeam(*t); err != nil {
+		ev.store.DeleteTeam(t.Id)
+		ev.labhub.Release(l)
+		return err
+	}
+
+	// a team without a lab is of no use, so it is removed again
+	undo := func() {
+		ev.labsLock.Lock()
+		delete(ev.labs, t.Id)
+		ev.labsLock.Unlock()
+
+		ev.deleteGuacUser(t.Id)
+		ev.ctfd.DeleteTeam(t.Name)
+		ev.store.DeleteTeam(t.Id)
+		ev.labhub.Release(l)
+	}
+
+	if err := ev.AssignLab(t, l); err != nil {
+		undo()
+		return err
+	}
+
+	if err := ev.store.SaveTeam(*t); err != nil {
+		undo()
+		return err
+	}
+
+	return nil
+}
+
+// deleteGuacUser removes the guacamole user of a team together with its
+// connections
+func (ev *event) deleteGuacUser(id string) {
+	if err := ev.guac.DeleteUser(id); err != nil {
+		log.Warn().Err(err).Str("team-id", id).Msg("Unable to delete guacamole user of team")
+	}
+	ev.guacUserStore.DeleteUserForTeam(id)
+}
+
+// DeleteTeam removes a team from the event and releases its lab,
+// freeing room in the hub for a new one
+func (ev *event) DeleteTeam(id string) error {
+	t, err := ev.getTeam(id)
+	if err != nil {
+		return err
+	}
+
+	// the team is deleted in CTFd first, so the team keeps its lab if
+	// that fails
+	if err := ev.ctfd.DeleteTeam(t.Name); err != nil {
+		return err
+	}
+
+	ev.labsLock.Lock()
+	l, ok := ev.labs[t.Id]
+	delete(ev.labs, t.Id)
+	ev.labsLock.Unlock()
+
+	if ok {
+		ev.deleteGuacUser(t.Id)
+	}
+
+	// the team is disconnected from its lab at this point, so the lab
+	// is released even if the team cannot be removed from the store
+	err = ev.store.DeleteTeam(t.Id)
+	if ok {
+		if rerr := ev.labhub.Release(l); err == nil {
+			err = rerr
+		}
+	}
+
+	return err
+}
+
+func (ev *event) RenameTeam(id, name string) error {
+	t, err := ev.getTeam(id)
+	if err != nil {
+		return err
+	}
+
+	if other, err := ev.store.GetTeamByName(name); err == nil && other.Id != t.Id {
+		return TeamNameTakenErr
+	}
+
+	old := t.Name
+	t.Name = name
+	if err := ev.ctfd.UpdateTeam(old, t); err != nil {
+		return err
+	}
+
+	return ev.store.SaveTeam(t)
+}
+
+// ResetTeamPassword changes the password of a team, the credentials used
+// behind the scenes for CTFd and Guacamole are rotated as well
+func (ev *event) ResetTeamPassword(id, password string) error {
+	t, err := ev.getTeam(id)
+	if err != nil {
+		return err
+	}
+
+	if err := t.SetPassword(password); err != nil {
+		return err
+	}
+
+	// the team is saved after each rotation, so the stored credentials
+	// always match those in use
+	t.CTFdPassword = uuid.New().String()
+	if err := ev.ctfd.UpdateTeam(t.Name, t); err != nil {
+		return err
+	}
+
+	if err := ev.store.SaveTeam(t); err != nil {
+		return err
+	}
+
+	if _, ok := ev.GetLabByTeam(t.Id); ok {
+		guacPass := uuid.New().String()
+		if err := ev.guac.UpdateUserPassword(t.Id, guacPass); err != nil {
+			return err
+		}
+
+		t.GuacPassword = guacPass
+		u, err := ev.guacUserStore.GetUserForTeam(t.Id)
+		if err == nil {
+			u.Password = t.GuacPassword
+			ev.guacUserStore.CreateUserForTeam(t.Id, *u)
+		}
+
+		return ev.store.SaveTeam(t)
+	}
+
+	return nil
+}
+
+// MoveTeamLab assigns a fresh lab to a team, the old lab of the team
+// is released
+func (ev *event) MoveTeamLab(ctx context.Context, id string) error {
+	t, err := ev.getTeam(id)
+	if err != nil {
+		return err
+	}
+
+	l, err := ev.nextLab(ctx)
+	if err != nil {
+		return err
+	}
+
+	ev.labsLock.Lock()
+	old, ok := ev.labs[t.Id]
+	delete(ev.labs, t.Id)
+	ev.labsLock.Unlock()
+
+	if ok {
+		// connections are named after the team, so they have to be
+		// removed before the team is given new ones
+		if err := ev.guac.DeleteUser(t.Id); err != nil {
+			ev.labsLock.Lock()
+			ev.labs[t.Id] = old
+			ev.labsLock.Unlock()
+			ev.labhub.Release(l)
+			return err
+		}
+		ev.guacUserStore.DeleteUserForTeam(t.Id)
+	}
+
+	if err := ev.AssignLab(&t, l); err != nil {
+		ev.deleteGuacUser(t.Id)
+		ev.labhub.Release(l)
+
+		// the team is given its old lab back, or that is released as well
+		// if its connections cannot be recreated
+		if ok {
+			if err := ev.AssignLab(&t, old); err != nil {
+				log.Warn().Err(err).Str("team-id", t.Id).Msg("Unable to give team its old lab back")
+				ev.deleteGuacUser(t.Id)
+				ev.labhub.Release(old)
+			}
+		}
+
+		return err
+	}
+
+	// the team is connected to the new lab at this point, so the old
+	// lab is released even if the team cannot be saved
+	err = ev.store.SaveTeam(t)
+	if ok {
+		if rerr := ev.labhub.Release(old); err == nil {
+			err = rerr
+		}
+	}
+
+	return err
+}
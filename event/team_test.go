@@ -0,0 +1,352 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/guacamole"
+)
+
+type teamCtfd struct {
+	teams     map[string]store.Team
+	deleteErr error
+	testCtfd
+}
+
+func (ctf *teamCtfd) CreateTeam(t store.Team) error {
+	ctf.teams[t.Name] = t
+	return nil
+}
+
+func (ctf *teamCtfd) UpdateTeam(name string, t store.Team) error {
+	delete(ctf.teams, name)
+	ctf.teams[t.Name] = t
+	return nil
+}
+
+func (ctf *teamCtfd) DeleteTeam(name string) error {
+	if ctf.deleteErr != nil {
+		return ctf.deleteErr
+	}
+
+	delete(ctf.teams, name)
+	return nil
+}
+
+type teamGuac struct {
+	users     map[string]string
+	createErr error
+	updateErr error
+	testGuac
+}
+
+func (guac *teamGuac) CreateUser(username, password string) error {
+	if guac.createErr != nil {
+		return guac.createErr
+	}
+
+	guac.users[username] = password
+	return nil
+}
+
+func (guac *teamGuac) UpdateUserPassword(username, password string) error {
+	if guac.updateErr != nil {
+		return guac.updateErr
+	}
+
+	guac.users[username] = password
+	return nil
+}
+
+func (guac *teamGuac) DeleteUser(username string) error {
+	delete(guac.users, username)
+	return nil
+}
+
+type teamLabHub struct {
+	queue    chan lab.Lab
+	released []lab.Lab
+	testLabHub
+}
+
+func (hub *teamLabHub) Queue() <-chan lab.Lab {
+	return hub.queue
+}
+
+func (hub *teamLabHub) Release(l lab.Lab) error {
+	hub.released = append(hub.released, l)
+	return nil
+}
+
+func TestEvent_TeamManagement(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "event")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	first := &testLab{rdpPorts: []uint{5000}}
+	second := &testLab{rdpPorts: []uint{5001}}
+
+	ctf := &teamCtfd{teams: map[string]store.Team{}}
+	guac := &teamGuac{users: map[string]string{}}
+	hub := &teamLabHub{queue: make(chan lab.Lab, 2)}
+	hub.queue <- first
+	hub.queue <- second
+
+	ev := event{
+		ctfd:          ctf,
+		guac:          guac,
+		labhub:        hub,
+		labs:          map[string]lab.Lab{},
+		store:         store.NewEventFile(tmp, "event.yml", store.RawEventFile{}),
+		guacUserStore: guacamole.NewGuacUserStore(),
+		dockerHost:    &testDockerHost{},
+	}
+
+	team := store.NewTeam("team@example.com", "team", "secret")
+	if err := ev.CreateTeam(context.Background(), &team); err != nil {
+		t.Fatalf("unexpected error when creating team: %s", err)
+	}
+
+	if _, ok := ctf.teams["team"]; !ok {
+		t.Fatalf("expected team to be created in CTFd")
+	}
+
+	if l, _ := ev.GetLabByTeam(team.Id); l != first {
+		t.Fatalf("expected first lab to be assigned to team")
+	}
+
+	dup := store.NewTeam("other@example.com", "team", "secret")
+	if err := ev.CreateTeam(context.Background(), &dup); err != TeamNameTakenErr {
+		t.Fatalf("expected error (%s) when creating team with taken name, got: %v", TeamNameTakenErr, err)
+	}
+
+	if err := ev.RenameTeam(team.Id, "renamed"); err != nil {
+		t.Fatalf("unexpected error when renaming team: %s", err)
+	}
+
+	if _, ok := ctf.teams["renamed"]; !ok || len(ctf.teams) != 1 {
+		t.Fatalf("expected team to be renamed in CTFd, got: %v", ctf.teams)
+	}
+
+	if _, err := ev.store.GetTeamByName("renamed"); err != nil {
+		t.Fatalf("expected team to be renamed in store: %s", err)
+	}
+
+	guacPass := guac.users[team.Id]
+	if err := ev.ResetTeamPassword(team.Id, "new-secret"); err != nil {
+		t.Fatalf("unexpected error when resetting password: %s", err)
+	}
+
+	updated, err := ev.getTeam(team.Id)
+	if err != nil {
+		t.Fatalf("unexpected error when getting team: %s", err)
+	}
+
+	if !updated.IsCorrectPassword("new-secret") {
+		t.Fatalf("expected password of team to be changed")
+	}
+
+	if updated.CTFdPassword == team.CTFdPassword || ctf.teams["renamed"].CTFdPassword != updated.CTFdPassword {
+		t.Fatalf("expected CTFd password of team to be rotated")
+	}
+
+	if p := guac.users[team.Id]; p == guacPass || p != updated.GuacPassword {
+		t.Fatalf("expected guacamole password of team to be rotated")
+	}
+
+	if err := ev.MoveTeamLab(context.Background(), team.Id); err != nil {
+		t.Fatalf("unexpected error when moving team lab: %s", err)
+	}
+
+	if l, _ := ev.GetLabByTeam(team.Id); l != second {
+		t.Fatalf("expected second lab to be assigned to team")
+	}
+
+	if len(hub.released) != 1 || hub.released[0] != first {
+		t.Fatalf("expected first lab to be released, got: %v", hub.released)
+	}
+
+	if err := ev.DeleteTeam(team.Id); err != nil {
+		t.Fatalf("unexpected error when deleting team: %s", err)
+	}
+
+	if len(ctf.teams) != 0 || len(guac.users) != 0 {
+		t.Fatalf("expected team to be deleted in CTFd and guacamole")
+	}
+
+	if n := len(ev.GetTeams()); n != 0 {
+		t.Fatalf("expected no teams after deletion, got %d", n)
+	}
+
+	if len(hub.released) != 2 || hub.released[1] != second {
+		t.Fatalf("expected second lab to be released, got: %v", hub.released)
+	}
+
+	if _, ok := ev.GetLabByTeam(team.Id); ok {
+		t.Fatalf("expected lab of deleted team to be unassigned")
+	}
+}
+
+func TestEvent_TeamManagementRollback(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "event")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	guacErr := errors.New("guacamole is down")
+	first := &testLab{rdpPorts: []uint{5000}}
+	broken := &testLab{}
+
+	ctf := &teamCtfd{teams: map[string]store.Team{}}
+	guac := &teamGuac{users: map[string]string{}, createErr: guacErr}
+	hub := &teamLabHub{queue: make(chan lab.Lab, 2)}
+	hub.queue <- first
+
+	ev := event{
+		ctfd:          ctf,
+		guac:          guac,
+		labhub:        hub,
+		labs:          map[string]lab.Lab{},
+		store:         store.NewEventFile(tmp, "event.yml", store.RawEventFile{}),
+		guacUserStore: guacamole.NewGuacUserStore(),
+		dockerHost:    &testDockerHost{},
+	}
+
+	team := store.NewTeam("team@example.com", "team", "secret")
+	if err := ev.CreateTeam(context.Background(), &team); err != guacErr {
+		t.Fatalf("expected error (%s) when creating team, got: %v", guacErr, err)
+	}
+
+	if len(ctf.teams) != 0 || len(ev.GetTeams()) != 0 {
+		t.Fatalf("expected team to be removed again after failed lab assignment")
+	}
+
+	if len(hub.released) != 1 || hub.released[0] != first {
+		t.Fatalf("expected lab to be released, got: %v", hub.released)
+	}
+
+	guac.createErr = nil
+	hub.queue <- first
+	team = store.NewTeam("team@example.com", "team", "secret")
+	if err := ev.CreateTeam(context.Background(), &team); err != nil {
+		t.Fatalf("unexpected error when creating team: %s", err)
+	}
+
+	guac.updateErr = guacErr
+	if err := ev.ResetTeamPassword(team.Id, "new-secret"); err != guacErr {
+		t.Fatalf("expected error (%s) when resetting password, got: %v", guacErr, err)
+	}
+
+	updated, err := ev.getTeam(team.Id)
+	if err != nil {
+		t.Fatalf("unexpected error when getting team: %s", err)
+	}
+
+	if updated.CTFdPassword != ctf.teams["team"].CTFdPassword {
+		t.Fatalf("expected stored CTFd password to match the one in CTFd")
+	}
+
+	if updated.GuacPassword != guac.users[team.Id] {
+		t.Fatalf("expected stored guacamole password to match the one in guacamole")
+	}
+
+	// labs without RDP connections cannot be assigned
+	hub.queue <- broken
+	if err := ev.MoveTeamLab(context.Background(), team.Id); err != RdpConfErr {
+		t.Fatalf("expected error (%s) when moving team lab, got: %v", RdpConfErr, err)
+	}
+
+	if l, _ := ev.GetLabByTeam(team.Id); l != first {
+		t.Fatalf("expected team to keep its old lab")
+	}
+
+	if _, ok := guac.users[team.Id]; !ok {
+		t.Fatalf("expected guacamole user of team to be recreated")
+	}
+
+	if n := len(hub.released); n != 2 || hub.released[1] != broken {
+		t.Fatalf("expected new lab to be released, got: %v", hub.released)
+	}
+
+	ctfdErr := errors.New("CTFd is down")
+	ctf.deleteErr = ctfdErr
+	if err := ev.DeleteTeam(team.Id); err != ctfdErr {
+		t.Fatalf("expected error (%s) when deleting team, got: %v", ctfdErr, err)
+	}
+
+	if l, _ := ev.GetLabByTeam(team.Id); l != first {
+		t.Fatalf("expected team to keep its lab when it cannot be deleted in CTFd")
+	}
+
+	if _, ok := guac.users[team.Id]; !ok {
+		t.Fatalf("expected guacamole user of team to be kept")
+	}
+
+	if n := len(hub.released); n != 2 {
+		t.Fatalf("expected no lab to be released, got: %v", hub.released)
+	}
+}
+
+func TestEvent_CreateTeamWaitsForLab(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "event")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	// the hub buffers a single lab and creates the others on demand
+	hub := &teamLabHub{queue: make(chan lab.Lab, 1)}
+	labs := 3
+	go func() {
+		for i := 0; i < labs; i++ {
+			time.Sleep(10 * time.Millisecond)
+			hub.queue <- &testLab{rdpPorts: []uint{uint(5000 + i)}}
+		}
+	}()
+
+	ev := event{
+		ctfd:          &teamCtfd{teams: map[string]store.Team{}},
+		guac:          &teamGuac{users: map[string]string{}},
+		labhub:        hub,
+		labs:          map[string]lab.Lab{},
+		store:         store.NewEventFile(tmp, "event.yml", store.RawEventFile{}),
+		guacUserStore: guacamole.NewGuacUserStore(),
+		dockerHost:    &testDockerHost{},
+	}
+
+	for i := 0; i < labs; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		team := store.NewTeam(fmt.Sprintf("team-%d@example.com", i), fmt.Sprintf("team-%d", i), "secret")
+		err := ev.CreateTeam(ctx, &team)
+		cancel()
+		if err != nil {
+			t.Fatalf("unexpected error when creating team %d: %s", i+1, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	team := store.NewTeam("late@example.com", "late", "secret")
+	if err := ev.CreateTeam(ctx, &team); err != ErrNoAvailableLabs {
+		t.Fatalf("expected error (%s) when no lab is created in time, got: %v", ErrNoAvailableLabs, err)
+	}
+
+	if n := len(ev.GetTeams()); n != labs {
+		t.Fatalf("expected %d teams, got %d", labs, n)
+	}
+}
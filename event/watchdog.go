@@ -0,0 +1,254 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	watchdogInterval = 30 * time.Second
+	maxRestarts      = 5
+	restartBackoff   = 30 * time.Second
+	maxBackoff       = 10 * time.Minute
+	incidentsLog     = "incidents"
+
+	IncidentRestarted     = "restarted"
+	IncidentRestartFailed = "restart-failed"
+	IncidentGaveUp        = "gave-up"
+)
+
+// Incident describes an instance of a team lab found not to be running,
+// and what the watchdog did about it. Incidents are recorded in the
+// archive of the event.
+type Incident struct {
+	TeamId     string        `json:"team-id"`
+	InstanceId string        `json:"instance-id"`
+	Image      string        `json:"image"`
+	Type       string        `json:"type"`
+	State      virtual.State `json:"state"`
+	Action     string        `json:"action"`
+	Restarts   int           `json:"restarts"`
+	Error      string        `json:"error"`
+	Time       time.Time     `json:"t"`
+}
+
+type instanceHealth struct {
+	restarts    int
+	nextAttempt time.Time
+	gaveUp      bool
+}
+
+type busyLab interface {
+	Busy() bool
+}
+
+// watchdog periodically inspects the instances of every assigned lab, and
+// restarts those which have stopped or failed.
+type watchdog struct {
+	m      sync.Mutex
+	labs   func() map[string]lab.Lab
+	paused func() bool
+	health map[string]*instanceHealth
+	path   string
+	logger *zerolog.Logger
+	stop   chan struct{}
+	once   sync.Once
+}
+
+func newWatchdog(labs func() map[string]lab.Lab, paused func() bool, path string, logger *zerolog.Logger) *watchdog {
+	return &watchdog{
+		labs:   labs,
+		paused: paused,
+		health: map[string]*instanceHealth{},
+		path:   path,
+		logger: logger,
+		stop:   make(chan struct{}),
+	}
+}
+
+func (w *watchdog) run(ctx context.Context) {
+	ticker := time.NewTicker(watchdogInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			w.check(ctx, now)
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *watchdog) check(ctx context.Context, now time.Time) {
+	// instances of suspended events are stopped deliberately
+	if w.paused() {
+		return
+	}
+
+	for teamId, l := range w.labs() {
+		// instances are stopped on purpose while e.g. resetting or
+		// resizing frontends
+		if b, ok := l.(busyLab); ok && b.Busy() {
+			continue
+		}
+
+		for _, info := range l.InstanceInfo() {
+			if info.State == virtual.Running {
+				w.recovered(info.Id)
+				continue
+			}
+
+			w.recover(ctx, now, teamId, l, info)
+		}
+	}
+}
+
+// recovered forgets the restarts of an instance which is running again
+func (w *watchdog) recovered(id string) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	delete(w.health, id)
+}
+
+func (w *watchdog) recover(ctx context.Context, now time.Time, teamId string, l lab.Lab, info virtual.InstanceInfo) {
+	w.m.Lock()
+	h, ok := w.health[info.Id]
+	if !ok {
+		h = &instanceHealth{}
+		w.health[info.Id] = h
+	}
+
+	if h.gaveUp || now.Before(h.nextAttempt) {
+		w.m.Unlock()
+		return
+	}
+
+	incident := Incident{
+		TeamId:     teamId,
+		InstanceId: info.Id,
+		Image:      info.Image,
+		Type:       info.Type,
+		State:      info.State,
+		Restarts:   h.restarts,
+		Time:       now,
+	}
+
+	if h.restarts >= maxRestarts {
+		h.gaveUp = true
+		incident.Action = IncidentGaveUp
+		w.record(incident)
+		w.m.Unlock()
+		return
+	}
+
+	h.restarts += 1
+	backoff := restartBackoff << uint(h.restarts-1)
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	h.nextAttempt = now.Add(backoff)
+	w.m.Unlock()
+
+	incident.Restarts = h.restarts
+	incident.Action = IncidentRestarted
+	if err := l.RestartInstance(ctx, info.Id); err != nil {
+		incident.Action = IncidentRestartFailed
+		incident.Error = err.Error()
+	}
+
+	w.m.Lock()
+	w.record(incident)
+	w.m.Unlock()
+}
+
+// record must be called while holding the lock
+func (w *watchdog) record(i Incident) {
+	log.Warn().
+		Str("team-id", i.TeamId).
+		Str("instance-id", i.InstanceId).
+		Str("image", i.Image).
+		Str("state", i.State.String()).
+		Str("action", i.Action).
+		Int("restarts", i.Restarts).
+		Str("error", i.Error).
+		Msg("Unhealthy lab instance")
+
+	w.add(i)
+}
+
+// report records an incident found outside of the watchdog, such as teams
+// brute forcing flags
+func (w *watchdog) report(i Incident) {
+	log.Warn().
+		Str("team-id", i.TeamId).
+		Str("action", i.Action).
+		Str("error", i.Error).
+		Msg("Incident reported for team")
+
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.add(i)
+}
+
+// add must be called while holding the lock
+func (w *watchdog) add(i Incident) {
+	// the time is formatted explicitly, as the global time format of
+	// zerolog loses precision
+	w.logger.Log().
+		Str("t", i.Time.Format(time.RFC3339Nano)).
+		Str("team-id", i.TeamId).
+		Str("instance-id", i.InstanceId).
+		Str("image", i.Image).
+		Str("type", i.Type).
+		Int("state", int(i.State)).
+		Str("action", i.Action).
+		Int("restarts", i.Restarts).
+		Str("error", i.Error).
+		Msg("incident")
+}
+
+func (w *watchdog) Incidents() ([]Incident, error) {
+	return ReadIncidents(w.path)
+}
+
+// IncidentsPath is the path of the incidents recorded in an archive
+func IncidentsPath(archiveDir string) string {
+	return filepath.Join(archiveDir, incidentsLog+".log")
+}
+
+// ReadIncidents reads the recorded incidents back from the archive, lines
+// which cannot be parsed are skipped
+func ReadIncidents(path string) ([]Incident, error) {
+	var incidents []Incident
+	err := readLog(path, func(line []byte) {
+		var i Incident
+		if err := json.Unmarshal(line, &i); err == nil {
+			incidents = append(incidents, i)
+		}
+	})
+
+	return incidents, err
+}
+
+func (w *watchdog) Close() error {
+	w.once.Do(func() {
+		close(w.stop)
+	})
+
+	return nil
+}
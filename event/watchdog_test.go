@@ -0,0 +1,195 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package event
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/rs/zerolog"
+)
+
+type unhealthyLab struct {
+	instances []virtual.InstanceInfo
+	restarted []string
+	err       error
+	busy      bool
+	lab.Lab
+}
+
+func (l *unhealthyLab) Busy() bool {
+	return l.busy
+}
+
+func (l *unhealthyLab) InstanceInfo() []virtual.InstanceInfo {
+	return l.instances
+}
+
+func (l *unhealthyLab) RestartInstance(ctx context.Context, id string) error {
+	l.restarted = append(l.restarted, id)
+	return l.err
+}
+
+// incidentLog returns a log for the incidents of a watchdog, which is
+// removed by calling the returned function
+func incidentLog(t *testing.T) (string, *zerolog.Logger, func()) {
+	// the vbox package disables logging globally
+	lvl := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+
+	dir, err := ioutil.TempDir("", "incidents")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %s", err)
+	}
+
+	path := filepath.Join(dir, "incidents.log")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("unable to create incidents log: %s", err)
+	}
+	logger := zerolog.New(f)
+
+	return path, &logger, func() {
+		f.Close()
+		os.RemoveAll(dir)
+		zerolog.SetGlobalLevel(lvl)
+	}
+}
+
+func TestWatchdog(t *testing.T) {
+	tt := []struct {
+		name      string
+		state     virtual.State
+		err       error
+		paused    bool
+		busy      bool
+		checks    int
+		interval  time.Duration
+		restarts  int
+		incidents []string
+	}{
+		{name: "Running", state: virtual.Running, checks: 3, interval: time.Hour},
+		{name: "Suspended", state: virtual.Stopped, paused: true, checks: 3, interval: time.Hour},
+		{name: "Busy", state: virtual.Stopped, busy: true, checks: 3, interval: time.Hour},
+		{name: "Stopped", state: virtual.Stopped, checks: 1, restarts: 1, incidents: []string{IncidentRestarted}},
+		{name: "Error", state: virtual.Error, checks: 1, restarts: 1, incidents: []string{IncidentRestarted}},
+		{name: "Failed restart", state: virtual.Stopped, err: errors.New("failed"), checks: 1, restarts: 1, incidents: []string{IncidentRestartFailed}},
+		{name: "Backoff", state: virtual.Stopped, checks: 3, interval: restartBackoff / 2, restarts: 2, incidents: []string{IncidentRestarted, IncidentRestarted}},
+		{name: "Give up", state: virtual.Stopped, checks: maxRestarts + 3, interval: maxBackoff, restarts: maxRestarts, incidents: []string{
+			IncidentRestarted,
+			IncidentRestarted,
+			IncidentRestarted,
+			IncidentRestarted,
+			IncidentRestarted,
+			IncidentGaveUp,
+		}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			l := &unhealthyLab{
+				instances: []virtual.InstanceInfo{
+					{Image: "kali", Type: "vbox", Id: "kali-1", State: virtual.Running},
+					{Image: "sql", Type: "docker", Id: "sql-1", State: tc.state},
+				},
+				err:  tc.err,
+				busy: tc.busy,
+			}
+
+			path, logger, cleanup := incidentLog(t)
+			defer cleanup()
+
+			w := newWatchdog(func() map[string]lab.Lab {
+				return map[string]lab.Lab{"team-1": l}
+			}, func() bool {
+				return tc.paused
+			}, path, logger)
+
+			now := time.Now()
+			for i := 0; i < tc.checks; i++ {
+				w.check(context.Background(), now)
+				now = now.Add(tc.interval)
+			}
+
+			if len(l.restarted) != tc.restarts {
+				t.Fatalf("expected %d restarts, but received: %d", tc.restarts, len(l.restarted))
+			}
+
+			for _, id := range l.restarted {
+				if id != "sql-1" {
+					t.Fatalf("expected only the unhealthy instance to be restarted, but received: %s", id)
+				}
+			}
+
+			incidents, err := w.Incidents()
+			if err != nil {
+				t.Fatalf("unexpected error when reading incidents: %s", err)
+			}
+
+			if len(incidents) != len(tc.incidents) {
+				t.Fatalf("expected %d incidents, but received: %d", len(tc.incidents), len(incidents))
+			}
+
+			for i, action := range tc.incidents {
+				inc := incidents[i]
+				if inc.Action != action {
+					t.Fatalf("expected incident %d to be %s, but received: %s", i, action, inc.Action)
+				}
+
+				if inc.TeamId != "team-1" || inc.InstanceId != "sql-1" || inc.State != tc.state {
+					t.Fatalf("unexpected incident: %+v", inc)
+				}
+			}
+		})
+	}
+}
+
+func TestWatchdogRecovered(t *testing.T) {
+	l := &unhealthyLab{
+		instances: []virtual.InstanceInfo{
+			{Image: "sql", Type: "docker", Id: "sql-1", State: virtual.Stopped},
+		},
+	}
+
+	path, logger, cleanup := incidentLog(t)
+	defer cleanup()
+
+	w := newWatchdog(func() map[string]lab.Lab {
+		return map[string]lab.Lab{"team-1": l}
+	}, func() bool {
+		return false
+	}, path, logger)
+
+	// the instance is stopped again right after having been seen running,
+	// which is within the backoff of its first restart
+	now := time.Now()
+	for _, state := range []virtual.State{virtual.Stopped, virtual.Running, virtual.Stopped} {
+		l.instances[0].State = state
+		w.check(context.Background(), now)
+		now = now.Add(time.Second)
+	}
+
+	if len(l.restarted) != 2 {
+		t.Fatalf("expected instance to be restarted twice, but received: %d", len(l.restarted))
+	}
+
+	incidents, err := w.Incidents()
+	if err != nil {
+		t.Fatalf("unexpected error when reading incidents: %s", err)
+	}
+
+	for _, inc := range incidents {
+		if inc.Restarts != 1 {
+			t.Fatalf("expected restarts to be reset after recovery, but received: %d", inc.Restarts)
+		}
+	}
+}
@@ -21,8 +21,13 @@ import (
 type Environment interface {
 	Create(context.Context) error
 	Add(context.Context, ...store.Exercise) error
+	Remove(context.Context, ...store.Tag) error
 	ResetByTag(context.Context, string) error
+	RestartInstance(context.Context, string) error
+	Snapshot(context.Context, string) error
+	Restore(context.Context, string) error
 	NetworkInterface() string
+	Network() docker.Network
 	Challenges() []store.Challenge
 	InstanceInfo() []virtual.InstanceInfo
 	Start(context.Context) error
@@ -31,6 +36,7 @@ type Environment interface {
 }
 
 type environment struct {
+	m         sync.RWMutex
 	tags      map[store.Tag]*exercise
 	exercises []*exercise
 
@@ -38,6 +44,7 @@ type environment struct {
 	dnsServer  *dns.Server
 	dhcpServer *dhcp.Server
 	dnsAddr    string
+	started    bool
 
 	lib vbox.Library
 }
@@ -60,7 +67,13 @@ func (ee *environment) Create(ctx context.Context) error {
 	return nil
 }
 
+// Add creates the given exercises, they are started right away if the
+// environment is running
 func (ee *environment) Add(ctx context.Context, confs ...store.Exercise) error {
+	ee.m.Lock()
+	defer ee.m.Unlock()
+
+	var added []*exercise
 	for _, conf := range confs {
 		if len(conf.Tags) == 0 {
 			return MissingTagsErr
@@ -82,22 +95,85 @@ func (ee *environment) Add(ctx context.Context, confs ...store.Exercise) error {
 		}
 
 		ee.exercises = append(ee.exercises, e)
+		added = append(added, e)
 	}
 
-	return nil
+	if !ee.started {
+		return nil
+	}
+
+	for _, e := range added {
+		if err := e.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	return ee.refreshDNS(ctx)
+}
+
+// Remove closes the exercises with the given tags
+func (ee *environment) Remove(ctx context.Context, tags ...store.Tag) error {
+	ee.m.Lock()
+	defer ee.m.Unlock()
+
+	removed := map[*exercise]bool{}
+	for _, t := range tags {
+		e, ok := ee.tags[t]
+		if !ok {
+			return UnknownTagErr
+		}
+		removed[e] = true
+	}
+
+	var exercises []*exercise
+	for _, e := range ee.exercises {
+		if !removed[e] {
+			exercises = append(exercises, e)
+			continue
+		}
+
+		if err := e.Close(); err != nil {
+			log.Warn().Msgf("error while closing exercise: %s", err)
+		}
+	}
+	ee.exercises = exercises
+
+	for t, e := range ee.tags {
+		if removed[e] {
+			delete(ee.tags, t)
+		}
+	}
+
+	if !ee.started {
+		return nil
+	}
+
+	return ee.refreshDNS(ctx)
 }
 
 func (ee *environment) NetworkInterface() string {
 	return ee.network.Interface()
 }
 
+func (ee *environment) Network() docker.Network {
+	return ee.network
+}
+
 func (ee *environment) Start(ctx context.Context) error {
+	ee.m.Lock()
+	defer ee.m.Unlock()
 
 	if err := ee.refreshDNS(ctx); err != nil {
 		log.Error().Err(err).Msg("Refreshing DNS error")
 		return err
 	}
 
+	if ee.dhcpServer != nil {
+		if err := ee.dhcpServer.Close(); err != nil {
+			return err
+		}
+	}
+
 	var err error
 	ee.dhcpServer, err = dhcp.New(ee.network.FormatIP)
 	if err != nil {
@@ -124,11 +200,17 @@ func (ee *environment) Start(ctx context.Context) error {
 		}(ex)
 	}
 	wg.Wait()
+	ee.started = res == nil
 
 	return res
 }
 
 func (ee *environment) Stop() error {
+	ee.m.Lock()
+	defer ee.m.Unlock()
+
+	ee.started = false
+
 	if err := ee.dnsServer.Stop(); err != nil {
 		return err
 	}
@@ -147,6 +229,9 @@ func (ee *environment) Stop() error {
 }
 
 func (ee *environment) Close() error {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
 	var wg sync.WaitGroup
 
 	var closers []io.Closer
@@ -182,6 +267,9 @@ func (ee *environment) Close() error {
 }
 
 func (ee *environment) ResetByTag(ctx context.Context, s string) error {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
 	t, err := store.NewTag(s)
 	if err != nil {
 		return err
@@ -199,7 +287,57 @@ func (ee *environment) ResetByTag(ctx context.Context, s string) error {
 	return nil
 }
 
+func (ee *environment) RestartInstance(ctx context.Context, id string) error {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
+	for _, e := range ee.exercises {
+		if ok, err := e.RestartInstance(ctx, id); ok {
+			return err
+		}
+	}
+
+	return UnknownInstanceErr
+}
+
+func (ee *environment) Snapshot(ctx context.Context, name string) error {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
+	for _, e := range ee.exercises {
+		if err := e.Snapshot(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore restores the snapshot of every exercise, it fails before restoring
+// any of them if an exercise has been reset or added since the snapshot
+func (ee *environment) Restore(ctx context.Context, name string) error {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
+	for _, e := range ee.exercises {
+		if !e.hasSnapshot(name) {
+			return UnknownSnapshotErr
+		}
+	}
+
+	for _, e := range ee.exercises {
+		if err := e.Restore(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (ee *environment) Challenges() []store.Challenge {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
 	var challenges []store.Challenge
 	for _, e := range ee.exercises {
 		challenges = append(challenges, e.Challenges()...)
@@ -209,6 +347,9 @@ func (ee *environment) Challenges() []store.Challenge {
 }
 
 func (ee *environment) InstanceInfo() []virtual.InstanceInfo {
+	ee.m.RLock()
+	defer ee.m.RUnlock()
+
 	var instances []virtual.InstanceInfo
 	for _, e := range ee.exercises {
 		instances = append(instances, e.InstanceInfo()...)
@@ -222,11 +363,6 @@ func (ee *environment) refreshDNS(ctx context.Context) error {
 			return err
 		}
 	}
-	if ee.dhcpServer != nil {
-		if err := ee.dhcpServer.Close(); err != nil {
-			return err
-		}
-	}
 	var rrSet []dns.RR
 	for _, e := range ee.exercises {
 		for _, record := range e.dnsRecords {
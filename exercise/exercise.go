@@ -23,6 +23,9 @@ var (
 	MissingTagsErr  = errors.New("No tags, need atleast one tag")
 	UnknownTagErr   = errors.New("Unknown tag")
 
+	UnknownInstanceErr = errors.New("Unknown instance")
+	UnknownSnapshotErr = errors.New("Unknown snapshot")
+
 	tagRawRegexp = `^[a-z0-9][a-z0-9-]*[a-z0-9]$`
 	tagRegex     = regexp.MustCompile(tagRawRegexp)
 )
@@ -43,6 +46,7 @@ func (dockerHost) CreateContainer(ctx context.Context, conf docker.ContainerConf
 type exercise struct {
 	containerOpts []store.ContainerOptions
 	vboxOpts      []store.ExerciseInstanceConfig
+	flagFormat    store.FlagFormat
 
 	dhost DockerHost
 	vlib  vbox.Library
@@ -53,6 +57,9 @@ type exercise struct {
 
 	ips      []int
 	machines []virtual.Instance
+
+	// images committed from the containers, in order, by snapshot name
+	snapshots map[string][]string
 }
 
 func NewExercise(conf store.Exercise, dhost DockerHost, vlib vbox.Library, net docker.Network, dnsAddr string) *exercise {
@@ -66,6 +73,7 @@ func NewExercise(conf store.Exercise, dhost DockerHost, vlib vbox.Library, net d
 	return &exercise{
 		containerOpts: containerOpts,
 		vboxOpts:      vboxOpts,
+		flagFormat:    conf.FlagFormat,
 
 		dhost:   dhost,
 		vlib:    vlib,
@@ -78,12 +86,7 @@ func (e *exercise) Create(ctx context.Context) error {
 	var machines []virtual.Instance
 	var newIps []int
 	for i, opt := range e.containerOpts {
-		opt.DockerConf.DNS = []string{e.dnsAddr}
-		opt.DockerConf.Labels = map[string]string{
-			"hkn": "lab_exercise",
-		}
-
-		c, err := e.dhost.CreateContainer(ctx, opt.DockerConf)
+		c, err := e.dhost.CreateContainer(ctx, e.containerConf(opt.DockerConf))
 		if err != nil {
 			return err
 		}
@@ -141,6 +144,15 @@ func (e *exercise) Create(ctx context.Context) error {
 	return nil
 }
 
+func (e *exercise) containerConf(conf docker.ContainerConfig) docker.ContainerConfig {
+	conf.DNS = []string{e.dnsAddr}
+	conf.Labels = map[string]string{
+		"hkn": "lab_exercise",
+	}
+
+	return conf
+}
+
 func (e *exercise) Start(ctx context.Context) error {
 	var res error
 	var wg sync.WaitGroup
@@ -185,7 +197,16 @@ func (e *exercise) Close() error {
 	}
 	wg.Wait()
 
+	for _, images := range e.snapshots {
+		for _, img := range images {
+			if err := docker.RemoveImage(img); err != nil {
+				log.Warn().Msgf("error while removing snapshot image: %s", err)
+			}
+		}
+	}
+
 	e.machines = nil
+	e.snapshots = nil
 	return nil
 }
 
@@ -205,6 +226,106 @@ func (e *exercise) Reset(ctx context.Context) error {
 	return nil
 }
 
+// RestartInstance restarts the instance with the given id, it reports
+// whether the instance belongs to the exercise.
+func (e *exercise) RestartInstance(ctx context.Context, id string) (bool, error) {
+	for _, m := range e.machines {
+		if m.Info().Id != id {
+			continue
+		}
+
+		// the instance might have stopped already
+		if err := m.Stop(); err != nil {
+			log.Debug().Err(err).Str("id", id).Msg("Unable to stop instance before restart")
+		}
+
+		return true, m.Start(ctx)
+	}
+
+	return false, nil
+}
+
+// Snapshot commits the containers of the exercise to images and takes a
+// snapshot of its virtual machines, which can later be restored by name.
+func (e *exercise) Snapshot(ctx context.Context, name string) error {
+	var images []string
+	for _, m := range e.machines {
+		switch m := m.(type) {
+		case docker.Container:
+			img, err := m.Commit(ctx, name)
+			if err != nil {
+				return err
+			}
+			images = append(images, img)
+		case vbox.VM:
+			if err := m.Snapshot(name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if e.snapshots == nil {
+		e.snapshots = map[string][]string{}
+	}
+	e.snapshots[name] = images
+
+	return nil
+}
+
+func (e *exercise) hasSnapshot(name string) bool {
+	_, ok := e.snapshots[name]
+	return ok
+}
+
+// Restore replaces the containers of the exercise with ones created from
+// the images of the snapshot, keeping their IPs, and restores the snapshot
+// of its virtual machines.
+func (e *exercise) Restore(ctx context.Context, name string) error {
+	images, ok := e.snapshots[name]
+	if !ok {
+		return UnknownSnapshotErr
+	}
+
+	var n int
+	for i, m := range e.machines {
+		switch m := m.(type) {
+		case docker.Container:
+			if n >= len(images) || n >= len(e.ips) {
+				return UnknownSnapshotErr
+			}
+
+			if err := m.Close(); err != nil {
+				return err
+			}
+
+			conf := e.containerConf(e.containerOpts[n].DockerConf)
+			conf.Image = images[n]
+
+			c, err := e.dhost.CreateContainer(ctx, conf)
+			if err != nil {
+				return err
+			}
+
+			if _, err := e.net.Connect(c, e.ips[n]); err != nil {
+				return err
+			}
+
+			if err := c.Start(ctx); err != nil {
+				return err
+			}
+
+			e.machines[i] = c
+			n += 1
+		case vbox.VM:
+			if err := m.RestoreSnapshot(name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (e *exercise) Challenges() []store.Challenge {
 	var challenges []store.Challenge
 
@@ -216,7 +337,7 @@ func (e *exercise) Challenges() []store.Challenge {
 		for _, f := range opt.Flags {
 			challenges = append(challenges, store.Challenge{
 				FlagTag:   f.Tag,
-				FlagValue: f.Static,
+				FlagValue: e.flagFormat.Apply(f.Static),
 			})
 		}
 	}
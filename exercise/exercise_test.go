@@ -108,3 +108,129 @@ func TestExerciseCreate(t *testing.T) {
 		t.Fatalf("Expected rData '1.2.3.4', but got '%s'", e.dnsRecords[0].RData)
 	}
 }
+
+type snapshotContainer struct {
+	image  string
+	closed bool
+	docker.Container
+}
+
+func (c *snapshotContainer) Commit(ctx context.Context, tag string) (string, error) {
+	return c.image + "-snapshot:" + tag, nil
+}
+
+func (c *snapshotContainer) Start(context.Context) error {
+	return nil
+}
+
+func (c *snapshotContainer) Close() error {
+	c.closed = true
+	return nil
+}
+
+type snapshotDockerHost struct {
+	created []docker.ContainerConfig
+	DockerHost
+}
+
+func (dh *snapshotDockerHost) CreateContainer(ctx context.Context, conf docker.ContainerConfig) (docker.Container, error) {
+	dh.created = append(dh.created, conf)
+	return &snapshotContainer{image: conf.Image}, nil
+}
+
+type ipNetwork struct {
+	connected []int
+	testNetwork
+}
+
+func (n *ipNetwork) Connect(c docker.Container, ip ...int) (int, error) {
+	if len(ip) == 0 {
+		n.connected = append(n.connected, 100+len(n.connected))
+	} else {
+		n.connected = append(n.connected, ip[0])
+	}
+
+	return n.connected[len(n.connected)-1], nil
+}
+
+func TestExerciseSnapshotRestore(t *testing.T) {
+	conf := store.Exercise{
+		DockerConfs: []store.DockerConfig{
+			{ExerciseInstanceConfig: store.ExerciseInstanceConfig{InstanceConfig: store.InstanceConfig{Image: "web"}}},
+			{ExerciseInstanceConfig: store.ExerciseInstanceConfig{InstanceConfig: store.InstanceConfig{Image: "db"}}},
+		},
+	}
+
+	dh := &snapshotDockerHost{}
+	net := &ipNetwork{}
+	e := NewExercise(conf, dh, nil, net, "")
+	if err := e.Create(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := e.Restore(context.Background(), "checkpoint"); err != UnknownSnapshotErr {
+		t.Fatalf("Expected unknown snapshot error, but got: %v", err)
+	}
+
+	if err := e.Snapshot(context.Background(), "checkpoint"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	original := e.machines[0].(*snapshotContainer)
+	if err := e.Restore(context.Background(), "checkpoint"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !original.closed {
+		t.Fatalf("Expected original container to be closed")
+	}
+
+	if len(dh.created) != 4 {
+		t.Fatalf("Expected 4 containers to be created, but got %d", len(dh.created))
+	}
+
+	for i, img := range []string{"web-snapshot:checkpoint", "db-snapshot:checkpoint"} {
+		if dh.created[i+2].Image != img {
+			t.Fatalf("Expected container to be created from %s, but got %s", img, dh.created[i+2].Image)
+		}
+
+		if net.connected[i+2] != net.connected[i] {
+			t.Fatalf("Expected restored container to keep ip %d, but got %d", net.connected[i], net.connected[i+2])
+		}
+	}
+}
+
+func TestEnvironmentRestoreAfterReset(t *testing.T) {
+	conf := store.Exercise{
+		DockerConfs: []store.DockerConfig{
+			{ExerciseInstanceConfig: store.ExerciseInstanceConfig{InstanceConfig: store.InstanceConfig{Image: "web"}}},
+		},
+	}
+
+	var exercises []*exercise
+	for i := 0; i < 2; i++ {
+		e := NewExercise(conf, &snapshotDockerHost{}, nil, &ipNetwork{}, "")
+		if err := e.Create(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if err := e.Snapshot(context.Background(), "checkpoint"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		exercises = append(exercises, e)
+	}
+
+	// resetting an exercise discards its snapshots
+	exercises[1].snapshots = nil
+
+	env := &environment{exercises: exercises}
+	original := exercises[0].machines[0].(*snapshotContainer)
+	if err := env.Restore(context.Background(), "checkpoint"); err != UnknownSnapshotErr {
+		t.Fatalf("Expected unknown snapshot error, but got: %v", err)
+	}
+
+	if original.closed {
+		t.Fatalf("Expected no exercise to be restored")
+	}
+}
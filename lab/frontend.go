@@ -0,0 +1,186 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/dns"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+const rdpContainerPort = "3389/tcp"
+
+var (
+	newContainer = docker.NewContainer
+)
+
+// containerFrontend is a frontend running as a Docker container with an RDP
+// server, such as a desktop with xrdp. The RDP server is published on the
+// Docker host, while the container is connected to the network of the lab.
+type containerFrontend struct {
+	conf      docker.ContainerConfig
+	network   docker.Network
+	ip        int
+	cont      docker.Container
+	snapshots map[string]string
+}
+
+func newContainerFrontend(conf store.InstanceConfig, network docker.Network, hostIp string, rdpPort uint) *containerFrontend {
+	cconf := docker.ContainerConfig{
+		Image: conf.Image,
+		PortBindings: map[string]string{
+			rdpContainerPort: fmt.Sprintf("%s:%d", hostIp, rdpPort),
+		},
+		Labels: map[string]string{
+			"hkn": "lab_frontend",
+		},
+		DNS: []string{network.FormatIP(dns.PreferedIP)},
+		// ports cannot be published on the lab network, so the
+		// container remains on the default bridge as well
+		UseBridge: true,
+	}
+
+	if conf.MemoryMB > 0 || conf.CPU > 0 {
+		cconf.Resources = &docker.Resources{
+			MemoryMB: conf.MemoryMB,
+			CPU:      conf.CPU,
+		}
+	}
+
+	return &containerFrontend{
+		conf:    cconf,
+		network: network,
+	}
+}
+
+func (f *containerFrontend) Create(ctx context.Context) error {
+	return f.createFrom(ctx, f.conf)
+}
+
+func (f *containerFrontend) createFrom(ctx context.Context, conf docker.ContainerConfig) error {
+	c := newContainer(conf)
+	if err := c.Create(ctx); err != nil {
+		return err
+	}
+
+	// a recreated frontend keeps its IP on the lab network
+	var ips []int
+	if f.ip != 0 {
+		ips = append(ips, f.ip)
+	}
+
+	ip, err := f.network.Connect(c, ips...)
+	if err != nil {
+		return err
+	}
+
+	f.ip = ip
+	f.cont = c
+
+	return nil
+}
+
+func (f *containerFrontend) Start(ctx context.Context) error {
+	return f.cont.Start(ctx)
+}
+
+func (f *containerFrontend) Run(ctx context.Context) error {
+	if err := f.Create(ctx); err != nil {
+		return err
+	}
+
+	return f.Start(ctx)
+}
+
+func (f *containerFrontend) Stop() error {
+	return f.cont.Stop()
+}
+
+func (f *containerFrontend) Info() virtual.InstanceInfo {
+	return f.cont.Info()
+}
+
+func (f *containerFrontend) Close() error {
+	if err := f.cont.Close(); err != nil {
+		return err
+	}
+
+	for _, img := range f.snapshots {
+		if err := docker.RemoveImage(img); err != nil {
+			log.Warn().Msgf("error while removing snapshot image: %s", err)
+		}
+	}
+	f.snapshots = nil
+
+	return nil
+}
+
+func (f *containerFrontend) SetRAM(mb uint) error {
+	if err := f.cont.SetRAM(mb); err != nil {
+		return err
+	}
+
+	if f.conf.Resources == nil {
+		f.conf.Resources = &docker.Resources{}
+	}
+	f.conf.Resources.MemoryMB = mb
+
+	return nil
+}
+
+func (f *containerFrontend) SetCPU(cpu float64) error {
+	if err := f.cont.SetCPU(cpu); err != nil {
+		return err
+	}
+
+	if f.conf.Resources == nil {
+		f.conf.Resources = &docker.Resources{}
+	}
+	f.conf.Resources.CPU = cpu
+
+	return nil
+}
+
+func (f *containerFrontend) Snapshot(name string) error {
+	img, err := f.cont.Commit(context.Background(), name)
+	if err != nil {
+		return err
+	}
+
+	if f.snapshots == nil {
+		f.snapshots = map[string]string{}
+	}
+	f.snapshots[name] = img
+
+	return nil
+}
+
+// RestoreSnapshot replaces the container with one created from the image of
+// the snapshot
+func (f *containerFrontend) RestoreSnapshot(name string) error {
+	img, ok := f.snapshots[name]
+	if !ok {
+		return UnknownSnapshotErr
+	}
+
+	if err := f.cont.Close(); err != nil {
+		return err
+	}
+
+	conf := f.conf
+	conf.Image = img
+
+	ctx := context.Background()
+	if err := f.createFrom(ctx, conf); err != nil {
+		return err
+	}
+
+	return f.cont.Start(ctx)
+}
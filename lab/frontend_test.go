@@ -0,0 +1,127 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+)
+
+type testContainer struct {
+	conf    docker.ContainerConfig
+	started bool
+	closed  bool
+	docker.Container
+}
+
+func (c *testContainer) Create(context.Context) error {
+	return nil
+}
+
+func (c *testContainer) Start(context.Context) error {
+	c.started = true
+	return nil
+}
+
+func (c *testContainer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *testContainer) Commit(ctx context.Context, tag string) (string, error) {
+	return c.conf.Image + "-snapshot:" + tag, nil
+}
+
+type testNetwork struct {
+	connected []int
+	docker.Network
+}
+
+func (n *testNetwork) Connect(c docker.Container, ip ...int) (int, error) {
+	if len(ip) == 0 {
+		n.connected = append(n.connected, 30+len(n.connected))
+	} else {
+		n.connected = append(n.connected, ip[0])
+	}
+
+	return n.connected[len(n.connected)-1], nil
+}
+
+func (n *testNetwork) FormatIP(num int) string {
+	return "1.2.3.4"
+}
+
+type networkEnvironment struct {
+	network docker.Network
+	exercise.Environment
+}
+
+func (ee *networkEnvironment) Network() docker.Network {
+	return ee.network
+}
+
+func TestContainerFrontend(t *testing.T) {
+	var containers []*testContainer
+	newContainer = func(conf docker.ContainerConfig) docker.Container {
+		c := &testContainer{conf: conf}
+		containers = append(containers, c)
+		return c
+	}
+	defer func() { newContainer = docker.NewContainer }()
+
+	network := &testNetwork{}
+	l := lab{
+		dockerHost:  &testDockerHost{},
+		environment: &networkEnvironment{network: network},
+		frontends:   map[uint]frontendConf{},
+	}
+
+	conf := store.InstanceConfig{Image: "kali-xrdp", MemoryMB: 2048, Type: store.DockerFrontend}
+	f, err := l.addFrontend(context.Background(), conf, 5000)
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	if len(containers) != 1 {
+		t.Fatalf("expected a container to be created, but %d were", len(containers))
+	}
+
+	cconf := containers[0].conf
+	if binding := cconf.PortBindings[rdpContainerPort]; binding != "1.2.3.4:5000" {
+		t.Fatalf("expected RDP to be published on 1.2.3.4:5000, but received: %s", binding)
+	}
+
+	if cconf.Resources == nil || cconf.Resources.MemoryMB != 2048 {
+		t.Fatalf("expected container to be limited to 2048 MB, but received: %+v", cconf.Resources)
+	}
+
+	if err := f.Snapshot("checkpoint"); err != nil {
+		t.Fatalf("expected no error when taking snapshot, but received: %s", err)
+	}
+
+	if err := f.RestoreSnapshot("other"); err != UnknownSnapshotErr {
+		t.Fatalf("expected unknown snapshot error, but received: %v", err)
+	}
+
+	if err := f.RestoreSnapshot("checkpoint"); err != nil {
+		t.Fatalf("expected no error when restoring snapshot, but received: %s", err)
+	}
+
+	if len(containers) != 2 || !containers[0].closed || !containers[1].started {
+		t.Fatalf("expected container to be replaced by a started container")
+	}
+
+	if img := containers[1].conf.Image; img != "kali-xrdp-snapshot:checkpoint" {
+		t.Fatalf("expected container to be created from snapshot, but received: %s", img)
+	}
+
+	if network.connected[0] != network.connected[1] {
+		t.Fatalf("expected restored container to keep its ip, but received: %v", network.connected)
+	}
+}
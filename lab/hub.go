@@ -7,26 +7,56 @@ package lab
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
+
 	"github.com/aau-network-security/haaukins/logging"
+	"github.com/aau-network-security/haaukins/metrics"
+	"github.com/aau-network-security/haaukins/store"
 	"github.com/rs/zerolog/log"
-	"sync"
 )
 
 var (
+	// creationRetryDelay is the time waited before retrying to create a
+	// lab once the creation of one has failed
+	creationRetryDelay = 10 * time.Second
+
 	ErrBufferSize = errors.New("Buffer cannot be larger than capacity")
 	ErrNoLabByTag = errors.New("Could not find lab by the specified tag")
 )
 
 type Hub interface {
 	Queue() <-chan Lab
+	Available() int
+	Release(Lab) error
+	AddExercises(context.Context, ...store.Exercise) error
+	RemoveExercises(context.Context, ...store.Tag) error
+	Suspend() error
+	Resume(context.Context) error
 	Close() error
 }
 
 type hub struct {
-	creator Creator
-	queue   <-chan Lab
-	labs    map[string]Lab
-	stop    chan struct{}
+	m           sync.Mutex
+	creator     Creator
+	queue       chan Lab
+	queueSize   int
+	queueClosed bool
+	labs        map[string]Lab
+	release     chan struct{}
+	stop        chan struct{}
+
+	// creation is held for reading while a lab is created, which keeps
+	// the exercises of the hub from changing halfway through
+	creation sync.RWMutex
+	// pending are labs created but not yet received by the hub
+	pending map[string]Lab
+}
+
+// exerciseCreator is a creator whose exercises can be changed
+type exerciseCreator interface {
+	AddExercises(...store.Exercise)
+	RemoveExercises(...store.Tag)
 }
 
 func NewHub(ctx context.Context, creator Creator, buffer int, cap int) (*hub, error) {
@@ -37,27 +67,65 @@ func NewHub(ctx context.Context, creator Creator, buffer int, cap int) (*hub, er
 	grpcLogger := logging.LoggerFromCtx(ctx)
 
 	ready := make(chan struct{})
+	failed := make(chan struct{})
+	retry := make(chan struct{})
 	stop := make(chan struct{})
 	labs := make(chan Lab, buffer-workerAmount)
-	queue := make(chan Lab, buffer-workerAmount)
+	queueSize := buffer - workerAmount
+
+	h := &hub{
+		creator:   creator,
+		queue:     make(chan Lab, queueSize),
+		queueSize: queueSize,
+		stop:      stop,
+		labs:      map[string]Lab{},
+		release:   make(chan struct{}, cap),
+		pending:   map[string]Lab{},
+	}
 
+	// wg is added to before a lab is requested from a worker, so it
+	// cannot race with the dispatcher waiting for the workers
 	var wg sync.WaitGroup
+	request := func() {
+		wg.Add(1)
+		ready <- struct{}{}
+	}
+
 	worker := func() {
 		ctx := context.Background()
 		for range ready {
-			wg.Add(1)
+			started := time.Now()
+			h.creation.RLock()
 			lab, err := creator.NewLab(ctx)
 			if err != nil {
 				log.Error().Msgf("Error while creating new lab %s", err.Error())
+				h.creation.RUnlock()
+				wg.Done()
+
+				select {
+				case failed <- struct{}{}:
+				case <-stop:
+				}
+				continue
 			}
 
 			if err := lab.Start(ctx); err != nil {
 				log.Error().Msgf("Error while starting lab %s", err.Error())
 			}
+
+			h.m.Lock()
+			h.pending[lab.Tag()] = lab
+			h.m.Unlock()
+			h.creation.RUnlock()
+
+			metrics.LabCreationDuration.Observe(time.Since(started).Seconds())
 			select {
 			case labs <- lab:
 				wg.Done()
 			case <-stop:
+				h.m.Lock()
+				delete(h.pending, lab.Tag())
+				h.m.Unlock()
 				wg.Done()
 
 				/* Delete lab as it wasn't added to the lab queue */
@@ -77,83 +145,254 @@ func NewHub(ctx context.Context, creator Creator, buffer int, cap int) (*hub, er
 
 	for i := 0; i < workerAmount; i++ {
 		go worker()
-		ready <- struct{}{}
-
+		request()
 	}
 
-	startedLabs := map[string]Lab{}
 	go func() {
-		permOnce := func(f func()) func() {
-			var once sync.Once
-			return func() {
-				once.Do(f)
-			}
-		}
-
-		queueCloser := permOnce(func() { close(queue) })
-		labsCloser := permOnce(func() { close(labs) })
-		readyCloser := permOnce(func() { close(ready) })
+		// amount of labs being created by workers
+		creating := workerAmount
 
-		defer queueCloser()
-		defer readyCloser()
+		defer close(ready)
+		defer func() {
+			h.m.Lock()
+			if !h.queueClosed {
+				close(h.queue)
+				h.queueClosed = true
+			}
+			h.m.Unlock()
+		}()
 
 		for {
 			select {
 			case lab := <-labs:
-				startedLabs[lab.Tag()] = lab
+				creating -= 1
+
+				h.m.Lock()
+				delete(h.pending, lab.Tag())
+				h.labs[lab.Tag()] = lab
+				started := len(h.labs)
+				h.openQueue()
+				queue := h.queue
+				h.m.Unlock()
+
 				select {
 				case queue <- lab:
 				case <-stop:
 					continue
 				}
 
-				// minus one, as one worker is inactive
-				labsStarting := len(startedLabs) + workerAmount - 1
-				if labsStarting == cap {
-					readyCloser()
+				if started+creating < cap {
+					request()
+					creating += 1
 					continue
 				}
 
-				if len(startedLabs) == cap {
-					queueCloser()
-					continue
+				if started >= cap && creating == 0 {
+					h.m.Lock()
+					if h.queue == queue {
+						close(queue)
+						h.queueClosed = true
+					}
+					h.m.Unlock()
 				}
 
-				ready <- struct{}{}
+			case <-failed:
+				// the worker stays counted as creating until the lab
+				// is requested from it again
+				time.AfterFunc(creationRetryDelay, func() {
+					select {
+					case retry <- struct{}{}:
+					case <-stop:
+					}
+				})
+
+			case <-retry:
+				request()
+
+			case <-h.release:
+				h.m.Lock()
+				started := len(h.labs)
+				h.m.Unlock()
+
+				// busy workers request a new lab once done
+				if creating < workerAmount && started+creating < cap {
+					request()
+					creating += 1
+				}
 
 			case <-stop:
 				// wait for workers to finish starting labs
 				wg.Wait()
 				// close lab chan and iterate its content
-				labsCloser()
+				close(labs)
 				for l := range labs {
 					if err := l.Close(); err != nil {
 						log.Error().Msgf("Error while closing ready labs %s", err.Error())
 					}
 				}
 
-				for _, l := range startedLabs {
+				h.m.Lock()
+				for _, l := range h.labs {
 					if err := l.Close(); err != nil {
 						log.Error().Msgf("Error while closing started labs %s", err.Error())
 					}
 				}
+				h.m.Unlock()
 				return
 			}
 		}
 	}()
 
-	return &hub{
-		creator: creator,
-		queue:   queue,
-		stop:    stop,
-		labs:    startedLabs,
-	}, nil
+	return h, nil
 }
 
 func (h *hub) Queue() <-chan Lab {
+	h.m.Lock()
+	defer h.m.Unlock()
+
 	return h.queue
 }
 
+// Available returns the number of labs ready to be assigned to teams
+func (h *hub) Available() int {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	return len(h.queue)
+}
+
+// openQueue replaces the queue if it has been closed as the capacity was
+// reached, labs left in the closed queue are moved to the new one
+func (h *hub) openQueue() {
+	if !h.queueClosed {
+		return
+	}
+
+	queue := make(chan Lab, h.queueSize)
+	for l := range h.queue {
+		queue <- l
+	}
+
+	h.queue = queue
+	h.queueClosed = false
+}
+
+// Release closes a lab taken from the queue, which frees its capacity in
+// the hub for a new lab.
+func (h *hub) Release(l Lab) error {
+	h.m.Lock()
+	delete(h.labs, l.Tag())
+	h.openQueue()
+	h.m.Unlock()
+
+	if err := l.Close(); err != nil {
+		return err
+	}
+
+	select {
+	case h.release <- struct{}{}:
+	case <-h.stop:
+	}
+
+	return nil
+}
+
+// AddExercises adds exercises to every lab of the hub, labs created
+// afterwards include them as well.
+func (h *hub) AddExercises(ctx context.Context, exercises ...store.Exercise) error {
+	h.creation.Lock()
+	defer h.creation.Unlock()
+
+	if c, ok := h.creator.(exerciseCreator); ok {
+		c.AddExercises(exercises...)
+	}
+
+	return h.eachLab(func(l Lab) error {
+		return l.Environment().Add(ctx, exercises...)
+	})
+}
+
+// RemoveExercises closes the exercises with the given tags in every lab
+// of the hub, labs created afterwards are without them.
+func (h *hub) RemoveExercises(ctx context.Context, tags ...store.Tag) error {
+	h.creation.Lock()
+	defer h.creation.Unlock()
+
+	if c, ok := h.creator.(exerciseCreator); ok {
+		c.RemoveExercises(tags...)
+	}
+
+	return h.eachLab(func(l Lab) error {
+		return l.Environment().Remove(ctx, tags...)
+	})
+}
+
+// eachLab runs f concurrently on every lab of the hub, including those
+// waiting to be received by the hub
+func (h *hub) eachLab(f func(Lab) error) error {
+	h.m.Lock()
+	var all []Lab
+	for _, l := range h.labs {
+		all = append(all, l)
+	}
+	for _, l := range h.pending {
+		all = append(all, l)
+	}
+	h.m.Unlock()
+
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	var firstErr error
+	for _, l := range all {
+		wg.Add(1)
+		go func(l Lab) {
+			defer wg.Done()
+			if err := f(l); err != nil {
+				log.Warn().Str("lab", l.Tag()).Msgf("error while changing exercises of lab: %s", err)
+
+				m.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				m.Unlock()
+			}
+		}(l)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// Suspend stops every lab started by the hub, both the ones assigned to
+// teams and the ones waiting in the queue.
+func (h *hub) Suspend() error {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	var firstErr error
+	for _, l := range h.labs {
+		if err := l.Stop(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func (h *hub) Resume(ctx context.Context) error {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	var firstErr error
+	for _, l := range h.labs {
+		if err := l.Start(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
 func (h *hub) Close() error {
 	close(h.stop)
 	return nil
@@ -6,12 +6,15 @@ package lab
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/google/uuid"
 )
@@ -47,6 +50,22 @@ func (tl *testLab) ResetFrontends(context.Context) error {
 	return nil
 }
 
+func (tl *testLab) RestartInstance(context.Context, string) error {
+	return nil
+}
+
+func (tl *testLab) ResizeFrontends(context.Context, store.InstanceConfig) (int, error) {
+	return 0, nil
+}
+
+func (tl *testLab) Snapshot(context.Context, string) error {
+	return nil
+}
+
+func (tl *testLab) Restore(context.Context, string) error {
+	return nil
+}
+
 func (tl *testLab) Tag() string {
 	return uuid.New().String()
 }
@@ -59,6 +78,10 @@ func (tl *testLab) RdpConnPorts() []uint {
 	return nil
 }
 
+func (tl *testLab) RdpHost() string {
+	return ""
+}
+
 type testCreator struct {
 	m       sync.Mutex
 	lab     Lab
@@ -142,6 +165,240 @@ func TestHub(t *testing.T) {
 	}
 }
 
+type failingCreator struct {
+	m     sync.Mutex
+	fails int
+	testCreator
+}
+
+func (c *failingCreator) NewLab(ctx context.Context) (Lab, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.fails > 0 {
+		c.fails -= 1
+		return nil, errors.New("worker is down")
+	}
+
+	return c.testCreator.NewLab(ctx)
+}
+
+func TestHubCreationFailure(t *testing.T) {
+	delay := creationRetryDelay
+	creationRetryDelay = 10 * time.Millisecond
+	defer func() { creationRetryDelay = delay }()
+
+	started := make(chan bool, 100)
+	closed := make(chan bool, 100)
+	c := &failingCreator{fails: 3, testCreator: testCreator{lab: &testLab{started, closed}}}
+	h, err := NewHub(context.Background(), c, 2, 2)
+	if err != nil {
+		t.Fatalf("unable to create hub: %s", err)
+	}
+	defer h.Close()
+
+	// the failed creations are retried until the capacity is reached
+	for i := 0; i < 2; i++ {
+		select {
+		case <-h.Queue():
+		case <-time.After(time.Second):
+			t.Fatalf("expected lab %d to be queued despite failures", i+1)
+		}
+	}
+
+	if n := readAmountChan(started, 2, time.Second); n != 2 {
+		t.Fatalf("expected 2 labs to be started, but %d were started", n)
+	}
+}
+
+type taggedLab struct {
+	tag string
+	*testLab
+}
+
+func (tl *taggedLab) Tag() string {
+	return tl.tag
+}
+
+type taggedCreator struct {
+	m       sync.Mutex
+	started chan<- bool
+	closed  chan<- bool
+	n       int
+}
+
+func (c *taggedCreator) NewLab(context.Context) (Lab, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.n += 1
+	return &taggedLab{tag: fmt.Sprintf("lab-%d", c.n), testLab: &testLab{c.started, c.closed}}, nil
+}
+
+func TestHubRelease(t *testing.T) {
+	started := make(chan bool, 1000)
+	closed := make(chan bool, 1000)
+	c := &taggedCreator{started: started, closed: closed}
+
+	h, err := NewHub(context.Background(), c, 2, 2)
+	if err != nil {
+		t.Fatalf("unable to create hub: %s", err)
+	}
+	defer h.Close()
+
+	var labs []Lab
+	for i := 0; i < 2; i++ {
+		select {
+		case l := <-h.Queue():
+			labs = append(labs, l)
+		case <-time.After(time.Second):
+			t.Fatalf("expected lab %d to be queued", i+1)
+		}
+	}
+
+	if _, ok := <-h.Queue(); ok {
+		t.Fatalf("expected queue to be closed when capacity is reached")
+	}
+
+	if err := h.Release(labs[0]); err != nil {
+		t.Fatalf("unexpected error when releasing lab: %s", err)
+	}
+
+	if n := readAmountChan(closed, 1, time.Second); n != 1 {
+		t.Fatalf("expected released lab to be closed, but %d were closed", n)
+	}
+
+	select {
+	case l, ok := <-h.Queue():
+		if !ok {
+			t.Fatalf("expected a new lab to be queued after release, but queue is closed")
+		}
+
+		if l.Tag() != "lab-3" {
+			t.Fatalf("expected new lab (lab-3), received: %s", l.Tag())
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected a new lab to be queued after release")
+	}
+
+	if _, ok := <-h.Queue(); ok {
+		t.Fatalf("expected queue to be closed when capacity is reached again")
+	}
+}
+
+type exerciseEnv struct {
+	m       sync.Mutex
+	added   []store.Tag
+	removed []store.Tag
+	exercise.Environment
+}
+
+func (ee *exerciseEnv) Add(_ context.Context, exercises ...store.Exercise) error {
+	ee.m.Lock()
+	defer ee.m.Unlock()
+
+	for _, e := range exercises {
+		ee.added = append(ee.added, e.Tags...)
+	}
+	return nil
+}
+
+func (ee *exerciseEnv) Remove(_ context.Context, tags ...store.Tag) error {
+	ee.m.Lock()
+	defer ee.m.Unlock()
+
+	ee.removed = append(ee.removed, tags...)
+	return nil
+}
+
+type exerciseLab struct {
+	env *exerciseEnv
+	*taggedLab
+}
+
+func (el *exerciseLab) Environment() exercise.Environment {
+	return el.env
+}
+
+type exerciseCreatorLab struct {
+	conf []store.Tag
+	labs []*exerciseLab
+	taggedCreator
+}
+
+func (c *exerciseCreatorLab) NewLab(ctx context.Context) (Lab, error) {
+	l, _ := c.taggedCreator.NewLab(ctx)
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	el := &exerciseLab{env: &exerciseEnv{}, taggedLab: l.(*taggedLab)}
+	c.labs = append(c.labs, el)
+	return el, nil
+}
+
+func (c *exerciseCreatorLab) AddExercises(exercises ...store.Exercise) {
+	for _, e := range exercises {
+		c.conf = append(c.conf, e.Tags...)
+	}
+}
+
+func (c *exerciseCreatorLab) RemoveExercises(tags ...store.Tag) {
+	c.conf = nil
+}
+
+func TestHubExercises(t *testing.T) {
+	started := make(chan bool, 1000)
+	closed := make(chan bool, 1000)
+	c := &exerciseCreatorLab{taggedCreator: taggedCreator{started: started, closed: closed}}
+
+	h, err := NewHub(context.Background(), c, 2, 2)
+	if err != nil {
+		t.Fatalf("unable to create hub: %s", err)
+	}
+	defer h.Close()
+
+	select {
+	case <-h.Queue():
+	case <-time.After(time.Second):
+		t.Fatalf("expected lab to be queued")
+	}
+
+	if n := readAmountChan(started, 2, time.Second); n != 2 {
+		t.Fatalf("expected two labs to be started, but %d were started", n)
+	}
+
+	tag := store.Tag("sql")
+	if err := h.AddExercises(context.Background(), store.Exercise{Tags: []store.Tag{tag}}); err != nil {
+		t.Fatalf("unexpected error when adding exercises: %s", err)
+	}
+
+	if len(c.conf) != 1 || c.conf[0] != tag {
+		t.Fatalf("expected exercise to be added to creator, got: %v", c.conf)
+	}
+
+	if err := h.RemoveExercises(context.Background(), tag); err != nil {
+		t.Fatalf("unexpected error when removing exercises: %s", err)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if len(c.labs) != 2 {
+		t.Fatalf("expected two labs to be created, got %d", len(c.labs))
+	}
+
+	for _, l := range c.labs {
+		if len(l.env.added) != 1 || l.env.added[0] != tag {
+			t.Fatalf("expected exercise to be added to lab %s, got: %v", l.Tag(), l.env.added)
+		}
+
+		if len(l.env.removed) != 1 || l.env.removed[0] != tag {
+			t.Fatalf("expected exercise to be removed from lab %s, got: %v", l.Tag(), l.env.removed)
+		}
+	}
+}
+
 func readAmountChan(c <-chan bool, amount int, wait time.Duration) int {
 	var n int
 
@@ -6,6 +6,7 @@ package lab
 
 import (
 	"context"
+	"errors"
 	"github.com/aau-network-security/haaukins/exercise"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual"
@@ -14,17 +15,25 @@ import (
 	"github.com/docker/docker/pkg/namesgenerator"
 	"github.com/rs/zerolog/log"
 	"math/rand"
+	"regexp"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 var (
 	newEnvironment = exercise.NewEnvironment
+
+	InvalidSnapshotNameErr = errors.New("snapshot name can only contain letters, digits, '_', '.' and '-'")
+	DuplicateSnapshotErr   = errors.New("snapshot already exists")
+	UnknownSnapshotErr     = errors.New("unknown snapshot")
+
+	snapshotNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
 )
 
 type Config struct {
-	Frontends []store.InstanceConfig
-	Exercises []store.Exercise
+	Frontends []store.InstanceConfig `yaml:"frontends"`
+	Exercises []store.Exercise       `yaml:"exercises"`
 }
 
 func (conf Config) Flags() []store.FlagConfig {
@@ -39,12 +48,30 @@ type Creator interface {
 	NewLab(context.Context) (Lab, error)
 }
 
+// Scheduler decides which host a lab is created on, local creates the lab
+// on this host.
+type Scheduler interface {
+	NewLab(ctx context.Context, conf Config, local Creator) (Lab, error)
+}
+
 type LabHost struct {
 	Vlib vbox.Library
 	Conf Config
+
+	// RdpHost is the address frontends serve RDP on, defaults to the
+	// Docker host
+	RdpHost   string
+	Scheduler Scheduler
 }
 
 func (lh *LabHost) NewLab(ctx context.Context) (Lab, error) {
+	if lh.Scheduler != nil {
+		local := *lh
+		local.Scheduler = nil
+
+		return lh.Scheduler.NewLab(ctx, lh.Conf, &local)
+	}
+
 	env := newEnvironment(lh.Vlib)
 	if err := env.Create(ctx); err != nil {
 		return nil, err
@@ -61,6 +88,7 @@ func (lh *LabHost) NewLab(ctx context.Context) (Lab, error) {
 		environment: env,
 		dockerHost:  dockerHost,
 		frontends:   map[uint]frontendConf{},
+		rdpHost:     lh.RdpHost,
 	}
 
 	for _, f := range lh.Conf.Frontends {
@@ -73,13 +101,49 @@ func (lh *LabHost) NewLab(ctx context.Context) (Lab, error) {
 	return l, nil
 }
 
+// AddExercises adds exercises to the configuration of labs created afterwards
+func (lh *LabHost) AddExercises(exercises ...store.Exercise) {
+	lh.Conf.Exercises = append(lh.Conf.Exercises, exercises...)
+}
+
+// RemoveExercises removes the exercises with any of the given tags from the
+// configuration of labs created afterwards
+func (lh *LabHost) RemoveExercises(tags ...store.Tag) {
+	remove := map[store.Tag]bool{}
+	for _, t := range tags {
+		remove[t] = true
+	}
+
+	var exercises []store.Exercise
+	for _, e := range lh.Conf.Exercises {
+		keep := true
+		for _, t := range e.Tags {
+			if remove[t] {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			exercises = append(exercises, e)
+		}
+	}
+
+	lh.Conf.Exercises = exercises
+}
+
 type Lab interface {
 	Start(context.Context) error
 	Stop() error
 	Restart(context.Context) error
 	Environment() exercise.Environment
 	ResetFrontends(ctx context.Context) error
+	RestartInstance(ctx context.Context, id string) error
+	ResizeFrontends(ctx context.Context, conf store.InstanceConfig) (int, error)
+	Snapshot(ctx context.Context, name string) error
+	Restore(ctx context.Context, name string) error
 	RdpConnPorts() []uint
+	RdpHost() string
 	Tag() string
 	InstanceInfo() []virtual.InstanceInfo
 	Close() error
@@ -89,19 +153,55 @@ type lab struct {
 	tag         string
 	lib         vbox.Library
 	environment exercise.Environment
-	frontends   map[uint]frontendConf
 	dockerHost  docker.Host
+	rdpHost     string
+
+	m         sync.RWMutex
+	frontends map[uint]frontendConf
+	snapshots map[string]struct{}
+
+	// number of operations stopping instances of the lab on purpose
+	busy int32
+}
+
+// frontend is an instance which students connect to through RDP, either a
+// VirtualBox VM or a Docker container
+type frontend interface {
+	virtual.Instance
+	virtual.ResourceResizer
+	Snapshot(string) error
+	RestoreSnapshot(string) error
 }
 
 type frontendConf struct {
-	vm   vbox.VM
+	vm   frontend
 	conf store.InstanceConfig
 }
 
-func (l *lab) addFrontend(ctx context.Context, conf store.InstanceConfig, rdpPort uint) (vbox.VM, error) {
-	hostIp, err := l.dockerHost.GetDockerHostIP()
-	if err != nil {
-		return nil, err
+func (l *lab) addFrontend(ctx context.Context, conf store.InstanceConfig, rdpPort uint) (frontend, error) {
+	hostIp := l.rdpHost
+	if hostIp == "" {
+		var err error
+		hostIp, err = l.dockerHost.GetDockerHostIP()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.IsContainer() {
+		f := newContainerFrontend(conf, l.environment.Network(), hostIp, rdpPort)
+		if err := f.Create(ctx); err != nil {
+			return nil, err
+		}
+
+		l.setFrontend(rdpPort, frontendConf{
+			vm:   f,
+			conf: conf,
+		})
+
+		log.Debug().Msgf("Created lab container frontend on port %d", rdpPort)
+
+		return f, nil
 	}
 
 	vm, err := l.lib.GetCopy(
@@ -109,29 +209,70 @@ func (l *lab) addFrontend(ctx context.Context, conf store.InstanceConfig, rdpPor
 		conf,
 		vbox.SetBridge(l.environment.NetworkInterface()),
 		vbox.SetLocalRDP(hostIp, rdpPort),
-		vbox.SetRAM(conf.MemoryMB),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	l.frontends[rdpPort] = frontendConf{
+	l.setFrontend(rdpPort, frontendConf{
 		vm:   vm,
 		conf: conf,
-	}
+	})
 
 	log.Debug().Msgf("Created lab frontend on port %d", rdpPort)
 
 	return vm, nil
 }
 
+func (l *lab) setFrontend(port uint, fconf frontendConf) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.frontends[port] = fconf
+}
+
+// getFrontends returns a copy of the frontends, so they can be operated on
+// without holding the lock
+func (l *lab) getFrontends() map[uint]frontendConf {
+	l.m.RLock()
+	defer l.m.RUnlock()
+
+	frontends := make(map[uint]frontendConf, len(l.frontends))
+	for p, fconf := range l.frontends {
+		frontends[p] = fconf
+	}
+
+	return frontends
+}
+
+// working marks the lab as busy until the returned function is called
+func (l *lab) working() func() {
+	atomic.AddInt32(&l.busy, 1)
+	return func() {
+		atomic.AddInt32(&l.busy, -1)
+	}
+}
+
+// Busy reports whether instances of the lab are being stopped on purpose,
+// e.g. while resetting or resizing its frontends
+func (l *lab) Busy() bool {
+	return atomic.LoadInt32(&l.busy) > 0
+}
+
 func (l *lab) Environment() exercise.Environment {
 	return l.environment
 }
 
 func (l *lab) ResetFrontends(ctx context.Context) error {
+	defer l.working()()
+
+	// the new frontends are cloned from their origin, without snapshots
+	l.m.Lock()
+	l.snapshots = nil
+	l.m.Unlock()
+
 	var errs []error
-	for p, vmConf := range l.frontends {
+	for p, vmConf := range l.getFrontends() {
 		err := vmConf.vm.Close()
 		if err != nil {
 			errs = append(errs, err)
@@ -158,11 +299,124 @@ func (l *lab) ResetFrontends(ctx context.Context) error {
 	return nil
 }
 
+// ResizeFrontends applies the memory and CPU of the configuration to every
+// frontend of the same image, each frontend is power cycled once. It returns
+// the number of frontends resized.
+func (l *lab) ResizeFrontends(ctx context.Context, conf store.InstanceConfig) (int, error) {
+	defer l.working()()
+
+	var n int
+	for p, fconf := range l.getFrontends() {
+		if fconf.conf.Image != conf.Image {
+			continue
+		}
+
+		if err := fconf.vm.Stop(); err != nil {
+			log.Debug().Err(err).Msg("Unable to stop frontend before resize")
+		}
+
+		var err error
+		if conf.MemoryMB != 0 {
+			err = fconf.vm.SetRAM(conf.MemoryMB)
+		}
+
+		if err == nil && conf.CPU != 0 {
+			err = fconf.vm.SetCPU(conf.CPU)
+		}
+
+		// the frontend is started again regardless, so students are not
+		// left without a frontend
+		if startErr := fconf.vm.Start(ctx); startErr != nil && err == nil {
+			err = startErr
+		}
+
+		if err != nil {
+			return n, err
+		}
+
+		if conf.MemoryMB != 0 {
+			fconf.conf.MemoryMB = conf.MemoryMB
+		}
+
+		if conf.CPU != 0 {
+			fconf.conf.CPU = conf.CPU
+		}
+
+		l.setFrontend(p, fconf)
+		n += 1
+	}
+
+	return n, nil
+}
+
+// Snapshot takes a named snapshot of the frontends and the exercises of
+// the lab, which can be restored until the lab or its frontends are reset.
+func (l *lab) Snapshot(ctx context.Context, name string) error {
+	if !snapshotNameRegex.MatchString(name) {
+		return InvalidSnapshotNameErr
+	}
+
+	if l.hasSnapshot(name) {
+		return DuplicateSnapshotErr
+	}
+
+	for _, fconf := range l.getFrontends() {
+		if err := fconf.vm.Snapshot(name); err != nil {
+			return err
+		}
+	}
+
+	if err := l.environment.Snapshot(ctx, name); err != nil {
+		return err
+	}
+
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	if l.snapshots == nil {
+		l.snapshots = map[string]struct{}{}
+	}
+	l.snapshots[name] = struct{}{}
+
+	return nil
+}
+
+func (l *lab) hasSnapshot(name string) bool {
+	l.m.RLock()
+	defer l.m.RUnlock()
+
+	_, ok := l.snapshots[name]
+	return ok
+}
+
+// Restore restores the exercises before the frontends, as the snapshots of
+// exercises are lost when they are reset and the environment checks all of
+// them before restoring any.
+func (l *lab) Restore(ctx context.Context, name string) error {
+	if !l.hasSnapshot(name) {
+		return UnknownSnapshotErr
+	}
+
+	defer l.working()()
+
+	if err := l.environment.Restore(ctx, name); err != nil {
+		return err
+	}
+
+	for _, fconf := range l.getFrontends() {
+		if err := fconf.vm.RestoreSnapshot(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (l *lab) Start(ctx context.Context) error {
 	if err := l.environment.Start(ctx); err != nil {
 		return err
 	}
-	for _, fconf := range l.frontends {
+	for _, fconf := range l.getFrontends() {
 		if err := fconf.vm.Start(ctx); err != nil {
 			return err
 		}
@@ -171,11 +425,13 @@ func (l *lab) Start(ctx context.Context) error {
 }
 
 func (l *lab) Stop() error {
+	defer l.working()()
+
 	if err := l.environment.Stop(); err != nil {
 		return err
 	}
 
-	for _, fconf := range l.frontends {
+	for _, fconf := range l.getFrontends() {
 		if err := fconf.vm.Stop(); err != nil {
 			return err
 		}
@@ -185,6 +441,8 @@ func (l *lab) Stop() error {
 }
 
 func (l *lab) Restart(ctx context.Context) error {
+	defer l.working()()
+
 	if err := l.environment.Stop(); err != nil {
 		return err
 	}
@@ -193,7 +451,7 @@ func (l *lab) Restart(ctx context.Context) error {
 		return err
 	}
 
-	for _, fconf := range l.frontends {
+	for _, fconf := range l.getFrontends() {
 		if err := fconf.vm.Stop(); err != nil {
 			return err
 		}
@@ -206,12 +464,29 @@ func (l *lab) Restart(ctx context.Context) error {
 	return nil
 }
 
+func (l *lab) RestartInstance(ctx context.Context, id string) error {
+	for _, fconf := range l.getFrontends() {
+		if fconf.vm.Info().Id != id {
+			continue
+		}
+
+		// the frontend might have crashed already
+		if err := fconf.vm.Stop(); err != nil {
+			log.Debug().Err(err).Str("id", id).Msg("Unable to stop frontend before restart")
+		}
+
+		return fconf.vm.Start(ctx)
+	}
+
+	return l.environment.RestartInstance(ctx, id)
+}
+
 func (l *lab) Close() error {
 	var wg sync.WaitGroup
 
-	for _, lab := range l.frontends {
+	for _, lab := range l.getFrontends() {
 		wg.Add(1)
-		go func(vm vbox.VM) {
+		go func(vm frontend) {
 			// closing VMs....
 			defer wg.Done()
 			if err := vm.Close(); err != nil {
@@ -233,6 +508,9 @@ func (l *lab) Close() error {
 }
 
 func (l *lab) RdpConnPorts() []uint {
+	l.m.RLock()
+	defer l.m.RUnlock()
+
 	var ports []uint
 	for p, _ := range l.frontends {
 		ports = append(ports, p)
@@ -241,13 +519,19 @@ func (l *lab) RdpConnPorts() []uint {
 	return ports
 }
 
+// RdpHost returns the address frontends serve RDP on, which is empty when
+// served on the Docker host
+func (l *lab) RdpHost() string {
+	return l.rdpHost
+}
+
 func (l *lab) Tag() string {
 	return l.tag
 }
 
 func (l *lab) InstanceInfo() []virtual.InstanceInfo {
 	var instances []virtual.InstanceInfo
-	for _, fconf := range l.frontends {
+	for _, fconf := range l.getFrontends() {
 		instances = append(instances, fconf.vm.Info())
 	}
 	instances = append(instances, l.environment.InstanceInfo()...)
@@ -6,10 +6,12 @@ package lab
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/aau-network-security/haaukins/exercise"
 	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
 )
@@ -58,3 +60,237 @@ func TestAddFrontend(t *testing.T) {
 		t.Fatalf("Expected %d frontend, but is %d", len(lab.frontends), 1)
 	}
 }
+
+type resizableVM struct {
+	events []string
+	vbox.VM
+}
+
+func (vm *resizableVM) Start(context.Context) error {
+	vm.events = append(vm.events, "start")
+	return nil
+}
+
+func (vm *resizableVM) Stop() error {
+	vm.events = append(vm.events, "stop")
+	return nil
+}
+
+func (vm *resizableVM) SetRAM(uint) error {
+	vm.events = append(vm.events, "ram")
+	return nil
+}
+
+func (vm *resizableVM) SetCPU(float64) error {
+	vm.events = append(vm.events, "cpu")
+	return nil
+}
+
+func TestResizeFrontends(t *testing.T) {
+	tt := []struct {
+		name     string
+		conf     store.InstanceConfig
+		resized  int
+		expected []string
+	}{
+		{name: "Memory and cpu", conf: store.InstanceConfig{Image: "kali", MemoryMB: 4096, CPU: 1.5}, resized: 1, expected: []string{"stop", "ram", "cpu", "start"}},
+		{name: "Memory only", conf: store.InstanceConfig{Image: "kali", MemoryMB: 4096}, resized: 1, expected: []string{"stop", "ram", "start"}},
+		{name: "Other image", conf: store.InstanceConfig{Image: "windows", MemoryMB: 4096}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			vm := &resizableVM{}
+			l := lab{
+				frontends: map[uint]frontendConf{
+					5000: {vm: vm, conf: store.InstanceConfig{Image: "kali", MemoryMB: 2048, CPU: 1}},
+				},
+			}
+
+			n, err := l.ResizeFrontends(context.Background(), tc.conf)
+			if l.Busy() {
+				t.Fatalf("expected lab not to be busy after resize")
+			}
+			if err != nil {
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+
+			if n != tc.resized {
+				t.Fatalf("expected %d resized frontends, but received: %d", tc.resized, n)
+			}
+
+			if strings.Join(vm.events, ",") != strings.Join(tc.expected, ",") {
+				t.Fatalf("expected %v, but received: %v", tc.expected, vm.events)
+			}
+
+			conf := l.frontends[5000].conf
+			if tc.resized > 0 && conf.MemoryMB != tc.conf.MemoryMB {
+				t.Fatalf("expected frontend configuration to be updated, but received: %+v", conf)
+			}
+		})
+	}
+}
+
+type snapshotVM struct {
+	snapshots []string
+	restored  []string
+	vbox.VM
+}
+
+func (vm *snapshotVM) Snapshot(name string) error {
+	vm.snapshots = append(vm.snapshots, name)
+	return nil
+}
+
+func (vm *snapshotVM) RestoreSnapshot(name string) error {
+	vm.restored = append(vm.restored, name)
+	return nil
+}
+
+type snapshotEnvironment struct {
+	snapshots  []string
+	restored   []string
+	restoreErr error
+	exercise.Environment
+}
+
+func (ee *snapshotEnvironment) Snapshot(ctx context.Context, name string) error {
+	ee.snapshots = append(ee.snapshots, name)
+	return nil
+}
+
+func (ee *snapshotEnvironment) Restore(ctx context.Context, name string) error {
+	if ee.restoreErr != nil {
+		return ee.restoreErr
+	}
+
+	ee.restored = append(ee.restored, name)
+	return nil
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	tt := []struct {
+		name        string
+		snapshots   []string
+		restore     string
+		snapshotErr error
+		envErr      error
+		restoreErr  error
+	}{
+		{name: "Normal", snapshots: []string{"before-exploit"}, restore: "before-exploit"},
+		{name: "Multiple", snapshots: []string{"first", "second"}, restore: "first"},
+		{name: "Invalid name", snapshots: []string{"../origin"}, snapshotErr: InvalidSnapshotNameErr},
+		{name: "Duplicate", snapshots: []string{"first", "first"}, snapshotErr: DuplicateSnapshotErr},
+		{name: "Unknown", snapshots: []string{"first"}, restore: "second", restoreErr: UnknownSnapshotErr},
+		{name: "Exercise reset", snapshots: []string{"first"}, restore: "first", envErr: exercise.UnknownSnapshotErr, restoreErr: exercise.UnknownSnapshotErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			vm := &snapshotVM{}
+			env := &snapshotEnvironment{restoreErr: tc.envErr}
+			l := lab{
+				environment: env,
+				frontends: map[uint]frontendConf{
+					5000: {vm: vm},
+				},
+			}
+
+			var err error
+			for _, name := range tc.snapshots {
+				if err = l.Snapshot(context.Background(), name); err != nil {
+					break
+				}
+			}
+
+			if err != tc.snapshotErr {
+				t.Fatalf("expected snapshot error (%v), but received: %v", tc.snapshotErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if len(vm.snapshots) != len(tc.snapshots) || len(env.snapshots) != len(tc.snapshots) {
+				t.Fatalf("expected %d snapshots, but received: %v (frontend) %v (environment)", len(tc.snapshots), vm.snapshots, env.snapshots)
+			}
+
+			err = l.Restore(context.Background(), tc.restore)
+			if err != tc.restoreErr {
+				t.Fatalf("expected restore error (%v), but received: %v", tc.restoreErr, err)
+			}
+
+			if err != nil {
+				if len(vm.restored) != 0 {
+					t.Fatalf("expected frontends not to be restored on error, but received: %v", vm.restored)
+				}
+				return
+			}
+
+			if len(vm.restored) != 1 || vm.restored[0] != tc.restore {
+				t.Fatalf("expected frontend to be restored to %s, but received: %v", tc.restore, vm.restored)
+			}
+
+			if len(env.restored) != 1 || env.restored[0] != tc.restore {
+				t.Fatalf("expected environment to be restored to %s, but received: %v", tc.restore, env.restored)
+			}
+		})
+	}
+}
+
+type infoVM struct {
+	vbox.VM
+}
+
+func (vm *infoVM) Close() error {
+	return nil
+}
+
+func (vm *infoVM) Start(context.Context) error {
+	return nil
+}
+
+func (vm *infoVM) Info() virtual.InstanceInfo {
+	return virtual.InstanceInfo{Image: "kali", Type: "vbox", State: virtual.Running}
+}
+
+func (ee *testEnvironment) InstanceInfo() []virtual.InstanceInfo {
+	return nil
+}
+
+func TestResetFrontendsWhileInspected(t *testing.T) {
+	l := &lab{
+		dockerHost:  &testDockerHost{},
+		lib:         &testVboxLibrary{vm: &infoVM{}},
+		environment: &testEnvironment{},
+		frontends: map[uint]frontendConf{
+			5000: {vm: &infoVM{}},
+			5001: {vm: &infoVM{}},
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			if err := l.ResetFrontends(context.Background()); err != nil {
+				t.Errorf("expected no error, but received: %s", err)
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			if l.Busy() {
+				t.Fatalf("expected lab not to be busy after reset")
+			}
+			return
+		default:
+			if n := len(l.InstanceInfo()); n != 2 {
+				t.Fatalf("expected 2 instances, but received: %d", n)
+			}
+		}
+	}
+}
@@ -44,10 +44,23 @@ type pool struct {
 
 type logConfig struct {
 	writeStdErr bool
+	maxSize     int64
+	maxBackups  int
 }
 
 type loggingOpts func(*logConfig) error
 
+// WithRotation compresses the log file once it exceeds maxSize bytes,
+// keeping at most maxBackups of the compressed files. The file is never
+// rotated if maxSize is zero.
+func WithRotation(maxSize int64, maxBackups int) loggingOpts {
+	return func(conf *logConfig) error {
+		conf.maxSize = maxSize
+		conf.maxBackups = maxBackups
+		return nil
+	}
+}
+
 func (lp *pool) GetLogger(name string, opts ...loggingOpts) (*zerolog.Logger, error) {
 	lp.m.Lock()
 	defer lp.m.Unlock()
@@ -67,7 +80,13 @@ func (lp *pool) GetLogger(name string, opts ...loggingOpts) (*zerolog.Logger, er
 	var w io.Writer
 
 	path := filepath.Join(lp.dir, name+".log")
-	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	var f io.WriteCloser
+	var err error
+	if conf.maxSize > 0 {
+		f, err = openRotatingFile(path, conf.maxSize, conf.maxBackups)
+	} else {
+		f, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	}
 	if err != nil {
 		return nil, err
 	}
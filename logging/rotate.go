@@ -0,0 +1,242 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package logging
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const backupTimeFormat = "20060102T150405.000000000"
+
+// rotatingFile is a log file which is compressed and replaced by an empty
+// file once it exceeds its maximum size, keeping at most maxBackups of the
+// compressed files.
+type rotatingFile struct {
+	m          sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	f          *os.File
+	size       int64
+}
+
+func openRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	rf := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+
+	return rf, nil
+}
+
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	rf.f = f
+	rf.size = info.Size()
+
+	return nil
+}
+
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.m.Lock()
+	defer rf.m.Unlock()
+
+	if rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rf.f.Write(p)
+	rf.size += int64(n)
+
+	return n, err
+}
+
+// rotate replaces the file by an empty one, if the file cannot be backed up
+// it is kept so no lines are lost
+func (rf *rotatingFile) rotate() error {
+	if err := rf.f.Close(); err != nil {
+		return err
+	}
+
+	if err := rf.backup(); err != nil {
+		log.Warn().
+			Err(err).
+			Str("path", rf.path).
+			Msg("Unable to rotate log file")
+	}
+
+	return rf.open()
+}
+
+func (rf *rotatingFile) backup() error {
+	if err := compressFile(rf.path, rf.backupPath()); err != nil {
+		return err
+	}
+
+	if err := os.Remove(rf.path); err != nil {
+		return err
+	}
+
+	backups, err := Backups(rf.path)
+	if err != nil {
+		return err
+	}
+
+	for len(backups) > rf.maxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+
+	return nil
+}
+
+// backupPath names backups by the time of rotation, which orders them
+// when sorted
+func (rf *rotatingFile) backupPath() string {
+	base := strings.TrimSuffix(rf.path, ".log")
+	t := time.Now().UTC()
+	for {
+		path := base + "-" + t.Format(backupTimeFormat) + ".log.gz"
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return path
+		}
+		t = t.Add(time.Nanosecond)
+	}
+}
+
+func (rf *rotatingFile) Close() error {
+	rf.m.Lock()
+	defer rf.m.Unlock()
+
+	return rf.f.Close()
+}
+
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(out)
+	_, err = io.Copy(zw, in)
+	if err == nil {
+		err = zw.Close()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+
+	// a partial backup would break reading the log
+	if err != nil {
+		os.Remove(dst)
+	}
+
+	return err
+}
+
+// Backups returns the compressed backups of a rotated log file, the
+// oldest first.
+func Backups(path string) ([]string, error) {
+	base := strings.TrimSuffix(path, ".log")
+	matches, err := filepath.Glob(base + "-*.log.gz")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(matches)
+
+	return matches, nil
+}
+
+type multiReadCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (mrc *multiReadCloser) Close() error {
+	var errs error
+	for _, c := range mrc.closers {
+		if err := c.Close(); err != nil && errs == nil {
+			errs = err
+		}
+	}
+
+	return errs
+}
+
+// OpenLog reads a log file including its compressed backups, in the order
+// the lines were written.
+func OpenLog(path string) (io.ReadCloser, error) {
+	backups, err := Backups(path)
+	if err != nil {
+		return nil, err
+	}
+
+	mrc := &multiReadCloser{}
+	var readers []io.Reader
+	for _, b := range backups {
+		f, err := os.Open(b)
+		if err != nil {
+			mrc.Close()
+			return nil, err
+		}
+		mrc.closers = append(mrc.closers, f)
+
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			mrc.Close()
+			return nil, err
+		}
+		readers = append(readers, zr)
+	}
+
+	f, err := os.Open(path)
+	switch {
+	case err == nil:
+		mrc.closers = append(mrc.closers, f)
+		readers = append(readers, f)
+	case !os.IsNotExist(err):
+		mrc.Close()
+		return nil, err
+	}
+
+	mrc.Reader = io.MultiReader(readers...)
+
+	return mrc, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package logging
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFile(t *testing.T) {
+	tt := []struct {
+		name       string
+		lines      int
+		maxBackups int
+		backups    int
+		first      int
+	}{
+		{name: "No rotation", lines: 2, maxBackups: 2},
+		{name: "Rotated", lines: 5, maxBackups: 2, backups: 2},
+		{name: "Backups removed", lines: 10, maxBackups: 2, backups: 2, first: 4},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "logging")
+			if err != nil {
+				t.Fatalf("unable to create temp dir: %s", err)
+			}
+			defer os.RemoveAll(dir)
+
+			// every line is 7 bytes, so each file holds two lines
+			path := filepath.Join(dir, "audit.log")
+			rf, err := openRotatingFile(path, 14, tc.maxBackups)
+			if err != nil {
+				t.Fatalf("unable to open file: %s", err)
+			}
+
+			for i := 0; i < tc.lines; i++ {
+				if _, err := fmt.Fprintf(rf, "line-%d\n", i); err != nil {
+					t.Fatalf("unable to write line: %s", err)
+				}
+			}
+
+			if err := rf.Close(); err != nil {
+				t.Fatalf("unable to close file: %s", err)
+			}
+
+			backups, err := Backups(path)
+			if err != nil {
+				t.Fatalf("unable to list backups: %s", err)
+			}
+
+			if n := len(backups); n != tc.backups {
+				t.Fatalf("expected %d backups, but got: %d", tc.backups, n)
+			}
+
+			r, err := OpenLog(path)
+			if err != nil {
+				t.Fatalf("unable to open log: %s", err)
+			}
+			defer r.Close()
+
+			i := tc.first
+			scanner := bufio.NewScanner(r)
+			for scanner.Scan() {
+				if expected := fmt.Sprintf("line-%d", i); scanner.Text() != expected {
+					t.Fatalf("expected line %s, but got: %s", expected, scanner.Text())
+				}
+				i++
+			}
+
+			if i != tc.lines {
+				t.Fatalf("expected lines up to %d, but got: %d", tc.lines, i)
+			}
+		})
+	}
+}
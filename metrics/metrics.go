@@ -0,0 +1,272 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+	LabBuckets     = []float64{5, 10, 30, 60, 120, 300, 600, 1200}
+
+	FlagSubmissions = NewCounter(
+		"haaukins_flag_submissions_total",
+		"Number of flags submitted to CTFd by result.",
+		"result",
+	)
+	LabCreationDuration = NewHistogram(
+		"haaukins_lab_creation_duration_seconds",
+		"Time taken to create and start a lab.",
+		LabBuckets,
+	)
+	GrpcDuration = NewHistogram(
+		"haaukins_grpc_request_duration_seconds",
+		"Latency of gRPC calls handled by the daemon.",
+		DefaultBuckets,
+		"method", "code",
+	)
+)
+
+// Collector writes its samples in the Prometheus text format
+type Collector interface {
+	Collect(io.Writer)
+}
+
+type Registry struct {
+	m          sync.Mutex
+	collectors []Collector
+}
+
+func NewRegistry(collectors ...Collector) *Registry {
+	return &Registry{collectors: collectors}
+}
+
+func (r *Registry) Register(c ...Collector) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.collectors = append(r.collectors, c...)
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.m.Lock()
+	collectors := make([]Collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.m.Unlock()
+
+	w.Header().Set("Content-Type", contentType)
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.Collect(bw)
+	}
+	bw.Flush()
+}
+
+type metric struct {
+	name   string
+	help   string
+	labels []string
+}
+
+func (m metric) header(w io.Writer, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", m.name, m.help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", m.name, kind)
+}
+
+// key joins the label values, as they are used to identify a series
+func (m metric) key(values []string) string {
+	if len(values) != len(m.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values, received %d", m.name, len(m.labels), len(values)))
+	}
+
+	return strings.Join(values, "\xff")
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labelValue quotes a label value as done by the text format, which
+// only escapes backslashes, double quotes and newlines
+func labelValue(v string) string {
+	return `"` + labelEscaper.Replace(v) + `"`
+}
+
+func (m metric) labelPairs(key string, extra ...string) string {
+	var pairs []string
+	if len(m.labels) > 0 {
+		for i, v := range strings.Split(key, "\xff") {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", m.labels[i], labelValue(v)))
+		}
+	}
+
+	for i := 0; i+1 < len(extra); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", extra[i], labelValue(extra[i+1])))
+	}
+
+	if len(pairs) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func sortedKeys(m map[string]float64) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+type Counter struct {
+	metric
+	m      sync.Mutex
+	values map[string]float64
+}
+
+func NewCounter(name, help string, labels ...string) *Counter {
+	return &Counter{
+		metric: metric{name, help, labels},
+		values: map[string]float64{},
+	}
+}
+
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+func (c *Counter) Add(v float64, labelValues ...string) {
+	k := c.key(labelValues)
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.values[k] += v
+}
+
+func (c *Counter) Get(labelValues ...string) float64 {
+	k := c.key(labelValues)
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return c.values[k]
+}
+
+func (c *Counter) Collect(w io.Writer) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.header(w, "counter")
+	for _, k := range sortedKeys(c.values) {
+		fmt.Fprintf(w, "%s%s %s\n", c.name, c.labelPairs(k), formatFloat(c.values[k]))
+	}
+}
+
+type histogramValue struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+type Histogram struct {
+	metric
+	m       sync.Mutex
+	buckets []float64
+	values  map[string]*histogramValue
+}
+
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	return &Histogram{
+		metric:  metric{name, help, labels},
+		buckets: buckets,
+		values:  map[string]*histogramValue{},
+	}
+}
+
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	k := h.key(labelValues)
+
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	hv, ok := h.values[k]
+	if !ok {
+		hv = &histogramValue{counts: make([]uint64, len(h.buckets))}
+		h.values[k] = hv
+	}
+
+	for i, upper := range h.buckets {
+		if v <= upper {
+			hv.counts[i] += 1
+		}
+	}
+	hv.count += 1
+	hv.sum += v
+}
+
+func (h *Histogram) Collect(w io.Writer) {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	var keys []string
+	for k := range h.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h.header(w, "histogram")
+	for _, k := range keys {
+		hv := h.values[k]
+		for i, upper := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(k, "le", formatFloat(upper)), hv.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(k, "le", "+Inf"), hv.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, h.labelPairs(k), formatFloat(hv.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, h.labelPairs(k), hv.count)
+	}
+}
+
+// GaugeFunc reports gauges which are computed every time they are
+// collected, the collect function sets the value of each series.
+type GaugeFunc struct {
+	metric
+	collect func(set func(v float64, labelValues ...string))
+}
+
+func NewGaugeFunc(name, help string, collect func(set func(float64, ...string)), labels ...string) *GaugeFunc {
+	return &GaugeFunc{
+		metric:  metric{name, help, labels},
+		collect: collect,
+	}
+}
+
+func (g *GaugeFunc) Collect(w io.Writer) {
+	values := map[string]float64{}
+	g.collect(func(v float64, labelValues ...string) {
+		values[g.key(labelValues)] += v
+	})
+
+	g.header(w, "gauge")
+	for _, k := range sortedKeys(values) {
+		fmt.Fprintf(w, "%s%s %s\n", g.name, g.labelPairs(k), formatFloat(values[k]))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/metrics"
+)
+
+func TestRegistry(t *testing.T) {
+	c := metrics.NewCounter("test_submissions_total", "Submissions.", "result")
+	c.Inc("correct")
+	c.Inc("incorrect")
+	c.Add(2, "correct")
+
+	h := metrics.NewHistogram("test_duration_seconds", "Durations.", []float64{1, 5})
+	h.Observe(0.5)
+	h.Observe(3)
+	h.Observe(10)
+
+	g := metrics.NewGaugeFunc("test_instances", "Instances.", func(set func(float64, ...string)) {
+		set(1, "a", "running")
+		set(1, "a", "running")
+		set(1, "b", "stopped")
+		set(1, "c\\\"é\t\n", "running")
+	}, "event", "state")
+
+	reg := metrics.NewRegistry(c, h)
+	reg.Register(g)
+
+	w := httptest.NewRecorder()
+	reg.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body := w.Body.String()
+	expected := []string{
+		"# TYPE test_submissions_total counter",
+		`test_submissions_total{result="correct"} 3`,
+		`test_submissions_total{result="incorrect"} 1`,
+		"# TYPE test_duration_seconds histogram",
+		`test_duration_seconds_bucket{le="1"} 1`,
+		`test_duration_seconds_bucket{le="5"} 2`,
+		`test_duration_seconds_bucket{le="+Inf"} 3`,
+		"test_duration_seconds_sum 13.5",
+		"test_duration_seconds_count 3",
+		"# TYPE test_instances gauge",
+		`test_instances{event="a",state="running"} 2`,
+		`test_instances{event="b",state="stopped"} 1`,
+		"test_instances{event=\"c\\\\\\\"é\t\\n\",state=\"running\"} 1",
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(body, e+"\n") {
+			t.Fatalf("expected metrics to contain \"%s\", but received:\n%s", e, body)
+		}
+	}
+
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Fatalf("expected text content type, but received: %s", ct)
+	}
+}
+
+func TestCounterLabels(t *testing.T) {
+	c := metrics.NewCounter("test_total", "Test.", "a", "b")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic when using wrong amount of label values")
+		}
+	}()
+
+	c.Inc("only-one")
+}
@@ -10,13 +10,16 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
 	"crypto/sha256"
+	"crypto/subtle"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/yaml.v2"
 )
 
@@ -27,17 +30,34 @@ var (
 	NoFrontendErr       = errors.New("lab requires at least one frontend")
 	InvalidFlagValueErr = errors.New("Incorrect value for flag")
 	UnknownChallengeErr = errors.New("Unknown challenge")
+	HintUnlockedErr     = errors.New("Hint has already been unlocked")
+
+	legacyHashRegex = regexp.MustCompile(`^[a-f0-9]{64}$`)
 )
 
 type EventConfig struct {
-	Name       string     `yaml:"name"`
-	Tag        Tag        `yaml:"tag"`
-	Available  int        `yaml:"available"`
-	Capacity   int        `yaml:"capacity"`
-	Lab        Lab        `yaml:"lab"`
-	StartedAt  *time.Time `yaml:"started-at,omitempty"`
-	FinishExpected  *time.Time `yaml:"finish-req,omitempty"`
-	FinishedAt *time.Time `yaml:"finished-at,omitempty"`
+	Name           string           `yaml:"name"`
+	Tag            Tag              `yaml:"tag"`
+	Available      int              `yaml:"available"`
+	Capacity       int              `yaml:"capacity"`
+	Lab            Lab              `yaml:"lab"`
+	StartAt        *time.Time       `yaml:"start-at,omitempty"`
+	StartedAt      *time.Time       `yaml:"started-at,omitempty"`
+	FinishExpected *time.Time       `yaml:"finish-req,omitempty"`
+	FinishedAt     *time.Time       `yaml:"finished-at,omitempty"`
+	CreatedBy      string           `yaml:"created-by,omitempty"`
+	Suspended      bool             `yaml:"suspended,omitempty"`
+	Scoring        ScoringConfig    `yaml:"scoring,omitempty"`
+	FlagFormat     FlagFormat       `yaml:"flag-format,omitempty"`
+	Submissions    SubmissionConfig `yaml:"submissions,omitempty"`
+
+	// Flags are the flags of the exercises in the event, which are kept
+	// as the exercises may change after the event has finished
+	Flags []FlagConfig `yaml:"flags,omitempty"`
+
+	// SubmissionKey hashes the flags submitted by teams without consent,
+	// it is left out of the archive so the hashes cannot be reversed
+	SubmissionKey string `yaml:"submission-key,omitempty"`
 }
 
 type RawEventFile struct {
@@ -62,7 +82,11 @@ func (e EventConfig) Validate() error {
 		return &EmptyVarErr{Var: "Frontends", Type: "Event"}
 	}
 
-	return nil
+	if err := e.FlagFormat.Validate(); err != nil {
+		return err
+	}
+
+	return e.Scoring.Validate()
 }
 
 type Lab struct {
@@ -77,12 +101,22 @@ type Challenge struct {
 	CompletedAt *time.Time `yaml:"completed-at,omitempty"`
 }
 
+type UnlockedHint struct {
+	FlagTag    Tag        `yaml:"tag"`
+	Index      int        `yaml:"index"`
+	Cost       uint       `yaml:"cost,omitempty"`
+	UnlockedAt *time.Time `yaml:"unlocked-at,omitempty"`
+}
+
 type Team struct {
 	Id               string            `yaml:"id"`
 	Email            string            `yaml:"email"`
 	Name             string            `yaml:"name"`
 	HashedPassword   string            `yaml:"hashed-password"`
+	CTFdPassword     string            `yaml:"ctfd-password,omitempty"`
+	GuacPassword     string            `yaml:"guac-password,omitempty"`
 	SolvedChallenges []Challenge       `yaml:"solved-challenges,omitempty"`
+	UnlockedHints    []UnlockedHint    `yaml:"unlocked-hints,omitempty"`
 	Metadata         map[string]string `yaml:"metadata,omitempty"`
 	CreatedAt        *time.Time        `yaml:"created-at,omitempty"`
 	ChalMap          map[Tag]Challenge `yaml:"-"`
@@ -92,16 +126,18 @@ type Team struct {
 func NewTeam(email, name, password string, chals ...Challenge) Team {
 	now := time.Now()
 
-	hashedPassword := fmt.Sprintf("%x", sha256.Sum256([]byte(password)))
 	email = strings.ToLower(email)
 
 	t := Team{
-		Id:             uuid.New().String()[0:8],
-		Email:          email,
-		Name:           name,
-		HashedPassword: hashedPassword,
-		CreatedAt:      &now,
-		AccessedAt:     nil,
+		Id:           uuid.New().String()[0:8],
+		Email:        email,
+		Name:         name,
+		CTFdPassword: uuid.New().String(),
+		CreatedAt:    &now,
+		AccessedAt:   nil,
+	}
+	if err := t.SetPassword(password); err != nil {
+		log.Error().Msgf("Error on hashing password for team %s: %s", t.Name, err)
 	}
 	for _, chal := range chals {
 		t.AddChallenge(chal)
@@ -112,6 +148,32 @@ func NewTeam(email, name, password string, chals ...Challenge) Team {
 	return t
 }
 
+// SetPassword stores a salted bcrypt hash of the given password.
+func (t *Team) SetPassword(password string) error {
+	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	t.HashedPassword = string(hashedBytes)
+	return nil
+}
+
+// HasLegacyPassword reports whether the password of the team is still
+// stored as an unsalted SHA-256 hash.
+func (t Team) HasLegacyPassword() bool {
+	return legacyHashRegex.MatchString(t.HashedPassword)
+}
+
+func (t Team) IsCorrectPassword(pass string) bool {
+	if t.HasLegacyPassword() {
+		hashed := fmt.Sprintf("%x", sha256.Sum256([]byte(pass)))
+		return subtle.ConstantTimeCompare([]byte(hashed), []byte(t.HashedPassword)) == 1
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(t.HashedPassword), []byte(pass)) == nil
+}
+
 func (t *Team) IsCorrectFlag(tag Tag, v string) error {
 	c, ok := t.ChalMap[tag]
 	if !ok {
@@ -141,6 +203,36 @@ func (t *Team) SolveChallenge(tag Tag, v string) error {
 	return nil
 }
 
+// UnlockHint records that the team has unlocked the hint with the given
+// index of a challenge, for which the team is penalised the cost.
+func (t *Team) UnlockHint(tag Tag, index int, cost uint) error {
+	for _, h := range t.UnlockedHints {
+		if h.FlagTag == tag && h.Index == index {
+			return HintUnlockedErr
+		}
+	}
+
+	now := time.Now()
+	t.UnlockedHints = append(t.UnlockedHints, UnlockedHint{
+		FlagTag:    tag,
+		Index:      index,
+		Cost:       cost,
+		UnlockedAt: &now,
+	})
+
+	return nil
+}
+
+// HintCosts returns the total cost of the hints unlocked per challenge
+func (t Team) HintCosts() map[Tag]uint {
+	costs := map[Tag]uint{}
+	for _, h := range t.UnlockedHints {
+		costs[h.FlagTag] += h.Cost
+	}
+
+	return costs
+}
+
 func (t *Team) AddMetadata(key, value string) {
 	if t.Metadata == nil {
 		t.Metadata = map[string]string{}
@@ -190,6 +282,7 @@ type TeamStore interface {
 	GetTeamByName(string) (Team, error)
 	GetTeams() []Team
 	SaveTeam(Team) error
+	DeleteTeam(string) error
 	UpdateTeamAccessed(string, time.Time) (Team, error)
 	CreateTokenForTeam(string, Team) error
 	DeleteToken(string) error
@@ -198,11 +291,13 @@ type TeamStore interface {
 type teamstore struct {
 	m sync.RWMutex
 
-	hooks  []func([]Team) error
-	teams  map[string]Team
-	tokens map[string]string
-	emails map[string]string
-	names  map[string]string
+	hooks       []func([]Team) error
+	teamHooks   []func(Team) error
+	deleteHooks []func(Team) error
+	teams       map[string]Team
+	tokens      map[string]string
+	emails      map[string]string
+	names       map[string]string
 }
 
 type TeamStoreOpt func(ts *teamstore)
@@ -210,9 +305,9 @@ type TeamStoreOpt func(ts *teamstore)
 func WithTeams(teams []Team) func(ts *teamstore) {
 	return func(ts *teamstore) {
 		for _, t := range teams {
-		if err:=ts.CreateTeam(t); err !=nil {
-			log.Error().Msgf("Error on creating team %s",err)
-		}
+			if err := ts.CreateTeam(t); err != nil {
+				log.Error().Msgf("Error on creating team %s", err)
+			}
 		}
 	}
 }
@@ -223,6 +318,18 @@ func WithPostTeamHook(hook func(teams []Team) error) func(ts *teamstore) {
 	}
 }
 
+func WithTeamHook(hook func(team Team) error) func(ts *teamstore) {
+	return func(ts *teamstore) {
+		ts.teamHooks = append(ts.teamHooks, hook)
+	}
+}
+
+func WithDeleteTeamHook(hook func(team Team) error) func(ts *teamstore) {
+	return func(ts *teamstore) {
+		ts.deleteHooks = append(ts.deleteHooks, hook)
+	}
+}
+
 func NewTeamStore(opts ...TeamStoreOpt) *teamstore {
 	ts := &teamstore{
 		hooks:  []func(teams []Team) error{},
@@ -247,6 +354,11 @@ func (es *teamstore) CreateTeam(t Team) error {
 		return TeamExistsErr
 	}
 
+	// the team is only known once it has been stored
+	if err := es.runTeamHooks(t); err != nil {
+		return err
+	}
+
 	es.teams[t.Id] = t
 	es.emails[t.Email] = t.Id
 	es.names[t.Name] = t.Id
@@ -258,15 +370,57 @@ func (es *teamstore) SaveTeam(t Team) error {
 	es.m.Lock()
 	defer es.m.Unlock()
 
-	if _, ok := es.teams[t.Id]; !ok {
+	old, ok := es.teams[t.Id]
+	if !ok {
 		return UnknownTeamErr
 	}
 
+	if err := es.runTeamHooks(t); err != nil {
+		return err
+	}
+
+	if old.Email != t.Email {
+		delete(es.emails, old.Email)
+		es.emails[t.Email] = t.Id
+	}
+
+	if old.Name != t.Name {
+		delete(es.names, old.Name)
+		es.names[t.Name] = t.Id
+	}
+
 	es.teams[t.Id] = t
 
 	return es.RunHooks()
 }
 
+func (es *teamstore) DeleteTeam(id string) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+
+	t, ok := es.teams[id]
+	if !ok {
+		return UnknownTeamErr
+	}
+
+	for _, h := range es.deleteHooks {
+		if err := h(t); err != nil {
+			return err
+		}
+	}
+
+	delete(es.teams, id)
+	delete(es.emails, t.Email)
+	delete(es.names, t.Name)
+	for token, tid := range es.tokens {
+		if tid == id {
+			delete(es.tokens, token)
+		}
+	}
+
+	return es.RunHooks()
+}
+
 func (es *teamstore) CreateTokenForTeam(token string, in Team) error {
 	es.m.Lock()
 	defer es.m.Unlock()
@@ -364,6 +518,10 @@ func (es *teamstore) UpdateTeamAccessed(id string, ti time.Time) (Team, error) {
 	}
 
 	t.SetAccessed(ti)
+	if err := es.runTeamHooks(t); err != nil {
+		return es.teams[id], err
+	}
+
 	es.teams[id] = t
 
 	return t, es.RunHooks()
@@ -380,9 +538,24 @@ func (es *teamstore) RunHooks() error {
 	return nil
 }
 
+func (es *teamstore) runTeamHooks(t Team) error {
+	for _, h := range es.teamHooks {
+		if err := h(t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type EventConfigStore interface {
 	Read() EventConfig
 	SetCapacity(n int) error
+	Start(time.Time) error
+	SetSuspended(bool) error
+	SetExercises([]Tag) error
+	SetSubmissionKey(string) error
+	SetFlags([]FlagConfig) error
 	Finish(time.Time) error
 }
 
@@ -415,6 +588,51 @@ func (es *eventconfigstore) SetCapacity(n int) error {
 	return es.runHooks()
 }
 
+func (es *eventconfigstore) Start(t time.Time) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+
+	es.conf.StartedAt = &t
+
+	return es.runHooks()
+}
+
+func (es *eventconfigstore) SetSuspended(s bool) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+
+	es.conf.Suspended = s
+
+	return es.runHooks()
+}
+
+func (es *eventconfigstore) SetExercises(tags []Tag) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+
+	es.conf.Lab.Exercises = tags
+
+	return es.runHooks()
+}
+
+func (es *eventconfigstore) SetSubmissionKey(key string) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+
+	es.conf.SubmissionKey = key
+
+	return es.runHooks()
+}
+
+func (es *eventconfigstore) SetFlags(flags []FlagConfig) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+
+	es.conf.Flags = flags
+
+	return es.runHooks()
+}
+
 func (es *eventconfigstore) Finish(t time.Time) error {
 	es.m.Lock()
 	defer es.m.Unlock()
@@ -535,32 +753,90 @@ func (ef *eventfile) Archive() error {
 	ef.m.Lock()
 	defer ef.m.Unlock()
 
-	if _, err := os.Stat(ef.ArchiveDir()); os.IsNotExist(err) {
-		if err := os.MkdirAll(ef.ArchiveDir(), os.ModePerm); err != nil {
+	if err := archiveEvent(ef.ArchiveDir(), ef.file.EventConfig, ef.GetTeams()); err != nil {
+		return err
+	}
+
+	if err := ef.delete(); err != nil {
+		log.Warn().Msgf("Failed to delete old event file: %s", err)
+	}
+
+	return nil
+}
+
+// archiveEvent writes an anonymised copy of the event configuration and
+// its teams to config.yml inside the given archive directory.
+func archiveEvent(dir string, conf EventConfig, teams []Team) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 			return err
 		}
 	}
 
+	conf.SubmissionKey = ""
+
+	// static flags are left out, as the exercises may be used again
+	var flags []FlagConfig
+	for _, f := range conf.Flags {
+		f.Static = ""
+		flags = append(flags, f)
+	}
+	conf.Flags = flags
+
 	cpy := eventfile{
-		file:     ef.file,
-		dir:      ef.ArchiveDir(),
+		file:     RawEventFile{EventConfig: conf},
+		dir:      dir,
 		filename: "config.yml",
 	}
 
 	cpy.file.Teams = []Team{}
-	for _, t := range ef.GetTeams() {
+	for _, t := range teams {
 		t.Name = ""
 		t.Email = ""
 		t.HashedPassword = ""
+		t.CTFdPassword = ""
+		t.GuacPassword = ""
 		cpy.file.Teams = append(cpy.file.Teams, t)
 	}
-	cpy.save()
 
-	if err := ef.delete(); err != nil {
-		log.Warn().Msgf("Failed to delete old event file: %s", err)
+	return cpy.save()
+}
+
+// GetArchivedEvents reads the configuration of every event archived in
+// the given directory, keyed by the name of its archive directory.
+func GetArchivedEvents(path string) (map[string]RawEventFile, error) {
+	events := map[string]RawEventFile{}
+
+	dirs, err := ioutil.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return events, nil
+		}
+		return nil, err
 	}
 
-	return nil
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+
+		f, err := ioutil.ReadFile(filepath.Join(path, d.Name(), "config.yml"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		var ef RawEventFile
+		if err := yaml.Unmarshal(f, &ef); err != nil {
+			return nil, err
+		}
+
+		events[d.Name()] = ef
+	}
+
+	return events, nil
 }
 
 func getFileNameForEvent(path string, tag Tag) (string, error) {
@@ -5,6 +5,7 @@
 package store_test
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/google/uuid"
@@ -24,6 +25,36 @@ func TestNewTeam(t *testing.T) {
 	}
 }
 
+func TestTeamPassword(t *testing.T) {
+	password := "some_password"
+	team := store.NewTeam("some name", "some@email.com", password)
+
+	if team.HasLegacyPassword() {
+		t.Fatalf("expected password not to be stored as legacy hash")
+	}
+
+	if !team.IsCorrectPassword(password) {
+		t.Fatalf("expected password to be correct")
+	}
+
+	if team.IsCorrectPassword("wrong_password") {
+		t.Fatalf("expected wrong password to be incorrect")
+	}
+
+	if team.CTFdPassword == "" || team.CTFdPassword == password {
+		t.Fatalf("expected team to have a separate CTFd password")
+	}
+
+	team.HashedPassword = fmt.Sprintf("%x", sha256.Sum256([]byte(password)))
+	if !team.HasLegacyPassword() {
+		t.Fatalf("expected SHA-256 hash to be detected as legacy password")
+	}
+
+	if !team.IsCorrectPassword(password) {
+		t.Fatalf("expected legacy password to be correct")
+	}
+}
+
 func TestTeamSolveTask(t *testing.T) {
 	etag, err := store.NewTag("abc")
 	if err != nil {
@@ -48,6 +79,35 @@ func TestTeamSolveTask(t *testing.T) {
 	}
 }
 
+func TestTeamUnlockHint(t *testing.T) {
+	team := store.NewTeam("some name", "some@email.com", "some_password")
+
+	if err := team.UnlockHint("sql", 0, 5); err != nil {
+		t.Fatalf("expected no error when unlocking hint: %s", err)
+	}
+
+	if err := team.UnlockHint("sql", 1, 10); err != nil {
+		t.Fatalf("expected no error when unlocking hint: %s", err)
+	}
+
+	if err := team.UnlockHint("sql", 0, 5); err != store.HintUnlockedErr {
+		t.Fatalf("expected hint unlocked error, but received: %v", err)
+	}
+
+	if err := team.UnlockHint("xss", 0, 0); err != nil {
+		t.Fatalf("expected no error when unlocking hint: %s", err)
+	}
+
+	costs := team.HintCosts()
+	if costs["sql"] != 15 {
+		t.Fatalf("expected hint costs of 15, but received: %d", costs["sql"])
+	}
+
+	if costs["xss"] != 0 {
+		t.Fatalf("expected no hint costs, but received: %d", costs["xss"])
+	}
+}
+
 func TestCreateToken(t *testing.T) {
 	tt := []struct {
 		name  string
@@ -189,6 +249,127 @@ func TestDeleteToken(t *testing.T) {
 	}
 }
 
+func TestDeleteTeam(t *testing.T) {
+	tt := []struct {
+		name string
+		id   string
+		err  string
+	}{
+		{name: "Normal", id: "team-id"},
+		{name: "Unknown team", id: "other-id", err: "Unknown team"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var deleted []string
+			ts := store.NewTeamStore(store.WithDeleteTeamHook(func(team store.Team) error {
+				deleted = append(deleted, team.Id)
+				return nil
+			}))
+
+			team := store.Team{
+				Id:    "team-id",
+				Name:  "Test team",
+				Email: "tkp@tkp.dk",
+			}
+			if err := ts.CreateTeam(team); err != nil {
+				t.Fatalf("expected no error when creating team")
+			}
+
+			if err := ts.CreateTokenForTeam("some_token", team); err != nil {
+				t.Fatalf("expected no error when creating token")
+			}
+
+			err := ts.DeleteTeam(tc.id)
+			if err != nil {
+				if tc.err != "" {
+					if tc.err != err.Error() {
+						t.Fatalf("unexpected error (expected: \"%s\") when deleting team: %s", tc.err, err)
+					}
+
+					return
+				}
+
+				t.Fatalf("received error when deleting team, but expected none: %s", err)
+			}
+
+			if tc.err != "" {
+				t.Fatalf("expected error when deleting team: %s", tc.err)
+			}
+
+			if len(deleted) != 1 || deleted[0] != tc.id {
+				t.Fatalf("expected delete hook to be run for team, got: %v", deleted)
+			}
+
+			if n := len(ts.GetTeams()); n != 0 {
+				t.Fatalf("expected no teams after deletion, got %d", n)
+			}
+
+			if _, err := ts.GetTeamByToken("some_token"); err == nil {
+				t.Fatalf("expected token of deleted team to be removed")
+			}
+
+			if _, err := ts.GetTeamByName(team.Name); err == nil {
+				t.Fatalf("expected name of deleted team to be removed")
+			}
+		})
+	}
+}
+
+func TestTeamStoreFailingHooks(t *testing.T) {
+	var fail bool
+	hook := func(store.Team) error {
+		if fail {
+			return fmt.Errorf("database is down")
+		}
+		return nil
+	}
+	ts := store.NewTeamStore(store.WithTeamHook(hook), store.WithDeleteTeamHook(hook))
+
+	team := store.Team{Id: "team-id", Name: "Test team", Email: "tkp@tkp.dk"}
+
+	fail = true
+	if err := ts.CreateTeam(team); err == nil {
+		t.Fatalf("expected error when team cannot be stored")
+	}
+
+	if _, err := ts.GetTeamByName(team.Name); err == nil {
+		t.Fatalf("expected team not to be created when it cannot be stored")
+	}
+
+	fail = false
+	if err := ts.CreateTeam(team); err != nil {
+		t.Fatalf("unexpected error when creating team: %s", err)
+	}
+
+	fail = true
+	renamed := team
+	renamed.Name = "Renamed team"
+	if err := ts.SaveTeam(renamed); err == nil {
+		t.Fatalf("expected error when team cannot be saved")
+	}
+
+	if _, err := ts.GetTeamByName(team.Name); err != nil {
+		t.Fatalf("expected team to keep its name when it cannot be saved")
+	}
+
+	if _, err := ts.UpdateTeamAccessed(team.Id, time.Now()); err == nil {
+		t.Fatalf("expected error when access time cannot be saved")
+	}
+
+	if ts.GetTeams()[0].AccessedAt != nil {
+		t.Fatalf("expected access time to be unchanged when it cannot be saved")
+	}
+
+	if err := ts.DeleteTeam(team.Id); err == nil {
+		t.Fatalf("expected error when team cannot be deleted")
+	}
+
+	if n := len(ts.GetTeams()); n != 1 {
+		t.Fatalf("expected team to be kept when it cannot be deleted, got %d teams", n)
+	}
+}
+
 func TestArchive(t *testing.T) {
 	tempDir, err := ioutil.TempDir("", "")
 	if err != nil {
@@ -205,6 +386,15 @@ func TestArchive(t *testing.T) {
 		t.Fatalf("Unexpected error while creatingaving team")
 	}
 
+	if err := ef.SetSubmissionKey("secret"); err != nil {
+		t.Fatalf("Unexpected error while setting submission key: %s", err)
+	}
+
+	flags := []store.FlagConfig{{Tag: "sql-1", Static: "HKN{static}", Points: 10}}
+	if err := ef.SetFlags(flags); err != nil {
+		t.Fatalf("Unexpected error while setting flags: %s", err)
+	}
+
 	if err := ef.Archive(); err != nil {
 		t.Fatalf("Unexpected error: %s", err)
 	}
@@ -217,6 +407,36 @@ func TestArchive(t *testing.T) {
 	if _, err := os.Stat(eventFile); !os.IsNotExist(err) {
 		t.Fatalf("Expected '%s' to be removed, but it still exists: %s", eventFile, err)
 	}
+
+	archived, err := store.GetArchivedEvents(tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while reading archived events: %s", err)
+	}
+
+	raw, ok := archived[eventTag]
+	if !ok {
+		t.Fatalf("Expected archived event '%s' to be found", eventTag)
+	}
+
+	if len(raw.Teams) != 1 || raw.Teams[0].Id != team.Id {
+		t.Fatalf("Expected archived event to contain team '%s', but got: %v", team.Id, raw.Teams)
+	}
+
+	if raw.Teams[0].Name != "" {
+		t.Fatalf("Expected archived team to be anonymised, but got name '%s'", raw.Teams[0].Name)
+	}
+
+	if raw.SubmissionKey != "" {
+		t.Fatalf("Expected submission key to be left out of the archive")
+	}
+
+	if len(raw.Flags) != 1 || raw.Flags[0].Tag != "sql-1" || raw.Flags[0].Points != 10 {
+		t.Fatalf("Expected flags of event to be archived, but got: %v", raw.Flags)
+	}
+
+	if raw.Flags[0].Static != "" {
+		t.Fatalf("Expected static flag value to be left out of the archive")
+	}
 }
 
 func TestCreateEventFile(t *testing.T) {
@@ -0,0 +1,376 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	bolt "go.etcd.io/bbolt"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	eventsBucket = []byte("events")
+	teamsBucket  = []byte("teams")
+	configKey    = []byte("config")
+
+	UnknownEventErr = errors.New("Unknown event")
+	EventExistsErr  = errors.New("Event already exists")
+)
+
+const importedEventSuffix = ".imported"
+
+// EventDBHub is an EventFileHub which keeps events in an embedded
+// database, so team changes are written as single transactions instead
+// of rewriting the whole event file.
+type EventDBHub interface {
+	EventFileHub
+	ImportEventFiles(string) (int, error)
+	io.Closer
+}
+
+type eventdbhub struct {
+	m   sync.Mutex
+	db  *bolt.DB
+	dir string
+}
+
+func NewEventDBHub(path string, dir string) (EventDBHub, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(eventsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &eventdbhub{
+		db:  db,
+		dir: dir,
+	}, nil
+}
+
+func (h *eventdbhub) Close() error {
+	return h.db.Close()
+}
+
+func (h *eventdbhub) exists(name string) bool {
+	var found bool
+	h.db.View(func(tx *bolt.Tx) error {
+		found = tx.Bucket(eventsBucket).Bucket([]byte(name)) != nil
+		return nil
+	})
+
+	if found {
+		return true
+	}
+
+	_, err := os.Stat(filepath.Join(h.dir, name))
+	return !os.IsNotExist(err)
+}
+
+func (h *eventdbhub) nameForEvent(tag Tag) (string, error) {
+	now := time.Now().Format("02-01-06")
+	name := fmt.Sprintf("%s-%s", tag, now)
+	if !h.exists(name) {
+		return name, nil
+	}
+
+	for i := 1; i < 999; i++ {
+		name := fmt.Sprintf("%s-%s-%d", tag, now, i)
+		if !h.exists(name) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to get name for event")
+}
+
+func (h *eventdbhub) insert(name string, conf EventConfig, teams []Team) error {
+	rawConf, err := yaml.Marshal(conf)
+	if err != nil {
+		return err
+	}
+
+	return h.db.Update(func(tx *bolt.Tx) error {
+		events := tx.Bucket(eventsBucket)
+		if events.Bucket([]byte(name)) != nil {
+			return EventExistsErr
+		}
+
+		b, err := events.CreateBucket([]byte(name))
+		if err != nil {
+			return err
+		}
+
+		if err := b.Put(configKey, rawConf); err != nil {
+			return err
+		}
+
+		tb, err := b.CreateBucket(teamsBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range teams {
+			raw, err := yaml.Marshal(t)
+			if err != nil {
+				return err
+			}
+
+			if err := tb.Put([]byte(t.Id), raw); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// hasEvent tells if the event stored under name has the given tag
+func (h *eventdbhub) hasEvent(name string, tag Tag) bool {
+	var conf EventConfig
+	err := h.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(eventsBucket).Bucket([]byte(name))
+		if b == nil {
+			return UnknownEventErr
+		}
+
+		return yaml.Unmarshal(b.Get(configKey), &conf)
+	})
+
+	return err == nil && conf.Tag == tag
+}
+
+func (h *eventdbhub) CreateEventFile(conf EventConfig) (EventFile, error) {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	name, err := h.nameForEvent(conf.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.insert(name, conf, nil); err != nil {
+		return nil, err
+	}
+
+	return newEventDB(h.db, h.dir, name, conf, nil), nil
+}
+
+func (h *eventdbhub) GetUnfinishedEvents() ([]EventFile, error) {
+	var events []EventFile
+	err := h.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(eventsBucket).ForEach(func(k, v []byte) error {
+			b := tx.Bucket(eventsBucket).Bucket(k)
+			if b == nil {
+				return nil
+			}
+
+			var conf EventConfig
+			if err := yaml.Unmarshal(b.Get(configKey), &conf); err != nil {
+				return err
+			}
+
+			if conf.FinishedAt != nil {
+				return nil
+			}
+
+			var teams []Team
+			tb := b.Bucket(teamsBucket)
+			if tb != nil {
+				err := tb.ForEach(func(_, raw []byte) error {
+					var t Team
+					if err := yaml.Unmarshal(raw, &t); err != nil {
+						return err
+					}
+					teams = append(teams, t)
+
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			log.Debug().Str("name", conf.Name).Msg("Found unfinished event")
+			events = append(events, newEventDB(h.db, h.dir, string(k), conf, teams))
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ImportEventFiles moves the unfinished YAML event files found in dir into
+// the database. Imported files are renamed, so they are only imported once.
+func (h *eventdbhub) ImportEventFiles(dir string) (int, error) {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.yml"))
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, path := range files {
+		f, err := ioutil.ReadFile(path)
+		if err != nil {
+			return n, err
+		}
+
+		var ef RawEventFile
+		if err := yaml.Unmarshal(f, &ef); err != nil {
+			return n, err
+		}
+
+		if ef.FinishedAt != nil {
+			continue
+		}
+
+		// the file may have been imported before without being renamed,
+		// which is completed now
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		err = h.insert(name, ef.EventConfig, ef.Teams)
+		if err == EventExistsErr && h.hasEvent(name, ef.Tag) {
+			err = nil
+		}
+		if err != nil {
+			return n, err
+		}
+
+		if err := os.Rename(path, path+importedEventSuffix); err != nil {
+			return n, err
+		}
+
+		log.Info().
+			Str("name", ef.Name).
+			Str("file", path).
+			Msg("Imported event file into database")
+		n++
+	}
+
+	return n, nil
+}
+
+type eventdb struct {
+	m    sync.Mutex
+	db   *bolt.DB
+	dir  string
+	name string
+
+	TeamStore
+	EventConfigStore
+}
+
+func newEventDB(db *bolt.DB, dir string, name string, conf EventConfig, teams []Team) *eventdb {
+	edb := &eventdb{
+		db:   db,
+		dir:  dir,
+		name: name,
+	}
+
+	edb.TeamStore = NewTeamStore(WithTeams(teams), WithTeamHook(edb.saveTeam), WithDeleteTeamHook(edb.deleteTeam))
+	edb.EventConfigStore = NewEventConfigStore(conf, edb.saveEventConfig)
+
+	return edb
+}
+
+func (edb *eventdb) update(fn func(*bolt.Bucket) error) error {
+	return edb.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(eventsBucket).Bucket([]byte(edb.name))
+		if b == nil {
+			return UnknownEventErr
+		}
+
+		return fn(b)
+	})
+}
+
+func (edb *eventdb) saveTeam(t Team) error {
+	raw, err := yaml.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	return edb.update(func(b *bolt.Bucket) error {
+		tb, err := b.CreateBucketIfNotExists(teamsBucket)
+		if err != nil {
+			return err
+		}
+
+		return tb.Put([]byte(t.Id), raw)
+	})
+}
+
+func (edb *eventdb) deleteTeam(t Team) error {
+	return edb.update(func(b *bolt.Bucket) error {
+		tb := b.Bucket(teamsBucket)
+		if tb == nil {
+			return nil
+		}
+
+		return tb.Delete([]byte(t.Id))
+	})
+}
+
+func (edb *eventdb) saveEventConfig(conf EventConfig) error {
+	raw, err := yaml.Marshal(conf)
+	if err != nil {
+		return err
+	}
+
+	return edb.update(func(b *bolt.Bucket) error {
+		return b.Put(configKey, raw)
+	})
+}
+
+func (edb *eventdb) ArchiveDir() string {
+	return filepath.Join(edb.dir, edb.name)
+}
+
+func (edb *eventdb) Archive() error {
+	edb.m.Lock()
+	defer edb.m.Unlock()
+
+	if err := archiveEvent(edb.ArchiveDir(), edb.Read(), edb.GetTeams()); err != nil {
+		return err
+	}
+
+	err := edb.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(eventsBucket).DeleteBucket([]byte(edb.name))
+	})
+	if err != nil {
+		log.Warn().Msgf("Failed to delete event from database: %s", err)
+	}
+
+	return nil
+}
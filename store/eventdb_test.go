@@ -0,0 +1,207 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"gopkg.in/yaml.v2"
+)
+
+func TestEventDBHubUnfinishedEvents(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory")
+	}
+	defer os.RemoveAll(tempDir)
+
+	dbPath := filepath.Join(tempDir, "events.db")
+	hub, err := store.NewEventDBHub(dbPath, tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while creating event database hub: %s", err)
+	}
+
+	ef, err := hub.CreateEventFile(store.EventConfig{Name: "Test", Tag: "test"})
+	if err != nil {
+		t.Fatalf("Unexpected error while creating event: %s", err)
+	}
+
+	team := store.NewTeam("test@email.com", "BestTeam", "1234")
+	if err := ef.CreateTeam(team); err != nil {
+		t.Fatalf("Unexpected error while creating team: %s", err)
+	}
+
+	finished, err := hub.CreateEventFile(store.EventConfig{Name: "Finished", Tag: "finished"})
+	if err != nil {
+		t.Fatalf("Unexpected error while creating event: %s", err)
+	}
+	if err := finished.Finish(time.Now()); err != nil {
+		t.Fatalf("Unexpected error while finishing event: %s", err)
+	}
+
+	if err := hub.Close(); err != nil {
+		t.Fatalf("Unexpected error while closing hub: %s", err)
+	}
+
+	hub, err = store.NewEventDBHub(dbPath, tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while reopening event database hub: %s", err)
+	}
+	defer hub.Close()
+
+	events, err := hub.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("Unexpected error while getting unfinished events: %s", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Expected one unfinished event, but got %d", len(events))
+	}
+
+	if name := events[0].Read().Name; name != "Test" {
+		t.Fatalf("Expected event 'Test', but got '%s'", name)
+	}
+
+	teams := events[0].GetTeams()
+	if len(teams) != 1 || teams[0].Id != team.Id {
+		t.Fatalf("Expected team '%s' to be restored, but got: %+v", team.Id, teams)
+	}
+}
+
+func TestEventDBHubArchive(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory")
+	}
+	defer os.RemoveAll(tempDir)
+
+	hub, err := store.NewEventDBHub(filepath.Join(tempDir, "events.db"), tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while creating event database hub: %s", err)
+	}
+	defer hub.Close()
+
+	ef, err := hub.CreateEventFile(store.EventConfig{Name: "Test", Tag: "test"})
+	if err != nil {
+		t.Fatalf("Unexpected error while creating event: %s", err)
+	}
+
+	if err := ef.Archive(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	archiveFile := filepath.Join(ef.ArchiveDir(), "config.yml")
+	if _, err := os.Stat(archiveFile); err != nil {
+		t.Fatalf("Expected '%s' to exist, but got error: %s", archiveFile, err)
+	}
+
+	events, err := hub.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("Unexpected error while getting unfinished events: %s", err)
+	}
+
+	if len(events) != 0 {
+		t.Fatalf("Expected archived event to be removed from database, but got %d events", len(events))
+	}
+}
+
+func TestEventDBHubImportEventFiles(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory")
+	}
+	defer os.RemoveAll(tempDir)
+
+	team := store.NewTeam("test@email.com", "BestTeam", "1234")
+	raw, err := yaml.Marshal(store.RawEventFile{
+		EventConfig: store.EventConfig{Name: "Test", Tag: "test"},
+		Teams:       []store.Team{team},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error while marshalling event file: %s", err)
+	}
+
+	eventFile := filepath.Join(tempDir, "test-01-01-20.yml")
+	if err := ioutil.WriteFile(eventFile, raw, 0644); err != nil {
+		t.Fatalf("Unexpected error while writing event file: %s", err)
+	}
+
+	hub, err := store.NewEventDBHub(filepath.Join(tempDir, "events.db"), tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while creating event database hub: %s", err)
+	}
+	defer hub.Close()
+
+	n, err := hub.ImportEventFiles(tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while importing event files: %s", err)
+	}
+
+	if n != 1 {
+		t.Fatalf("Expected one event file to be imported, but got %d", n)
+	}
+
+	if _, err := os.Stat(eventFile); !os.IsNotExist(err) {
+		t.Fatalf("Expected '%s' to be moved after import", eventFile)
+	}
+
+	events, err := hub.GetUnfinishedEvents()
+	if err != nil {
+		t.Fatalf("Unexpected error while getting unfinished events: %s", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Expected one unfinished event, but got %d", len(events))
+	}
+
+	if dir := events[0].ArchiveDir(); dir != filepath.Join(tempDir, "test-01-01-20") {
+		t.Fatalf("Expected imported event to keep its name, but got archive directory '%s'", dir)
+	}
+
+	if teams := events[0].GetTeams(); len(teams) != 1 {
+		t.Fatalf("Expected one imported team, but got %d", len(teams))
+	}
+
+	// a file which was imported but not renamed is only renamed
+	if err := ioutil.WriteFile(eventFile, raw, 0644); err != nil {
+		t.Fatalf("Unexpected error while writing event file: %s", err)
+	}
+
+	n, err = hub.ImportEventFiles(tempDir)
+	if err != nil {
+		t.Fatalf("Unexpected error while importing already imported event file: %s", err)
+	}
+
+	if n != 1 {
+		t.Fatalf("Expected already imported event file to be counted, but got %d", n)
+	}
+
+	if _, err := os.Stat(eventFile); !os.IsNotExist(err) {
+		t.Fatalf("Expected '%s' to be moved after import", eventFile)
+	}
+
+	if events, _ := hub.GetUnfinishedEvents(); len(events) != 1 {
+		t.Fatalf("Expected event to be imported once, but got %d events", len(events))
+	}
+
+	// another event stored under the same name is not overwritten
+	raw, err = yaml.Marshal(store.RawEventFile{EventConfig: store.EventConfig{Name: "Other", Tag: "other"}})
+	if err != nil {
+		t.Fatalf("Unexpected error while marshalling event file: %s", err)
+	}
+
+	if err := ioutil.WriteFile(eventFile, raw, 0644); err != nil {
+		t.Fatalf("Unexpected error while writing event file: %s", err)
+	}
+
+	if _, err := hub.ImportEventFiles(tempDir); err != store.EventExistsErr {
+		t.Fatalf("Expected error (%s) while importing other event with same name, got: %v", store.EventExistsErr, err)
+	}
+}
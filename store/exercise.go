@@ -18,9 +18,10 @@ import (
 )
 
 var (
-	EmptyExTags         = errors.New("Exercise cannot have zero tags")
-	ImageNotDefinedErr  = errors.New("image cannot be empty")
-	MemoryNotDefinedErr = errors.New("memory cannot be empty")
+	EmptyExTags          = errors.New("Exercise cannot have zero tags")
+	ImageNotDefinedErr   = errors.New("image cannot be empty")
+	MemoryNotDefinedErr  = errors.New("memory cannot be empty")
+	InvalidFlagFormatErr = errors.New("flag format must contain %s exactly once")
 )
 
 type UnknownExerTagErr struct {
@@ -39,9 +40,59 @@ func (eee *ExerTagExistsErr) Error() string {
 	return fmt.Sprintf("Tag already exists: %s", eee.tag)
 }
 
+// FlagFormat is a template such as HKN{%s} in which the values of flags are
+// wrapped, so flags can be told apart from other strings
+type FlagFormat string
+
+func (ff FlagFormat) Validate() error {
+	if ff == "" {
+		return nil
+	}
+
+	if strings.Count(string(ff), "%") != 1 || !strings.Contains(string(ff), "%s") {
+		return InvalidFlagFormatErr
+	}
+
+	return nil
+}
+
+func (ff FlagFormat) parts() (string, string) {
+	parts := strings.SplitN(string(ff), "%s", 2)
+	if len(parts) != 2 {
+		return string(ff), ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// Matches reports whether the value is formatted as a flag, any value
+// matches if no format is given
+func (ff FlagFormat) Matches(value string) bool {
+	if ff == "" {
+		return true
+	}
+
+	prefix, suffix := ff.parts()
+	return len(value) > len(prefix)+len(suffix) &&
+		strings.HasPrefix(value, prefix) &&
+		strings.HasSuffix(value, suffix)
+}
+
+// Apply wraps the value in the format, values which are formatted already
+// are left as is
+func (ff FlagFormat) Apply(value string) string {
+	if ff.Matches(value) {
+		return value
+	}
+
+	prefix, suffix := ff.parts()
+	return prefix + value + suffix
+}
+
 type Exercise struct {
 	Name        string         `yaml:"name"`
 	Tags        []Tag          `yaml:"tags"`
+	FlagFormat  FlagFormat     `yaml:"flag-format,omitempty"`
 	DockerConfs []DockerConfig `yaml:"docker"`
 	VboxConfs   []VboxConfig   `yaml:"vbox"`
 }
@@ -57,6 +108,24 @@ func (e Exercise) Flags() []FlagConfig {
 		res = append(res, vboxConf.Flags...)
 	}
 
+	for i := range res {
+		res[i].Format = e.FlagFormat
+	}
+
+	return res
+}
+
+// WithFlagFormat returns the exercises with the flag format set for the ones
+// which have none of their own
+func WithFlagFormat(exercises []Exercise, ff FlagFormat) []Exercise {
+	res := make([]Exercise, len(exercises))
+	for i, e := range exercises {
+		if e.FlagFormat == "" {
+			e.FlagFormat = ff
+		}
+		res[i] = e
+	}
+
 	return res
 }
 
@@ -71,6 +140,10 @@ func (e Exercise) Validate() error {
 		}
 	}
 
+	if err := e.FlagFormat.Validate(); err != nil {
+		return err
+	}
+
 	for _, d := range e.DockerConfs {
 		if err := d.Validate(); err != nil {
 			return err
@@ -105,6 +178,7 @@ func (e Exercise) ContainerOpts() []ContainerOptions {
 				// flag is not static
 				value = uuid.New().String()
 			}
+			value = e.FlagFormat.Apply(value)
 
 			challenges = append(challenges, Challenge{
 				FlagTag:   flag.Tag,
@@ -167,6 +241,15 @@ type FlagConfig struct {
 	Points      uint   `yaml:"points"`
 	Description string `yaml:"description"`
 	Category    string `yaml:"category"`
+	Hints       []Hint `yaml:"hints,omitempty"`
+
+	// Format is the flag format of the exercise of the flag
+	Format FlagFormat `yaml:"-"`
+}
+
+type Hint struct {
+	Text string `yaml:"text"`
+	Cost uint   `yaml:"cost,omitempty"`
 }
 
 func (fc FlagConfig) Validate() error {
@@ -186,6 +269,12 @@ func (fc FlagConfig) Validate() error {
 		return &EmptyVarErr{Var: "Points", Type: "Flag Config"}
 	}
 
+	for _, h := range fc.Hints {
+		if h.Text == "" {
+			return &EmptyVarErr{Var: "Text", Type: "Hint"}
+		}
+	}
+
 	return nil
 }
 
@@ -261,6 +350,7 @@ type InstanceConfig struct {
 	Image    string  `yaml:"image"`
 	MemoryMB uint    `yaml:"memoryMB"`
 	CPU      float64 `yaml:"cpu"`
+	Type     string  `yaml:"type,omitempty"`
 }
 
 func (ic InstanceConfig) Validate() error {
@@ -273,16 +363,27 @@ func (ic InstanceConfig) Validate() error {
 	if ic.CPU < 0 {
 		return errors.New("cpu cannot be negative")
 	}
+	switch ic.Type {
+	case "", VBoxFrontend, DockerFrontend:
+	default:
+		return UnknownFrontendTypeErr
+	}
 
 	return nil
 }
 
+// IsContainer reports whether the instance is run as a Docker container
+// rather than a VirtualBox VM.
+func (ic InstanceConfig) IsContainer() bool {
+	return ic.Type == DockerFrontend
+}
+
 type exercisestore struct {
-	m         sync.Mutex
-	tags      map[Tag]*Exercise
-	exercises []*Exercise
+	m            sync.Mutex
+	tags         map[Tag]*Exercise
+	exercises    []*Exercise
 	exerciseInfo []FlagConfig
-	hooks     []func([]Exercise) error
+	hooks        []func([]Exercise) error
 }
 
 func (es *exercisestore) UpdateExercisesFile(path string) (ExerciseStore, error) {
@@ -314,8 +415,8 @@ func NewExerciseStore(exercises []Exercise, hooks ...func([]Exercise) error) (Ex
 		}
 	}
 
-	for _, e := range s.exercises{
-		for _,i :=range e.Flags(){
+	for _, e := range s.exercises {
+		for _, i := range e.Flags() {
 			s.exerciseInfo = append(s.exerciseInfo, i)
 		}
 	}
@@ -331,7 +432,7 @@ func (es *exercisestore) GetExercisesInfo(tag Tag) []FlagConfig {
 	var exer []FlagConfig
 
 	for _, e := range es.exerciseInfo {
-		if strings.Contains(string(e.Tag), string(tag)){
+		if strings.Contains(string(e.Tag), string(tag)) {
 			exer = append(exer, e)
 		}
 	}
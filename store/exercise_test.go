@@ -244,3 +244,76 @@ func TestDeleteExercise(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagFormat(t *testing.T) {
+	tt := []struct {
+		name    string
+		format  store.FlagFormat
+		value   string
+		applied string
+		matches bool
+		err     error
+	}{
+		{name: "No format", value: "abc", applied: "abc", matches: true},
+		{name: "Normal", format: "HKN{%s}", value: "abc", applied: "HKN{abc}"},
+		{name: "Formatted", format: "HKN{%s}", value: "HKN{abc}", applied: "HKN{abc}", matches: true},
+		{name: "Empty value", format: "HKN{%s}", value: "HKN{}", applied: "HKN{HKN{}}"},
+		{name: "Missing verb", format: "HKN{}", err: store.InvalidFlagFormatErr},
+		{name: "Other verb", format: "HKN{%s-%d}", err: store.InvalidFlagFormatErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.format.Validate(); err != tc.err {
+				t.Fatalf("unexpected error (expected: %v): %v", tc.err, err)
+			}
+
+			if tc.err != nil {
+				return
+			}
+
+			if m := tc.format.Matches(tc.value); m != tc.matches {
+				t.Fatalf("expected match to be %t", tc.matches)
+			}
+
+			if v := tc.format.Apply(tc.value); v != tc.applied {
+				t.Fatalf("expected formatted value %s, got: %s", tc.applied, v)
+			}
+		})
+	}
+}
+
+func TestFlagFormatContainerOpts(t *testing.T) {
+	e := store.Exercise{
+		Tags:       []store.Tag{"sql"},
+		FlagFormat: "HKN{%s}",
+		DockerConfs: []store.DockerConfig{{
+			ExerciseInstanceConfig: store.ExerciseInstanceConfig{
+				Flags: []store.FlagConfig{
+					{Tag: "sql-1", EnvVar: "FLAG1"},
+					{Tag: "sql-2", EnvVar: "FLAG2", Static: "abc"},
+				},
+			},
+		}},
+	}
+
+	for _, opt := range e.ContainerOpts() {
+		for _, c := range opt.Challenges {
+			if !e.FlagFormat.Matches(c.FlagValue) {
+				t.Fatalf("expected flag of %s to be formatted, got: %s", c.FlagTag, c.FlagValue)
+			}
+		}
+	}
+
+	for _, f := range e.Flags() {
+		if f.Format != e.FlagFormat {
+			t.Fatalf("expected format of flag %s to be set", f.Tag)
+		}
+	}
+
+	exercises := store.WithFlagFormat([]store.Exercise{{Tags: []store.Tag{"xss"}}, e}, "FLAG{%s}")
+	if exercises[0].FlagFormat != "FLAG{%s}" || exercises[1].FlagFormat != e.FlagFormat {
+		t.Fatalf("expected event flag format only for exercises without format, got: %s and %s",
+			exercises[0].FlagFormat, exercises[1].FlagFormat)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"reflect"
+)
+
+type ExerciseChange struct {
+	Tag           Tag
+	Name          string
+	AddedFlags    []Tag
+	RemovedFlags  []Tag
+	ChangedFlags  []Tag
+	AddedImages   []string
+	RemovedImages []string
+}
+
+type ExercisesDiff struct {
+	Added   []ExerciseChange
+	Removed []ExerciseChange
+	Changed []ExerciseChange
+}
+
+func (d ExercisesDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Tags returns the tags of the removed and changed exercises, which are the
+// ones affecting running events
+func (d ExercisesDiff) Tags() []Tag {
+	var tags []Tag
+	for _, c := range append(append([]ExerciseChange{}, d.Removed...), d.Changed...) {
+		tags = append(tags, c.Tag)
+	}
+
+	return tags
+}
+
+func (e Exercise) images() []string {
+	var images []string
+	for _, d := range e.DockerConfs {
+		images = append(images, d.Image)
+	}
+
+	for _, v := range e.VboxConfs {
+		images = append(images, v.Image)
+	}
+
+	return images
+}
+
+func (e Exercise) flagsByTag() map[Tag]FlagConfig {
+	flags := map[Tag]FlagConfig{}
+	for _, f := range e.Flags() {
+		flags[f.Tag] = f
+	}
+
+	return flags
+}
+
+func diffExercise(old, new Exercise) ExerciseChange {
+	// exercises are identified by their previous tag, as running events refer
+	// to that one
+	tags := old.Tags
+	if len(tags) == 0 {
+		tags = new.Tags
+	}
+
+	c := ExerciseChange{
+		Tag:  tags[0],
+		Name: new.Name,
+	}
+
+	oldFlags, newFlags := old.flagsByTag(), new.flagsByTag()
+	for _, f := range new.Flags() {
+		of, ok := oldFlags[f.Tag]
+		if !ok {
+			c.AddedFlags = append(c.AddedFlags, f.Tag)
+			continue
+		}
+
+		if !reflect.DeepEqual(of, f) {
+			c.ChangedFlags = append(c.ChangedFlags, f.Tag)
+		}
+	}
+
+	for _, f := range old.Flags() {
+		if _, ok := newFlags[f.Tag]; !ok {
+			c.RemovedFlags = append(c.RemovedFlags, f.Tag)
+		}
+	}
+
+	c.AddedImages = missingImages(new.images(), old.images())
+	c.RemovedImages = missingImages(old.images(), new.images())
+
+	return c
+}
+
+// missingImages returns the images of a which are not in b
+func missingImages(a, b []string) []string {
+	known := map[string]bool{}
+	for _, img := range b {
+		known[img] = true
+	}
+
+	var res []string
+	for _, img := range a {
+		if !known[img] {
+			res = append(res, img)
+		}
+	}
+
+	return res
+}
+
+func findExercise(exercises []Exercise, tags []Tag) (Exercise, bool) {
+	for _, e := range exercises {
+		for _, t := range e.Tags {
+			for _, tt := range tags {
+				if t == tt {
+					return e, true
+				}
+			}
+		}
+	}
+
+	return Exercise{}, false
+}
+
+// DiffExercises compares two sets of exercises, exercises sharing a tag are
+// considered to be the same exercise
+func DiffExercises(old, new []Exercise) ExercisesDiff {
+	var diff ExercisesDiff
+	for _, e := range new {
+		if len(e.Tags) == 0 {
+			continue
+		}
+
+		prev, ok := findExercise(old, e.Tags)
+		if !ok {
+			diff.Added = append(diff.Added, diffExercise(Exercise{}, e))
+			continue
+		}
+
+		if !reflect.DeepEqual(prev, e) {
+			diff.Changed = append(diff.Changed, diffExercise(prev, e))
+		}
+	}
+
+	for _, e := range old {
+		if len(e.Tags) == 0 {
+			continue
+		}
+
+		if _, ok := findExercise(new, e.Tags); !ok {
+			c := diffExercise(e, Exercise{Name: e.Name, Tags: e.Tags})
+			diff.Removed = append(diff.Removed, c)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"testing"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestDiffExercises(t *testing.T) {
+	exercise := func(tag store.Tag, image string, flags ...store.FlagConfig) store.Exercise {
+		return store.Exercise{
+			Name: string(tag),
+			Tags: []store.Tag{tag},
+			DockerConfs: []store.DockerConfig{{
+				ExerciseInstanceConfig: store.ExerciseInstanceConfig{
+					Flags:          flags,
+					InstanceConfig: store.InstanceConfig{Image: image},
+				},
+			}},
+		}
+	}
+
+	old := []store.Exercise{
+		exercise("sql", "sql:1", store.FlagConfig{Tag: "sql-1", Points: 10}),
+		exercise("xss", "xss:1", store.FlagConfig{Tag: "xss-1"}),
+		exercise("hb", "hb:1", store.FlagConfig{Tag: "hb-1"}),
+	}
+
+	new := []store.Exercise{
+		exercise("sql", "sql:2", store.FlagConfig{Tag: "sql-1", Points: 20}, store.FlagConfig{Tag: "sql-2"}),
+		exercise("hb", "hb:1", store.FlagConfig{Tag: "hb-1"}),
+		exercise("csrf", "csrf:1", store.FlagConfig{Tag: "csrf-1"}),
+	}
+
+	diff := store.DiffExercises(old, new)
+	if diff.Empty() {
+		t.Fatalf("expected diff to be non-empty")
+	}
+
+	if len(diff.Added) != 1 || diff.Added[0].Tag != "csrf" || len(diff.Added[0].AddedFlags) != 1 {
+		t.Fatalf("unexpected added exercises: %+v", diff.Added)
+	}
+
+	if len(diff.Removed) != 1 || diff.Removed[0].Tag != "xss" || len(diff.Removed[0].RemovedImages) != 1 {
+		t.Fatalf("unexpected removed exercises: %+v", diff.Removed)
+	}
+
+	if len(diff.Changed) != 1 {
+		t.Fatalf("expected one changed exercise, got: %+v", diff.Changed)
+	}
+
+	c := diff.Changed[0]
+	if c.Tag != "sql" {
+		t.Fatalf("expected sql to be changed, got: %s", c.Tag)
+	}
+
+	if len(c.AddedFlags) != 1 || c.AddedFlags[0] != "sql-2" {
+		t.Fatalf("unexpected added flags: %v", c.AddedFlags)
+	}
+
+	if len(c.ChangedFlags) != 1 || c.ChangedFlags[0] != "sql-1" {
+		t.Fatalf("unexpected changed flags: %v", c.ChangedFlags)
+	}
+
+	if len(c.AddedImages) != 1 || c.AddedImages[0] != "sql:2" || len(c.RemovedImages) != 1 {
+		t.Fatalf("unexpected images: added %v, removed %v", c.AddedImages, c.RemovedImages)
+	}
+
+	if tags := diff.Tags(); len(tags) != 2 {
+		t.Fatalf("expected tags of removed and changed exercises, got: %v", tags)
+	}
+
+	if diff := store.DiffExercises(old, old); !diff.Empty() {
+		t.Fatalf("expected no difference between identical exercises, got: %+v", diff)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// record types which can be served from the zone file of the lab DNS server
+var recordTypes = map[string]bool{
+	"A":     true,
+	"AAAA":  true,
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"PTR":   true,
+	"SRV":   true,
+	"TXT":   true,
+}
+
+type LintIssue struct {
+	Exercise string
+	Msg      string
+}
+
+func (li LintIssue) String() string {
+	return fmt.Sprintf("%s: %s", li.Exercise, li.Msg)
+}
+
+type linter struct {
+	exercise string
+	issues   []LintIssue
+}
+
+func (l *linter) report(format string, args ...interface{}) {
+	l.issues = append(l.issues, LintIssue{
+		Exercise: l.exercise,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) flags(flags []FlagConfig) {
+	for i, f := range flags {
+		if err := f.Validate(); err != nil {
+			l.report("flag %d (%s): %s", i+1, f.Tag, err)
+		}
+	}
+}
+
+func (l *linter) records(records []RecordConfig) {
+	for i, r := range records {
+		if err := r.Validate(); err != nil {
+			l.report("dns record %d: %s", i+1, err)
+			continue
+		}
+
+		if !recordTypes[strings.ToUpper(r.Type)] {
+			l.report("dns record %d (%s): invalid type %s", i+1, r.Name, r.Type)
+		}
+	}
+}
+
+func (l *linter) docker(i int, conf DockerConfig) {
+	if err := conf.InstanceConfig.Validate(); err != nil {
+		l.report("docker %d: %s", i+1, err)
+	} else if !docker.KnownRegistry(conf.Image) {
+		l.report("docker %d: image %s is not in any of the configured repositories", i+1, conf.Image)
+	}
+
+	for _, e := range conf.Envs {
+		if err := e.Validate(); err != nil {
+			l.report("docker %d: %s", i+1, err)
+		}
+	}
+
+	l.flags(conf.Flags)
+	l.records(conf.Records)
+
+	// the values of flags sharing an environment variable overwrite each other
+	envs := map[string]Tag{}
+	for _, f := range conf.Flags {
+		if f.EnvVar == "" {
+			continue
+		}
+
+		if other, ok := envs[f.EnvVar]; ok {
+			l.report("docker %d: flags %s and %s share environment variable %s", i+1, other, f.Tag, f.EnvVar)
+			continue
+		}
+		envs[f.EnvVar] = f.Tag
+	}
+
+	for _, e := range conf.Envs {
+		if t, ok := envs[e.EnvVar]; ok {
+			l.report("docker %d: environment variable %s of flag %s is overwritten", i+1, e.EnvVar, t)
+		}
+	}
+}
+
+func (l *linter) vbox(i int, conf VboxConfig) {
+	if err := conf.Validate(); err != nil {
+		l.report("vbox %d: %s", i+1, err)
+	}
+
+	l.flags(conf.Flags)
+	l.records(conf.Records)
+}
+
+// LintExercises reports every problem of the exercises, rather than only the
+// first one as done by validation
+func LintExercises(exercises []Exercise) []LintIssue {
+	var issues []LintIssue
+	tags := map[Tag]string{}
+	for i, e := range exercises {
+		l := linter{exercise: e.Name}
+		if l.exercise == "" {
+			l.exercise = fmt.Sprintf("exercise %d", i+1)
+		}
+
+		if len(e.Tags) == 0 {
+			l.report("%s", &EmptyVarErr{Var: "Tags", Type: "Exercise"})
+		}
+
+		for _, t := range e.Tags {
+			if err := t.Validate(); err != nil {
+				l.report("%s", err)
+				continue
+			}
+
+			if other, ok := tags[t]; ok {
+				l.report("tag %s is already used by %s", t, other)
+				continue
+			}
+			tags[t] = l.exercise
+		}
+
+		if err := e.FlagFormat.Validate(); err != nil {
+			l.report("%s", err)
+		}
+
+		for i, conf := range e.DockerConfs {
+			l.docker(i, conf)
+		}
+
+		for i, conf := range e.VboxConfs {
+			l.vbox(i, conf)
+		}
+
+		issues = append(issues, l.issues...)
+	}
+
+	return issues
+}
+
+// LintExercisesFile reports the problems of the exercises in the content of
+// an exercises file
+func LintExercisesFile(content []byte) ([]LintIssue, error) {
+	var conf struct {
+		Exercises []Exercise `yaml:"exercises"`
+	}
+
+	if err := yaml.Unmarshal(content, &conf); err != nil {
+		return nil, err
+	}
+
+	return LintExercises(conf.Exercises), nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestLintExercisesFile(t *testing.T) {
+	tt := []struct {
+		name    string
+		content string
+		issues  []string
+	}{
+		{name: "Normal", content: `exercises:
+- name: SQL
+  tags: [sql]
+  docker:
+  - image: sql
+    flag:
+    - {tag: sql-1, name: SQL, env: FLAG, points: 10}
+    dns:
+    - {type: A, name: sql.com}
+`},
+		{name: "Duplicate tags", content: `exercises:
+- {name: SQL, tags: [sql]}
+- {name: SQL 2, tags: [sql]}
+`, issues: []string{"SQL 2: tag sql is already used by SQL"}},
+		{name: "Flag without value", content: `exercises:
+- name: SQL
+  tags: [sql]
+  docker:
+  - image: sql
+    flag:
+    - {tag: sql-1, name: SQL, points: 10}
+`, issues: []string{"SQL: flag 1 (sql-1): Static or Env cannot be empty for Flag Config"}},
+		{name: "Env collision", content: `exercises:
+- name: SQL
+  tags: [sql]
+  docker:
+  - image: sql
+    flag:
+    - {tag: sql-1, name: SQL, env: FLAG, points: 10}
+    - {tag: sql-2, name: SQL, env: FLAG, points: 10}
+`, issues: []string{"SQL: docker 1: flags sql-1 and sql-2 share environment variable FLAG"}},
+		{name: "Invalid record type", content: `exercises:
+- name: SQL
+  tags: [sql]
+  docker:
+  - image: sql
+    dns:
+    - {type: X, name: sql.com}
+`, issues: []string{"SQL: dns record 1 (sql.com): invalid type X"}},
+		{name: "Unknown registry", content: `exercises:
+- name: SQL
+  tags: [sql]
+  docker:
+  - image: registry.example.com/aau/sql
+`, issues: []string{"SQL: docker 1: image registry.example.com/aau/sql is not in any of the configured repositories"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			issues, err := store.LintExercisesFile([]byte(tc.content))
+			if err != nil {
+				t.Fatalf("unexpected error when linting: %s", err)
+			}
+
+			var received []string
+			for _, i := range issues {
+				received = append(received, i.String())
+			}
+
+			if strings.Join(received, "\n") != strings.Join(tc.issues, "\n") {
+				t.Fatalf("expected issues %q, received: %q", tc.issues, received)
+			}
+		})
+	}
+}
@@ -5,14 +5,26 @@
 package store
 
 import (
+	"errors"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
 	"os"
+	"sort"
 	"sync"
 )
 
+const (
+	VBoxFrontend   = "vbox"
+	DockerFrontend = "docker"
+)
+
+var (
+	UnknownFrontendTypeErr = errors.New("unknown frontend type, expected vbox or docker")
+)
+
 type FrontendStore interface {
 	GetFrontends(...string) []InstanceConfig
+	ListFrontends() []InstanceConfig
 	SetMemoryMB(string, uint) error
 	SetCpu(string, float64) error
 	runHooks() error
@@ -85,6 +97,20 @@ func (fs *frontendstore) GetFrontends(names ...string) []InstanceConfig {
 	return res
 }
 
+// ListFrontends returns the frontends which are configured, sorted by image
+func (fs *frontendstore) ListFrontends() []InstanceConfig {
+	var res []InstanceConfig
+	for _, ic := range fs.frontends {
+		res = append(res, ic)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Image < res[j].Image
+	})
+
+	return res
+}
+
 func (fs *frontendstore) SetMemoryMB(f string, memoryMB uint) error {
 	ic, ok := fs.frontends[f]
 	if !ok {
@@ -49,6 +49,51 @@ func TestGetFrontends(t *testing.T) {
 	}
 }
 
+func TestListFrontends(t *testing.T) {
+	fs := frontendstore{
+		frontends: map[string]InstanceConfig{
+			"kali":      {Image: "kali", MemoryMB: 4096},
+			"kali-xrdp": {Image: "kali-xrdp", Type: DockerFrontend},
+		},
+	}
+
+	expected := []InstanceConfig{
+		{Image: "kali", MemoryMB: 4096},
+		{Image: "kali-xrdp", Type: DockerFrontend},
+	}
+
+	output := fs.ListFrontends()
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Expected frontends %+v, but got %+v", expected, output)
+	}
+}
+
+func TestFrontendType(t *testing.T) {
+	tt := []struct {
+		name      string
+		conf      InstanceConfig
+		container bool
+		err       error
+	}{
+		{name: "Default", conf: InstanceConfig{Image: "kali"}},
+		{name: "VirtualBox", conf: InstanceConfig{Image: "kali", Type: VBoxFrontend}},
+		{name: "Docker", conf: InstanceConfig{Image: "kali-xrdp", Type: DockerFrontend}, container: true},
+		{name: "Unknown", conf: InstanceConfig{Image: "kali", Type: "qemu"}, err: UnknownFrontendTypeErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.conf.Validate(); err != tc.err {
+				t.Fatalf("Expected error (%v), but got %v", tc.err, err)
+			}
+
+			if tc.conf.IsContainer() != tc.container {
+				t.Fatalf("Expected container to be %t, but got %t", tc.container, tc.conf.IsContainer())
+			}
+		})
+	}
+}
+
 func TestSetMemoryMBAndCpu(t *testing.T) {
 	tt := []struct {
 		name              string
@@ -0,0 +1,144 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	StaticScoring  = "static"
+	DynamicScoring = "dynamic"
+)
+
+var (
+	UnknownScoringModeErr = errors.New("Unknown scoring mode, expected static or dynamic")
+	InvalidDecayErr       = errors.New("Dynamic scoring requires a decay larger than zero")
+	InvalidMinimumErr     = errors.New("Minimum points cannot exceed the initial points")
+)
+
+// ScoringConfig describes how challenges are valued in an event. In the
+// dynamic mode the value of a challenge decays with the amount of teams
+// which have solved it (using the same formula as CTFd), going from the
+// initial value (or the points of the challenge) down to the minimum once
+// decay teams have solved it. The value is shared by all solvers.
+type ScoringConfig struct {
+	Mode            string `yaml:"mode,omitempty"`
+	Initial         uint   `yaml:"initial,omitempty"`
+	Minimum         uint   `yaml:"minimum,omitempty"`
+	Decay           uint   `yaml:"decay,omitempty"`
+	FirstBloodBonus uint   `yaml:"first-blood-bonus,omitempty"`
+}
+
+func (sc ScoringConfig) IsDynamic() bool {
+	return sc.Mode == DynamicScoring
+}
+
+func (sc ScoringConfig) Validate() error {
+	switch sc.Mode {
+	case "", StaticScoring:
+		return nil
+	case DynamicScoring:
+	default:
+		return UnknownScoringModeErr
+	}
+
+	if sc.Decay == 0 {
+		return InvalidDecayErr
+	}
+
+	if sc.Initial != 0 && sc.Minimum > sc.Initial {
+		return InvalidMinimumErr
+	}
+
+	return nil
+}
+
+// InitialValue returns the value of a challenge worth the given points
+// before anyone has solved it.
+func (sc ScoringConfig) InitialValue(points uint) uint {
+	if sc.IsDynamic() && sc.Initial != 0 {
+		return sc.Initial
+	}
+
+	return points
+}
+
+// Value returns the value of a challenge worth the given points once it
+// has been solved by the given amount of teams.
+func (sc ScoringConfig) Value(points uint, solves int) uint {
+	initial := sc.InitialValue(points)
+	if !sc.IsDynamic() || sc.Decay == 0 {
+		return initial
+	}
+
+	minimum := sc.Minimum
+	if minimum > initial {
+		minimum = initial
+	}
+
+	// the first solver receives the initial value
+	n := float64(solves - 1)
+	if n < 0 {
+		n = 0
+	}
+
+	decay := float64(sc.Decay)
+	value := (float64(minimum)-float64(initial))/(decay*decay)*(n*n) + float64(initial)
+	value = math.Ceil(value)
+	if value < float64(minimum) {
+		return minimum
+	}
+
+	return uint(value)
+}
+
+// Solvers returns the ids of the teams which have solved each challenge,
+// ordered by the time of their solve.
+func Solvers(teams []Team) map[Tag][]string {
+	type solve struct {
+		teamId string
+		at     time.Time
+	}
+
+	solves := map[Tag][]solve{}
+	for _, t := range teams {
+		solved := map[Tag]bool{}
+		for _, c := range t.SolvedChallenges {
+			if solved[c.FlagTag] || c.CompletedAt == nil {
+				continue
+			}
+			solved[c.FlagTag] = true
+
+			solves[c.FlagTag] = append(solves[c.FlagTag], solve{t.Id, *c.CompletedAt})
+		}
+	}
+
+	res := map[Tag][]string{}
+	for tag, s := range solves {
+		sort.SliceStable(s, func(i, j int) bool {
+			return s[i].at.Before(s[j].at)
+		})
+
+		for _, v := range s {
+			res[tag] = append(res[tag], v.teamId)
+		}
+	}
+
+	return res
+}
+
+// FirstBloods returns the id of the team which solved each challenge first
+func FirstBloods(teams []Team) map[Tag]string {
+	first := map[Tag]string{}
+	for tag, ids := range Solvers(teams) {
+		first[tag] = ids[0]
+	}
+
+	return first
+}
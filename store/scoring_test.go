@@ -0,0 +1,102 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestScoringValue(t *testing.T) {
+	dynamic := store.ScoringConfig{Mode: store.DynamicScoring, Minimum: 100, Decay: 10}
+
+	tt := []struct {
+		name     string
+		scoring  store.ScoringConfig
+		points   uint
+		solves   int
+		expected uint
+	}{
+		{name: "Static", points: 50, solves: 10, expected: 50},
+		{name: "Dynamic unsolved", scoring: dynamic, points: 500, solves: 0, expected: 500},
+		{name: "Dynamic first solve", scoring: dynamic, points: 500, solves: 1, expected: 500},
+		{name: "Dynamic decaying", scoring: dynamic, points: 500, solves: 6, expected: 400},
+		{name: "Dynamic fully decayed", scoring: dynamic, points: 500, solves: 11, expected: 100},
+		{name: "Dynamic beyond decay", scoring: dynamic, points: 500, solves: 50, expected: 100},
+		{name: "Dynamic initial override", scoring: store.ScoringConfig{Mode: store.DynamicScoring, Initial: 1000, Minimum: 100, Decay: 10}, points: 5, solves: 1, expected: 1000},
+		{name: "Dynamic minimum above points", scoring: dynamic, points: 50, solves: 5, expected: 50},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.scoring.Value(tc.points, tc.solves); v != tc.expected {
+				t.Fatalf("expected value %d, got: %d", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestScoringValidate(t *testing.T) {
+	tt := []struct {
+		name    string
+		scoring store.ScoringConfig
+		err     error
+	}{
+		{name: "Default"},
+		{name: "Static", scoring: store.ScoringConfig{Mode: store.StaticScoring, FirstBloodBonus: 10}},
+		{name: "Dynamic", scoring: store.ScoringConfig{Mode: store.DynamicScoring, Minimum: 10, Decay: 5}},
+		{name: "Unknown mode", scoring: store.ScoringConfig{Mode: "linear"}, err: store.UnknownScoringModeErr},
+		{name: "No decay", scoring: store.ScoringConfig{Mode: store.DynamicScoring}, err: store.InvalidDecayErr},
+		{name: "Minimum above initial", scoring: store.ScoringConfig{Mode: store.DynamicScoring, Initial: 10, Minimum: 20, Decay: 5}, err: store.InvalidMinimumErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.scoring.Validate(); err != tc.err {
+				t.Fatalf("expected error %v, got: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestFirstBloods(t *testing.T) {
+	at := func(min int) *time.Time {
+		ti := time.Date(2020, 1, 1, 12, min, 0, 0, time.UTC)
+		return &ti
+	}
+
+	teams := []store.Team{
+		{Id: "a", SolvedChallenges: []store.Challenge{
+			{FlagTag: "sql", CompletedAt: at(5)},
+			{FlagTag: "xss", CompletedAt: at(2)},
+		}},
+		{Id: "b", SolvedChallenges: []store.Challenge{
+			{FlagTag: "sql", CompletedAt: at(3)},
+			{FlagTag: "sql", CompletedAt: at(1)},
+			{FlagTag: "rce"},
+		}},
+		{Id: "c", SolvedChallenges: []store.Challenge{
+			{FlagTag: "xss", CompletedAt: at(2)},
+		}},
+	}
+
+	expected := map[store.Tag]string{
+		"sql": "b",
+		"xss": "a",
+	}
+
+	first := store.FirstBloods(teams)
+	if len(first) != len(expected) {
+		t.Fatalf("expected %d first bloods, but received: %d", len(expected), len(first))
+	}
+
+	for tag, id := range expected {
+		if first[tag] != id {
+			t.Fatalf("expected first blood of %s to be team %s, but received: %s", tag, id, first[tag])
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"time"
+)
+
+const (
+	defaultSubmissionWindow = time.Minute
+	defaultTeamSubmissions  = 20
+	defaultChalSubmissions  = 10
+	defaultAlertWindow      = 15 * time.Minute
+	defaultAlertWrongFlags  = 50
+)
+
+// SubmissionConfig limits the amount of flags a team can submit within a
+// sliding window, both in total and for a single challenge. Teams which
+// submit AlertWrongFlags distinct wrong flags within the alert window are
+// reported to the operators. Zero values are replaced by defaults.
+type SubmissionConfig struct {
+	Window          time.Duration `yaml:"window,omitempty"`
+	PerTeam         int           `yaml:"per-team,omitempty"`
+	PerChallenge    int           `yaml:"per-challenge,omitempty"`
+	AlertWindow     time.Duration `yaml:"alert-window,omitempty"`
+	AlertWrongFlags int           `yaml:"alert-wrong-flags,omitempty"`
+}
+
+func (sc SubmissionConfig) WithDefaults() SubmissionConfig {
+	if sc.Window == 0 {
+		sc.Window = defaultSubmissionWindow
+	}
+
+	if sc.PerTeam == 0 {
+		sc.PerTeam = defaultTeamSubmissions
+	}
+
+	if sc.PerChallenge == 0 {
+		sc.PerChallenge = defaultChalSubmissions
+	}
+
+	if sc.AlertWindow == 0 {
+		sc.AlertWindow = defaultAlertWindow
+	}
+
+	if sc.AlertWrongFlags == 0 {
+		sc.AlertWrongFlags = defaultAlertWrongFlags
+	}
+
+	return sc
+}
@@ -6,6 +6,7 @@ package store
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -25,38 +26,126 @@ var (
 
 	SignupKeyExistsErr   = errors.New("Signup key already exists")
 	SignupKeyNotFoundErr = errors.New("Signup key not found")
+
+	// users from before roles were introduced keep the permissions
+	// every user had back then
+	legacyRoles = []Role{RoleEventManager, RoleExerciseAuthor}
+)
+
+type Role string
+
+const (
+	RoleAdmin          Role = "admin"
+	RoleEventManager   Role = "event-manager"
+	RoleObserver       Role = "observer"
+	RoleExerciseAuthor Role = "exercise-author"
 )
 
+type UnknownRoleErr struct {
+	Role Role
+}
+
+func (ure *UnknownRoleErr) Error() string {
+	return fmt.Sprintf("Unknown role: %s", ure.Role)
+}
+
+func NewRole(s string) (Role, error) {
+	r := Role(strings.ToLower(s))
+	switch r {
+	case RoleAdmin, RoleEventManager, RoleObserver, RoleExerciseAuthor:
+		return r, nil
+	}
+
+	return "", &UnknownRoleErr{r}
+}
+
 type User struct {
+	Id             string    `yaml:"id,omitempty"`
 	Username       string    `yaml:"username"`
 	HashedPassword string    `yaml:"hashed-password"`
 	SuperUser      bool      `yaml:"super-user"`
+	Roles          []Role    `yaml:"roles,omitempty"`
 	CreatedAt      time.Time `yaml:"created-at"`
+
+	// RolesSet marks that the roles were given explicitly, in which case
+	// no roles means no permissions
+	RolesSet bool `yaml:"roles-set,omitempty"`
+
+	// TokenGeneration is embedded in issued tokens, incrementing it
+	// revokes every token issued to the user so far, while the id keeps
+	// tokens of a deleted user from being accepted for a new one by the
+	// same name
+	TokenGeneration int `yaml:"token-generation,omitempty"`
 }
 
 func NewUser(username, password string) (User, error) {
+	u := User{
+		Username: strings.ToLower(username),
+	}
+
+	if err := u.SetPassword(password); err != nil {
+		return User{}, err
+	}
+
+	return u, nil
+}
+
+func (u *User) SetPassword(password string) error {
 	if len(password) < 6 {
-		return User{}, PasswdTooShortErr
+		return PasswdTooShortErr
 	}
 
 	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return User{}, err
+		return err
 	}
 
-	return User{
-		Username:       strings.ToLower(username),
-		HashedPassword: string(hashedBytes[:]),
-	}, nil
+	u.HashedPassword = string(hashedBytes[:])
+	return nil
+}
+
+func (u *User) RevokeTokens() {
+	u.TokenGeneration += 1
 }
 
 func (u User) IsCorrectPassword(pass string) bool {
 	return bcrypt.CompareHashAndPassword([]byte(u.HashedPassword), []byte(pass)) == nil
 }
 
+func (u User) GetRoles() []Role {
+	if u.SuperUser {
+		return []Role{RoleAdmin}
+	}
+
+	if !u.RolesSet && len(u.Roles) == 0 {
+		return legacyRoles
+	}
+
+	return u.Roles
+}
+
+// HasRole reports whether the user has any of the given roles, admins
+// (and super users) are considered to have every role.
+func (u User) HasRole(roles ...Role) bool {
+	for _, r := range u.GetRoles() {
+		if r == RoleAdmin {
+			return true
+		}
+
+		for _, wanted := range roles {
+			if r == wanted {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type UserStore interface {
 	DeleteUserByUsername(string) error
 	CreateUser(User) error
+	UpdateUser(User) error
 	GetUserByUsername(string) (User, error)
 	ListUsers() []User
 }
@@ -133,6 +222,7 @@ func (us *userstore) CreateUser(u User) error {
 		return UserExistsErr
 	}
 
+	u.Id = uuid.New().String()
 	u.CreatedAt = time.Now()
 
 	us.userMap[u.Username] = &u
@@ -141,6 +231,25 @@ func (us *userstore) CreateUser(u User) error {
 	return us.RunHooks()
 }
 
+func (us *userstore) UpdateUser(u User) error {
+	us.m.Lock()
+	defer us.m.Unlock()
+
+	if _, ok := us.userMap[u.Username]; !ok {
+		return UserNotFoundErr
+	}
+
+	us.userMap[u.Username] = &u
+	for i, cu := range us.users {
+		if u.Username == cu.Username {
+			us.users[i] = u
+			break
+		}
+	}
+
+	return us.RunHooks()
+}
+
 func (us *userstore) RunHooks() error {
 	for _, h := range us.hooks {
 		if err := h(us.users); err != nil {
@@ -153,6 +262,8 @@ func (us *userstore) RunHooks() error {
 
 type SignupKey struct {
 	WillBeSuperUser bool   `yaml:"super-user,omitempty"`
+	Roles           []Role `yaml:"roles,omitempty"`
+	RolesSet        bool   `yaml:"roles-set,omitempty"`
 	Value           string `yaml:"value,omitempty"`
 }
 
@@ -27,6 +27,36 @@ func TestUser(t *testing.T) {
 	}
 }
 
+func TestUserRoles(t *testing.T) {
+	tt := []struct {
+		name     string
+		user     store.User
+		role     store.Role
+		expected bool
+	}{
+		{name: "Super user", user: store.User{SuperUser: true}, role: store.RoleObserver, expected: true},
+		{name: "Admin", user: store.User{Roles: []store.Role{store.RoleAdmin}}, role: store.RoleEventManager, expected: true},
+		{name: "Matching role", user: store.User{Roles: []store.Role{store.RoleObserver}}, role: store.RoleObserver, expected: true},
+		{name: "Missing role", user: store.User{Roles: []store.Role{store.RoleObserver}}, role: store.RoleEventManager},
+		{name: "No roles", user: store.User{}, role: store.RoleEventManager, expected: true},
+		{name: "No roles admin", user: store.User{}, role: store.RoleAdmin},
+		{name: "Roles removed", user: store.User{RolesSet: true}, role: store.RoleEventManager},
+		{name: "Roles removed exercise author", user: store.User{RolesSet: true}, role: store.RoleExerciseAuthor},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if ok := tc.user.HasRole(tc.role); ok != tc.expected {
+				t.Fatalf("expected user to have role %s: %t, got: %t", tc.role, tc.expected, ok)
+			}
+		})
+	}
+
+	if _, err := store.NewRole("teacher"); err == nil {
+		t.Fatalf("expected error for unknown role")
+	}
+}
+
 func TestUserStore(t *testing.T) {
 	var ran bool
 	var count int
@@ -7,7 +7,9 @@ package ctfd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"html"
 	"io/ioutil"
 	"mime/multipart"
 	"net"
@@ -17,7 +19,10 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"errors"
@@ -38,8 +43,14 @@ var (
 	UserNotFoundErr        = errors.New("Could not find the specified user")
 	CouldNotFindSessionErr = errors.New("Could not find the specified session")
 	NoSessionErr           = errors.New("No session found")
+	TeamIdNotFoundErr      = errors.New("Could not find the CTFd identifier of the team")
+	ChallengeIdNotFoundErr = errors.New("Could not find the CTFd identifier of the challenge")
+	HintIdNotFoundErr      = errors.New("Could not find the CTFd identifier of the hint")
+	UnknownHintErr         = errors.New("Could not find the specified hint")
+	HintNotUnlockedErr     = errors.New("CTFd did not unlock the hint")
 	ChallengeNotFoundErr   = errors.New("Could not find the specified challenge")
 	FlagNotFoundErr        = errors.New("Could not find the specified flag")
+	DeleteTeamErr          = errors.New("Unable to delete team in CTFd")
 )
 
 type CTFd interface {
@@ -49,16 +60,25 @@ type CTFd interface {
 	Start(context.Context) error
 	Stop() error
 	Flags() []store.FlagConfig
+	AddFlags(...store.FlagConfig) error
+	HideFlags(...store.Tag) error
+	CreateTeam(store.Team) error
+	UpdateTeam(name string, t store.Team) error
+	DeleteTeam(name string) error
 }
 
 type Config struct {
-	Name       string `yaml:"name"`
-	AdminUser  string `yaml:"admin_user"`
-	AdminEmail string `yaml:"admin_email"`
-	AdminPass  string `yaml:"admin_pass"`
-	Theme      string `yaml:"theme"`
-	Flags      []store.FlagConfig
-	Teams      []store.Team
+	Name         string `yaml:"name"`
+	AdminUser    string `yaml:"admin_user"`
+	AdminEmail   string `yaml:"admin_email"`
+	AdminPass    string `yaml:"admin_pass"`
+	Theme        string `yaml:"theme"`
+	Flags        []store.FlagConfig
+	Teams        []store.Team
+	Scoring      store.ScoringConfig
+	SolveHooks   []func(store.Team, store.Challenge)
+	Submissions  store.SubmissionConfig
+	AttemptHooks []func(store.Team, Attempt)
 }
 
 type ctfd struct {
@@ -70,6 +90,10 @@ type ctfd struct {
 	users    []*user
 	relation map[string]*user
 	flagPool *FlagPool
+	limiter  *SubmissionLimiter
+
+	m           sync.Mutex
+	firstBloods map[store.Tag]string
 }
 
 type user struct {
@@ -126,8 +150,11 @@ func New(ctx context.Context, conf Config) (CTFd, error) {
 		conf:     conf,
 		theme:    theme,
 		flagPool: NewFlagPool(),
+		limiter:  NewSubmissionLimiter(conf.Submissions, conf.AttemptHooks...),
 		nc:       nc,
 		relation: make(map[string]*user),
+
+		firstBloods: map[store.Tag]string{},
 	}
 
 	confDir, err := ioutil.TempDir("", "ctfd")
@@ -194,6 +221,9 @@ func (ctf *ctfd) Stop() error {
 }
 
 func (ctf *ctfd) Flags() []store.FlagConfig {
+	ctf.m.Lock()
+	defer ctf.m.Unlock()
+
 	return ctf.conf.Flags
 }
 
@@ -210,10 +240,17 @@ func (ctf *ctfd) ProxyHandler(hooks ...func(*store.Team) error) svcs.ProxyConnec
 	}
 
 	return func(es store.EventFile) http.Handler {
+		solveHooks := append([]func(store.Team, store.Challenge){}, ctf.conf.SolveHooks...)
+		if ctf.conf.Scoring.FirstBloodBonus > 0 {
+			solveHooks = append(solveHooks, ctf.firstBloodHook(es))
+		}
+
 		itc := svcs.Interceptors{
 			NewRegisterInterception(es, regOpts...),
-			NewCheckFlagInterceptor(es, ctf.flagPool),
+			NewCheckFlagInterceptor(es, ctf.flagPool, ctf.limiter, solveHooks...),
+			NewHintUnlockInterceptor(es, ctf.flagPool),
 			NewLoginInterceptor(es),
+			NewLabResetInterceptor(es, func() []store.Tag { return es.Read().Lab.Exercises }),
 		}
 
 		if ctf.theme.ExtraFields != nil {
@@ -265,7 +302,7 @@ func (ctf *ctfd) configureInstance() error {
 	for id, flag := range ctf.conf.Flags {
 		value := ctf.flagPool.AddFlag(flag, id+1)
 
-		if err := ctf.createFlag(flag.Name, value, flag.Description, flag.Category, flag.Points); err != nil {
+		if err := ctf.createFlag(flag, value); err != nil {
 			return err
 		}
 
@@ -273,10 +310,22 @@ func (ctf *ctfd) configureInstance() error {
 			Str("name", flag.Name).
 			Str("value", value).
 			Bool("static", flag.Static != "").
-			Uint("points", flag.Points).
+			Uint("points", ctf.conf.Scoring.InitialValue(flag.Points)).
+			Bool("dynamic", ctf.conf.Scoring.IsDynamic()).
 			Msg("Flag created")
 	}
 
+	for id, flag := range ctf.conf.Flags {
+		for i, h := range flag.Hints {
+			hid, err := ctf.createHint(id+1, h)
+			if err != nil {
+				return err
+			}
+
+			ctf.flagPool.AddHint(flag.Tag, i, hid)
+		}
+	}
+
 	for _, tt := range ctf.conf.Teams {
 		jar, err := cookiejar.New(nil)
 		if err != nil {
@@ -300,6 +349,19 @@ func (ctf *ctfd) configureInstance() error {
 		}
 	}
 
+	if ctf.conf.Scoring.FirstBloodBonus > 0 {
+		teams := map[string]store.Team{}
+		for _, t := range ctf.conf.Teams {
+			teams[t.Id] = t
+		}
+
+		for tag, id := range store.FirstBloods(ctf.conf.Teams) {
+			if err := ctf.awardFirstBlood(teams[id], tag); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -332,7 +394,7 @@ func (nc *nonceClient) getNonce(path string) (string, error) {
 	return string(matches[0][1]), nil
 }
 
-func (ctf *ctfd) createFlag(name, flagValue, description, category string, points uint) error {
+func (ctf *ctfd) createFlag(flag store.FlagConfig, flagValue string) error {
 	endpoint := ctf.nc.baseUrl() + "/admin/chal/new"
 
 	nonce, err := ctf.nc.getNonce(endpoint)
@@ -342,18 +404,26 @@ func (ctf *ctfd) createFlag(name, flagValue, description, category string, point
 
 	body := &bytes.Buffer{}
 	w := multipart.NewWriter(body)
+	scoring := ctf.conf.Scoring
 	values := map[string]string{
-		"name":         name,
-		"value":        fmt.Sprintf("%d", points),
+		"name":         flag.Name,
+		"value":        fmt.Sprintf("%d", scoring.InitialValue(flag.Points)),
 		"key":          flagValue,
 		"nonce":        nonce,
 		"key_type[0]":  "static",
-		"category":     category,
-		"description":  description,
+		"category":     flag.Category,
+		"description":  flag.Description,
 		"max_attempts": "",
 		"chaltype":     "standard",
 	}
 
+	// the value of dynamic challenges is decayed by CTFd itself
+	if scoring.IsDynamic() {
+		values["chaltype"] = "dynamic"
+		values["minimum"] = fmt.Sprintf("%d", scoring.Minimum)
+		values["decay"] = fmt.Sprintf("%d", scoring.Decay)
+	}
+
 	for k, v := range values {
 		err := w.WriteField(k, v)
 		if err != nil {
@@ -378,6 +448,431 @@ func (ctf *ctfd) createFlag(name, flagValue, description, category string, point
 	return nil
 }
 
+// updateChallenge changes whether the challenge with the given CTFd
+// identifier is hidden from teams
+func (ctf *ctfd) updateChallenge(cid int, flag store.FlagConfig, hidden bool) error {
+	endpoint := ctf.nc.baseUrl() + "/admin/chal/update"
+
+	nonce, err := ctf.nc.getNonce(ctf.nc.baseUrl() + "/admin/chals")
+	if err != nil {
+		return err
+	}
+
+	scoring := ctf.conf.Scoring
+	form := url.Values{
+		"id":           {fmt.Sprintf("%d", cid)},
+		"name":         {flag.Name},
+		"value":        {fmt.Sprintf("%d", scoring.InitialValue(flag.Points))},
+		"category":     {flag.Category},
+		"description":  {flag.Description},
+		"max_attempts": {""},
+		"nonce":        {nonce},
+	}
+
+	if scoring.IsDynamic() {
+		form.Set("initial", fmt.Sprintf("%d", scoring.InitialValue(flag.Points)))
+		form.Set("minimum", fmt.Sprintf("%d", scoring.Minimum))
+		form.Set("decay", fmt.Sprintf("%d", scoring.Decay))
+	}
+
+	if hidden {
+		form.Set("hidden", "on")
+	}
+
+	resp, err := ctf.nc.client.PostForm(endpoint, form)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// createHint adds a hint to the challenge with the given CTFd identifier,
+// and returns the identifier given to the hint by CTFd
+func (ctf *ctfd) createHint(cid int, h store.Hint) (int, error) {
+	endpoint := ctf.nc.baseUrl() + "/admin/hints"
+
+	nonce, err := ctf.nc.getNonce(ctf.nc.baseUrl() + "/admin/chals")
+	if err != nil {
+		return 0, err
+	}
+
+	form := url.Values{
+		"chal":  {fmt.Sprintf("%d", cid)},
+		"hint":  {h.Text},
+		"cost":  {fmt.Sprintf("%d", h.Cost)},
+		"nonce": {nonce},
+	}
+
+	resp, err := ctf.nc.client.PostForm(endpoint, form)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Id int `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return 0, err
+	}
+
+	if out.Id == 0 {
+		return 0, HintIdNotFoundErr
+	}
+
+	return out.Id, nil
+}
+
+// challengeIdByName returns the CTFd identifier of the most recently
+// created challenge with the given name
+func (ctf *ctfd) challengeIdByName(name string) (int, error) {
+	endpoint := ctf.nc.baseUrl() + "/admin/chals"
+
+	nonce, err := ctf.nc.getNonce(endpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := ctf.nc.client.PostForm(endpoint, url.Values{"nonce": {nonce}})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Game []struct {
+			Id   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"game"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return 0, err
+	}
+
+	var cid int
+	for _, c := range out.Game {
+		if c.Name == name && c.Id > cid {
+			cid = c.Id
+		}
+	}
+
+	if cid == 0 {
+		return 0, ChallengeIdNotFoundErr
+	}
+
+	return cid, nil
+}
+
+func (ctf *ctfd) firstBloodHook(ts store.TeamStore) func(store.Team, store.Challenge) {
+	return func(t store.Team, c store.Challenge) {
+		if store.FirstBloods(ts.GetTeams())[c.FlagTag] != t.Id {
+			return
+		}
+
+		go func() {
+			if err := ctf.awardFirstBlood(t, c.FlagTag); err != nil {
+				log.Warn().
+					Err(err).
+					Str("tag", string(c.FlagTag)).
+					Str("team-id", t.Id).
+					Msg("Unable to award first blood bonus")
+			}
+		}()
+	}
+}
+
+// awardFirstBlood gives the first blood bonus to a team in CTFd as an
+// award, at most once per challenge.
+func (ctf *ctfd) awardFirstBlood(t store.Team, tag store.Tag) error {
+	ctf.m.Lock()
+	defer ctf.m.Unlock()
+
+	if _, ok := ctf.firstBloods[tag]; ok {
+		return nil
+	}
+
+	if err := ctf.adminLogin(); err != nil {
+		return err
+	}
+
+	teamId, err := ctf.teamIdByName(t.Name)
+	if err != nil {
+		return err
+	}
+
+	endpoint := ctf.nc.baseUrl() + "/admin/teams"
+	nonce, err := ctf.nc.getNonce(endpoint)
+	if err != nil {
+		return err
+	}
+
+	name := string(tag)
+	for _, f := range ctf.conf.Flags {
+		if f.Tag == tag {
+			name = f.Name
+		}
+	}
+
+	form := url.Values{
+		"teamid":      {fmt.Sprintf("%d", teamId)},
+		"name":        {fmt.Sprintf("First blood: %s", name)},
+		"value":       {fmt.Sprintf("%d", ctf.conf.Scoring.FirstBloodBonus)},
+		"category":    {"First blood"},
+		"description": {fmt.Sprintf("First team to solve %s", name)},
+		"nonce":       {nonce},
+	}
+
+	resp, err := ctf.nc.client.PostForm(ctf.nc.baseUrl()+"/admin/awards/add", form)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	ctf.firstBloods[tag] = t.Id
+
+	return nil
+}
+
+// AddFlags creates challenges for the given flags in a running CTFd, the
+// challenges of flags which have been hidden are shown again
+func (ctf *ctfd) AddFlags(flags ...store.FlagConfig) error {
+	ctf.m.Lock()
+	defer ctf.m.Unlock()
+
+	if err := ctf.adminLogin(); err != nil {
+		return err
+	}
+
+	for _, flag := range flags {
+		if cid, err := ctf.flagPool.GetIdentifierByTag(flag.Tag); err == nil {
+			if err := ctf.updateChallenge(cid, flag, false); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// the flag is only known to the pool once CTFd has created its
+		// challenge, whose identifier is read back from CTFd
+		value := flagValue(flag)
+		if err := ctf.createFlag(flag, value); err != nil {
+			return err
+		}
+
+		cid, err := ctf.challengeIdByName(flag.Name)
+		if err != nil {
+			return err
+		}
+
+		ctf.flagPool.addFlag(flag, cid, value)
+		ctf.conf.Flags = append(ctf.conf.Flags, flag)
+
+		for i, h := range flag.Hints {
+			hid, err := ctf.createHint(cid, h)
+			if err != nil {
+				return err
+			}
+
+			ctf.flagPool.AddHint(flag.Tag, i, hid)
+		}
+
+		log.Debug().
+			Str("name", flag.Name).
+			Int("id", cid).
+			Msg("Flag added to running CTFd")
+	}
+
+	return nil
+}
+
+// HideFlags hides the challenges of the given flags from teams, solves of
+// the challenges are kept
+func (ctf *ctfd) HideFlags(tags ...store.Tag) error {
+	ctf.m.Lock()
+	defer ctf.m.Unlock()
+
+	if err := ctf.adminLogin(); err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		cid, err := ctf.flagPool.GetIdentifierByTag(tag)
+		if err != nil {
+			return err
+		}
+
+		for _, flag := range ctf.conf.Flags {
+			if flag.Tag != tag {
+				continue
+			}
+
+			if err := ctf.updateChallenge(cid, flag, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateTeam registers a team in a running CTFd
+func (ctf *ctfd) CreateTeam(tt store.Team) error {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return err
+	}
+
+	t := team{
+		nc: nonceClient{
+			port:   ctf.nc.port,
+			client: &http.Client{Jar: jar},
+		},
+		conf: tt,
+	}
+
+	return t.create(ctf.flagPool)
+}
+
+// UpdateTeam changes the name, email and password of the team in CTFd
+// which currently has the given name
+func (ctf *ctfd) UpdateTeam(name string, t store.Team) error {
+	ctf.m.Lock()
+	defer ctf.m.Unlock()
+
+	if err := ctf.adminLogin(); err != nil {
+		return err
+	}
+
+	teamId, err := ctf.teamIdByName(name)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/admin/team/%d", ctf.nc.baseUrl(), teamId)
+	nonce, err := ctf.nc.getNonce(endpoint)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"name":        {t.Name},
+		"email":       {t.Email},
+		"password":    {t.CTFdPassword},
+		"website":     {""},
+		"affiliation": {""},
+		"country":     {""},
+		"nonce":       {nonce},
+	}
+
+	resp, err := ctf.nc.client.PostForm(endpoint, form)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Data []string `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return err
+	}
+
+	if len(out.Data) != 1 || out.Data[0] != "success" {
+		return fmt.Errorf("Unable to update team in CTFd: %s", strings.Join(out.Data, ", "))
+	}
+
+	return nil
+}
+
+func (ctf *ctfd) DeleteTeam(name string) error {
+	ctf.m.Lock()
+	defer ctf.m.Unlock()
+
+	if err := ctf.adminLogin(); err != nil {
+		return err
+	}
+
+	teamId, err := ctf.teamIdByName(name)
+	if err != nil {
+		return err
+	}
+
+	nonce, err := ctf.nc.getNonce(ctf.nc.baseUrl() + "/admin/teams")
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/admin/team/%d/delete", ctf.nc.baseUrl(), teamId)
+	resp, err := ctf.nc.client.PostForm(endpoint, url.Values{"nonce": {nonce}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(string(content)) != "1" {
+		return DeleteTeamErr
+	}
+
+	return nil
+}
+
+func (ctf *ctfd) adminLogin() error {
+	endpoint := ctf.nc.baseUrl() + "/login"
+
+	nonce, err := ctf.nc.getNonce(endpoint)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"name":     {ctf.conf.AdminUser},
+		"password": {ctf.conf.AdminPass},
+		"nonce":    {nonce},
+	}
+
+	resp, err := ctf.nc.client.PostForm(endpoint, form)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// teamIdByName looks up the CTFd identifier of a team in the (paginated)
+// team overview of the admin interface.
+func (ctf *ctfd) teamIdByName(name string) (int, error) {
+	teamRegexp := regexp.MustCompile(fmt.Sprintf(`value="%s">\s*<a href="/admin/team/(\d+)"`, regexp.QuoteMeta(html.EscapeString(name))))
+
+	for page := 1; ; page++ {
+		resp, err := ctf.nc.client.Get(fmt.Sprintf("%s/admin/teams/%d", ctf.nc.baseUrl(), page))
+		if err != nil {
+			return 0, err
+		}
+
+		content, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return 0, err
+		}
+
+		if matches := teamRegexp.FindSubmatch(content); len(matches) > 0 {
+			return strconv.Atoi(string(matches[1]))
+		}
+
+		if !bytes.Contains(content, []byte("/admin/team/")) {
+			return 0, TeamIdNotFoundErr
+		}
+	}
+}
+
 func (ctf *ctfd) addTheme(t Theme) error {
 	endpoint := ctf.nc.baseUrl() + "/admin/config"
 
@@ -460,13 +955,20 @@ func (t *team) create(fp *FlagPool) error {
 		return err
 	}
 
+	// teams created before CTFd had separate credentials
+	// are registered with their legacy password hash
+	password := t.conf.CTFdPassword
+	if password == "" {
+		password = t.conf.HashedPassword
+	}
+
 	body := &bytes.Buffer{}
 	w := multipart.NewWriter(body)
 
 	values := map[string]string{
 		"name":     t.conf.Name,
 		"email":    t.conf.Email,
-		"password": t.conf.HashedPassword,
+		"password": password,
 		"nonce":    nonce,
 	}
 
@@ -491,8 +993,39 @@ func (t *team) create(fp *FlagPool) error {
 
 	defer resp.Body.Close()
 
+	// solves and hint unlocks are replayed in the order they happened, as
+	// CTFd only unlocks a hint for a team with enough points to pay for it
+	type step struct {
+		at  *time.Time
+		run func() error
+	}
+
+	var steps []step
 	for _, chal := range t.conf.SolvedChallenges {
-		if err := t.solve(fp, chal.FlagTag); err != nil {
+		tag := chal.FlagTag
+		steps = append(steps, step{chal.CompletedAt, func() error {
+			return t.solve(fp, tag)
+		}})
+	}
+
+	for _, h := range t.conf.UnlockedHints {
+		tag, index := h.FlagTag, h.Index
+		steps = append(steps, step{h.UnlockedAt, func() error {
+			return t.unlockHint(fp, tag, index)
+		}})
+	}
+
+	// anything without a time is replayed last
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].at == nil || steps[j].at == nil {
+			return steps[i].at != nil
+		}
+
+		return steps[i].at.Before(*steps[j].at)
+	})
+
+	for _, s := range steps {
+		if err := s.run(); err != nil {
 			return err
 		}
 	}
@@ -500,6 +1033,37 @@ func (t *team) create(fp *FlagPool) error {
 	return nil
 }
 
+func (t *team) unlockHint(fp *FlagPool, tag store.Tag, index int) error {
+	hid, err := fp.GetHintIdentifier(tag, index)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/hints/%d", t.nc.baseUrl(), hid)
+
+	nonce, err := t.nc.getNonce(t.nc.baseUrl() + "/challenges")
+	if err != nil {
+		return err
+	}
+
+	resp, err := t.nc.client.PostForm(endpoint, url.Values{"nonce": {nonce}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var hint hintResp
+	if err := json.NewDecoder(resp.Body).Decode(&hint); err != nil {
+		return err
+	}
+
+	if hint.Hint == "" {
+		return HintNotUnlockedErr
+	}
+
+	return nil
+}
+
 func (t *team) solve(fp *FlagPool, tag store.Tag) error {
 	id, err := fp.GetIdentifierByTag(tag)
 	if err != nil {
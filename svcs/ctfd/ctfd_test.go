@@ -0,0 +1,136 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package ctfd
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestTeamCreateReplaysHints(t *testing.T) {
+	at := func(min int) *time.Time {
+		ti := time.Date(2020, 1, 1, 12, min, 0, 0, time.UTC)
+		return &ti
+	}
+
+	tt := []struct {
+		name     string
+		team     store.Team
+		expected []string
+		err      error
+	}{
+		{name: "Replayed in order", team: store.Team{
+			SolvedChallenges: []store.Challenge{{FlagTag: "sql", CompletedAt: at(1)}},
+			UnlockedHints:    []store.UnlockedHint{{FlagTag: "xss", Index: 0, Cost: 5, UnlockedAt: at(2)}},
+		}, expected: []string{"/chal/1", "/hints/1"}},
+		{name: "Refused by CTFd", team: store.Team{
+			SolvedChallenges: []store.Challenge{{FlagTag: "sql", CompletedAt: at(2)}},
+			UnlockedHints:    []store.UnlockedHint{{FlagTag: "xss", Index: 0, Cost: 5, UnlockedAt: at(1)}},
+		}, expected: []string{"/hints/1"}, err: HintNotUnlockedErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				replayed []string
+				points   uint
+			)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					fmt.Fprint(w, `<script>var csrf_nonce = "nonce"</script>`)
+					return
+				}
+
+				if r.URL.Path == "/register" {
+					return
+				}
+
+				replayed = append(replayed, r.URL.Path)
+				switch {
+				case strings.HasPrefix(r.URL.Path, "/chal/"):
+					points += 10
+				case points < 5:
+					fmt.Fprint(w, `{"errors":"Not enough points"}`)
+				default:
+					points -= 5
+					fmt.Fprint(w, `{"hint":"try harder","chal":2}`)
+				}
+			}))
+			defer srv.Close()
+
+			_, p, _ := net.SplitHostPort(srv.Listener.Addr().String())
+			port, _ := strconv.Atoi(p)
+
+			jar, err := cookiejar.New(nil)
+			if err != nil {
+				t.Fatalf("unexpected error when creating cookie jar: %s", err)
+			}
+
+			fp := NewFlagPool()
+			fp.AddFlag(store.FlagConfig{Tag: "sql", Static: "flag"}, 1)
+			fp.AddFlag(store.FlagConfig{Tag: "xss", Static: "flag", Hints: []store.Hint{{Text: "try harder", Cost: 5}}}, 2)
+			fp.AddHint("xss", 0, 1)
+
+			team := team{
+				nc:   nonceClient{port: uint(port), client: &http.Client{Jar: jar}},
+				conf: tc.team,
+			}
+
+			if err := team.create(fp); err != tc.err {
+				t.Fatalf("expected error %v, got: %v", tc.err, err)
+			}
+
+			if len(replayed) != len(tc.expected) {
+				t.Fatalf("expected %d replayed requests, but received: %v", len(tc.expected), replayed)
+			}
+
+			for i, path := range tc.expected {
+				if replayed[i] != path {
+					t.Fatalf("expected request %d to be %s, but received: %s", i, path, replayed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSubmissionLimiterForgetsIdleTeams(t *testing.T) {
+	sl := NewSubmissionLimiter(store.SubmissionConfig{
+		Window:       time.Minute,
+		PerTeam:      3,
+		PerChallenge: 2,
+	})
+
+	now := time.Now()
+	sl.Allow("a", 1, now)
+	sl.Allow("a", 2, now)
+	sl.Allow("b", 1, now.Add(30*time.Second))
+
+	if len(sl.teams) != 2 || len(sl.chals) != 3 {
+		t.Fatalf("expected 2 teams and 3 challenges, but received: %d and %d", len(sl.teams), len(sl.chals))
+	}
+
+	sl.Allow("b", 1, now.Add(time.Minute+time.Second))
+	if _, ok := sl.teams["a"]; ok || len(sl.teams) != 1 {
+		t.Fatalf("expected only team b to be kept, but received: %v", sl.teams)
+	}
+
+	if len(sl.chals) != 1 {
+		t.Fatalf("expected only the challenge of team b to be kept, but received: %v", sl.chals)
+	}
+
+	if n := len(sl.teams["b"]); n != 2 {
+		t.Fatalf("expected team b to have 2 submissions within the window, but received: %d", n)
+	}
+}
@@ -12,9 +12,16 @@ import (
 )
 
 type FlagPool struct {
-	m    sync.RWMutex
-	ids  map[int]*activeFlagConfig
-	tags map[store.Tag]*activeFlagConfig
+	m     sync.RWMutex
+	ids   map[int]*activeFlagConfig
+	tags  map[store.Tag]*activeFlagConfig
+	hints map[int]activeHint
+}
+
+type activeHint struct {
+	Tag   store.Tag
+	Index int
+	Cost  uint
 }
 
 type activeFlagConfig struct {
@@ -29,26 +36,76 @@ func (afc *activeFlagConfig) IsStatic() bool {
 
 func NewFlagPool() *FlagPool {
 	return &FlagPool{
-		ids:  map[int]*activeFlagConfig{},
-		tags: map[store.Tag]*activeFlagConfig{},
+		ids:   map[int]*activeFlagConfig{},
+		tags:  map[store.Tag]*activeFlagConfig{},
+		hints: map[int]activeHint{},
 	}
 }
 
 func (fp *FlagPool) AddFlag(flag store.FlagConfig, cid int) string {
-	fp.m.Lock()
-	defer fp.m.Unlock()
+	value := flagValue(flag)
+	fp.addFlag(flag, cid, value)
 
-	value := flag.Static
-	if value == "" {
-		value = uuid.New().String()
+	return value
+}
+
+// flagValue returns the value of the flag as known by CTFd, which teams
+// never see unless the flag is static
+func flagValue(flag store.FlagConfig) string {
+	if flag.Static != "" {
+		return flag.Static
 	}
 
+	return uuid.New().String()
+}
+
+func (fp *FlagPool) addFlag(flag store.FlagConfig, cid int, value string) {
+	fp.m.Lock()
+	defer fp.m.Unlock()
+
 	fconf := activeFlagConfig{value, cid, flag}
 
 	fp.tags[flag.Tag] = &fconf
 	fp.ids[cid] = &fconf
+}
 
-	return value
+// AddHint relates the CTFd identifier of a hint to the index of the hint
+// within the flag with the given tag.
+func (fp *FlagPool) AddHint(tag store.Tag, index int, hid int) {
+	fp.m.Lock()
+	defer fp.m.Unlock()
+
+	var cost uint
+	if conf, ok := fp.tags[tag]; ok && index < len(conf.Hints) {
+		cost = conf.Hints[index].Cost
+	}
+
+	fp.hints[hid] = activeHint{tag, index, cost}
+}
+
+func (fp *FlagPool) GetHintByIdentifier(hid int) (store.Tag, int, uint, error) {
+	fp.m.RLock()
+	defer fp.m.RUnlock()
+
+	h, ok := fp.hints[hid]
+	if !ok {
+		return "", 0, 0, UnknownHintErr
+	}
+
+	return h.Tag, h.Index, h.Cost, nil
+}
+
+func (fp *FlagPool) GetHintIdentifier(tag store.Tag, index int) (int, error) {
+	fp.m.RLock()
+	defer fp.m.RUnlock()
+
+	for hid, h := range fp.hints {
+		if h.Tag == tag && h.Index == index {
+			return hid, nil
+		}
+	}
+
+	return 0, UnknownHintErr
 }
 
 func (fp *FlagPool) GetIdentifierByTag(t store.Tag) (int, error) {
@@ -87,6 +144,20 @@ func (fp *FlagPool) GetTagByIdentifier(id int) (store.Tag, error) {
 	return conf.Tag, nil
 }
 
+// GetFormatByIdentifier returns the format which flags of the challenge are
+// expected to have, which is empty for challenges without a format
+func (fp *FlagPool) GetFormatByIdentifier(id int) store.FlagFormat {
+	fp.m.RLock()
+	defer fp.m.RUnlock()
+
+	conf, ok := fp.ids[id]
+	if !ok {
+		return ""
+	}
+
+	return conf.Format
+}
+
 func (fp *FlagPool) TranslateFlagForTeam(t store.Team, cid int, value string) string {
 	fp.m.RLock()
 	defer fp.m.RUnlock()
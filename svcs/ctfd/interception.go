@@ -14,18 +14,23 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/aau-network-security/haaukins/metrics"
 	"github.com/aau-network-security/haaukins/store"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
 
 var (
 	chalPathRegex       = regexp.MustCompile(`/chal/([0-9]+)`)
+	hintPathRegex       = regexp.MustCompile(`^/hints/([0-9]+)$`)
 	DuplicateConsentErr = errors.New("Cannot have more than one consent checkbox")
 	NoConsentErr        = errors.New("No consent given")
 
@@ -312,7 +317,7 @@ func (ri *registerInterception) Intercept(next http.Handler) http.Handler {
 			}
 		}
 
-		r.Form.Set("password", t.HashedPassword)
+		r.Form.Set("password", t.CTFdPassword)
 
 		// update body and content-length
 		formdata := r.Form.Encode()
@@ -361,7 +366,7 @@ func (ri *registerInterception) Intercept(next http.Handler) http.Handler {
 			if errs != nil {
 				r.Form.Set("name", "")
 				r.Form.Set("email", "")
-				t.HashedPassword = ""
+				t.CTFdPassword = ""
 
 				mods = append(mods, WithRemoveErrors())
 				mods = append(mods, WithAppendErrors(errs))
@@ -402,17 +407,28 @@ type challengeResp struct {
 }
 
 type checkFlagInterception struct {
-	teamStore store.TeamStore
-	flagPool  *FlagPool
+	teamStore  store.TeamStore
+	flagPool   *FlagPool
+	limiter    *SubmissionLimiter
+	solveHooks []func(store.Team, store.Challenge)
 }
 
-func NewCheckFlagInterceptor(ts store.TeamStore, fp *FlagPool) *checkFlagInterception {
+// NewCheckFlagInterceptor checks the flags submitted by teams, submissions
+// are not throttled if no limiter is given
+func NewCheckFlagInterceptor(ts store.TeamStore, fp *FlagPool, sl *SubmissionLimiter, hooks ...func(store.Team, store.Challenge)) *checkFlagInterception {
 	return &checkFlagInterception{
-		teamStore: ts,
-		flagPool:  fp,
+		teamStore:  ts,
+		flagPool:   fp,
+		limiter:    sl,
+		solveHooks: hooks,
 	}
 }
 
+func writeChallengeResp(w http.ResponseWriter, resp challengeResp) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 func (*checkFlagInterception) ValidRequest(r *http.Request) bool {
 	if r.Method == http.MethodPost && chalPathRegex.MatchString(r.URL.Path) {
 		return true
@@ -437,6 +453,39 @@ func (cfi *checkFlagInterception) Intercept(next http.Handler) http.Handler {
 
 		originalFlag := r.FormValue("key")
 
+		tag, _ := cfi.flagPool.GetTagByIdentifier(cid)
+		attempt := Attempt{
+			Tag:  tag,
+			Flag: originalFlag,
+			Time: time.Now(),
+		}
+
+		// flags of the wrong format are rejected before reaching CTFd, so
+		// they do not count towards the submission limits
+		if ff := cfi.flagPool.GetFormatByIdentifier(cid); !ff.Matches(originalFlag) {
+			metrics.FlagSubmissions.Inc(AttemptWrongFormat)
+			attempt.Result = AttemptWrongFormat
+			cfi.limiter.Record(t, attempt)
+
+			writeChallengeResp(w, challengeResp{
+				Message: fmt.Sprintf("Incorrect, flags are formatted as %s", ff.Apply("...")),
+				Status:  0,
+			})
+			return
+		}
+
+		if !cfi.limiter.Allow(t.Id, cid, attempt.Time) {
+			metrics.FlagSubmissions.Inc(AttemptThrottled)
+			attempt.Result = AttemptThrottled
+			cfi.limiter.Record(t, attempt)
+
+			writeChallengeResp(w, challengeResp{
+				Message: throttledMessage,
+				Status:  throttledStatus,
+			})
+			return
+		}
+
 		translatedFlag := cfi.flagPool.TranslateFlagForTeam(t, cid, originalFlag)
 
 		r.Form.Set("key", translatedFlag)
@@ -457,7 +506,16 @@ func (cfi *checkFlagInterception) Intercept(next http.Handler) http.Handler {
 			return
 		}
 
+		result := AttemptIncorrect
 		if strings.ToLower(chal.Message) == "correct" {
+			result = AttemptCorrect
+		}
+		metrics.FlagSubmissions.Inc(result)
+
+		attempt.Result = result
+		cfi.limiter.Record(t, attempt)
+
+		if result == AttemptCorrect {
 			tag, err := cfi.flagPool.GetTagByIdentifier(cid)
 			if err != nil {
 				log.Warn().
@@ -496,6 +554,10 @@ func (cfi *checkFlagInterception) Intercept(next http.Handler) http.Handler {
 				Str("original", originalFlag).
 				Str("translated", translatedFlag).
 				Msg("Successfully solved challenge")
+
+			for _, h := range cfi.solveHooks {
+				h(t, t.ChalMap[tag])
+			}
 		}
 	})
 }
@@ -515,6 +577,157 @@ func (cfi *checkFlagInterception) getTeamFromSession(r *http.Request) (store.Tea
 	return t, nil
 }
 
+type hintResp struct {
+	Hint   string `json:"hint"`
+	Errors string `json:"errors"`
+}
+
+type hintUnlockInterception struct {
+	teamStore store.TeamStore
+	flagPool  *FlagPool
+}
+
+func NewHintUnlockInterceptor(ts store.TeamStore, fp *FlagPool) *hintUnlockInterception {
+	return &hintUnlockInterception{
+		teamStore: ts,
+		flagPool:  fp,
+	}
+}
+
+func (*hintUnlockInterception) ValidRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && hintPathRegex.MatchString(r.URL.Path)
+}
+
+func (hui *hintUnlockInterception) Intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := r.Cookie("session")
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		t, err := hui.teamStore.GetTeamByToken(c.Value)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		matches := hintPathRegex.FindStringSubmatch(r.URL.Path)
+		hid, _ := strconv.Atoi(matches[1])
+
+		resp, body := recordAndServe(next, r, w)
+		defer resp.Body.Close()
+
+		var hint hintResp
+		if err := json.Unmarshal(body, &hint); err != nil || hint.Hint == "" {
+			return
+		}
+
+		tag, index, cost, err := hui.flagPool.GetHintByIdentifier(hid)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Int("hint-id", hid).
+				Msg("Unable to find hint for identifier")
+			return
+		}
+
+		// the team may have changed while the request was proxied, e.g. by
+		// solving a challenge, which must not be overwritten
+		t, err = hui.teamStore.GetTeamByToken(c.Value)
+		if err != nil {
+			return
+		}
+
+		if err := t.UnlockHint(tag, index, cost); err != nil {
+			return
+		}
+
+		if err := hui.teamStore.SaveTeam(t); err != nil {
+			log.Warn().
+				Err(err).
+				Str("tag", string(tag)).
+				Str("team-id", t.Id).
+				Msg("Unable to save team")
+			return
+		}
+
+		log.Debug().
+			Str("tag", string(tag)).
+			Int("index", index).
+			Uint("cost", cost).
+			Str("team-id", t.Id).
+			Msg("Hint unlocked")
+	})
+}
+
+const (
+	LabResetExercisePath  = "/lab/reset/exercise"
+	LabResetFrontendsPath = "/lab/reset/frontends"
+	LabResetTokenField    = "csrf-token"
+)
+
+// LabResetToken is embedded in the reset forms shown to a session, other
+// sites are unable to submit it as they cannot read the session
+func LabResetToken(session string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte("lab-reset:"+session)))
+}
+
+var (
+	labResetTmpl, _ = template.New("lab-reset").Parse(`
+<div class="container" id="lab-reset">
+	<div class="row">
+		<form method="POST" action="` + LabResetExercisePath + `" class="form-inline col-md-8">
+			<input type="hidden" name="` + LabResetTokenField + `" value="{{.Token}}">
+			<select name="tag" class="form-control" required>{{range .Exercises}}
+			<option>{{.}}</option>{{end}}
+			</select>
+			<button type="submit" class="btn btn-outline-secondary">Reset exercise</button>
+		</form>
+		<form method="POST" action="` + LabResetFrontendsPath + `" class="form-inline col-md-4">
+			<input type="hidden" name="` + LabResetTokenField + `" value="{{.Token}}">
+			<button type="submit" class="btn btn-outline-secondary">Reset frontends</button>
+		</form>
+	</div>
+</div>`)
+)
+
+type labResetInterception struct {
+	teamStore store.TeamStore
+	exercises func() []store.Tag
+}
+
+// NewLabResetInterceptor adds buttons for resetting the lab of a team to
+// the challenges page, the exercises are read for every page as they can
+// change while the event is running
+func NewLabResetInterceptor(ts store.TeamStore, exercises func() []store.Tag) *labResetInterception {
+	return &labResetInterception{
+		teamStore: ts,
+		exercises: exercises,
+	}
+}
+
+func (*labResetInterception) ValidRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet && r.URL.Path == "/challenges"
+}
+
+func (lri *labResetInterception) Intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := r.Cookie("session")
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if _, err := lri.teamStore.GetTeamByToken(c.Value); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recordAndServe(next, r, w, WithLabReset(lri.exercises(), LabResetToken(c.Value)))
+	})
+}
+
 type loginInterception struct {
 	teamStore store.TeamStore
 }
@@ -538,10 +751,20 @@ func (li *loginInterception) Intercept(next http.Handler) http.Handler {
 		name := r.FormValue("name")
 		pass := r.FormValue("password")
 
+		var t store.Team
+		var err error
+		t, err = li.teamStore.GetTeamByEmail(name)
+		if err != nil {
+			t, err = li.teamStore.GetTeamByName(name)
+		}
+
 		// skip admin user
 		if name != "admin" {
-			hashedPass := fmt.Sprintf("%x", sha256.Sum256([]byte(pass)))
-			r.Form.Set("password", hashedPass)
+			ctfdPass := fmt.Sprintf("%x", sha256.Sum256([]byte(pass)))
+			if err == nil && !t.HasLegacyPassword() && t.IsCorrectPassword(pass) {
+				ctfdPass = t.CTFdPassword
+			}
+			r.Form.Set("password", ctfdPass)
 		}
 
 		// update body and content-length
@@ -559,13 +782,6 @@ func (li *loginInterception) Intercept(next http.Handler) http.Handler {
 			}
 		}
 
-		var t store.Team
-		var err error
-		t, err = li.teamStore.GetTeamByEmail(name)
-		if err != nil {
-			t, err = li.teamStore.GetTeamByName(name)
-		}
-
 		if err != nil {
 			log.Warn().
 				Str("name", name).
@@ -575,10 +791,71 @@ func (li *loginInterception) Intercept(next http.Handler) http.Handler {
 
 		if session != "" {
 			li.teamStore.CreateTokenForTeam(session, t)
+
+			if t.HasLegacyPassword() && t.IsCorrectPassword(pass) {
+				if err := li.upgradePassword(next, session, t, pass); err != nil {
+					log.Warn().
+						Err(err).
+						Str("team-id", t.Id).
+						Msg("Unable to upgrade legacy password for team")
+				}
+			}
 		}
 	})
 }
 
+// upgradePassword replaces the unsalted password hash of a team with a
+// bcrypt hash, and gives the team a separate password in CTFd.
+func (li *loginInterception) upgradePassword(next http.Handler, session string, t store.Team, pass string) error {
+	oldPass := t.HashedPassword
+	newPass := uuid.New().String()
+
+	cookie := &http.Cookie{Name: "session", Value: session}
+	req, err := http.NewRequest(http.MethodGet, "/profile", nil)
+	if err != nil {
+		return err
+	}
+	req.AddCookie(cookie)
+
+	rec := httptest.NewRecorder()
+	next.ServeHTTP(rec, req)
+
+	matches := NONCEREGEXP.FindSubmatch(rec.Body.Bytes())
+	if len(matches) == 0 {
+		return fmt.Errorf("Unable to find nonce in page")
+	}
+
+	form := url.Values{
+		"name":        {t.Name},
+		"email":       {t.Email},
+		"password":    {newPass},
+		"confirm":     {oldPass},
+		"website":     {""},
+		"affiliation": {""},
+		"country":     {""},
+		"nonce":       {string(matches[1])},
+	}
+	req, err = http.NewRequest(http.MethodPost, "/profile", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(cookie)
+
+	rec = httptest.NewRecorder()
+	next.ServeHTTP(rec, req)
+	if rec.Code != http.StatusFound {
+		return fmt.Errorf("Unexpected status code from CTFd when changing password: %d", rec.Code)
+	}
+
+	if err := t.SetPassword(pass); err != nil {
+		return err
+	}
+	t.CTFdPassword = newPass
+
+	return li.teamStore.SaveTeam(t)
+}
+
 var (
 	errTmpl, _ = template.New("error").Parse(`
 {{range .}}
@@ -618,6 +895,19 @@ func WithExtraFields(ef *ExtraFields) RespModifier {
 	}
 }
 
+func WithLabReset(exercises []store.Tag, token string) RespModifier {
+	var out bytes.Buffer
+	labResetTmpl.Execute(&out, struct {
+		Exercises []store.Tag
+		Token     string
+	}{exercises, token})
+	html := out.String()
+
+	return func(doc *goquery.Document) {
+		doc.Find(".jumbotron").AfterHtml(html)
+	}
+}
+
 type RespModifier func(*goquery.Document)
 
 func recordAndServe(next http.Handler, r *http.Request, w http.ResponseWriter, mods ...RespModifier) (*http.Response, []byte) {
@@ -5,6 +5,7 @@
 package ctfd_test
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -266,7 +267,7 @@ func TestCheckFlagInterceptor(t *testing.T) {
 	email := "some@email.com"
 	nonce := "some_nonce"
 
-	genFlags := func(value string, static bool, n int) (*ctfd.FlagPool, store.Tag, int, string) {
+	genFlags := func(value string, static bool, format store.FlagFormat, n int) (*ctfd.FlagPool, store.Tag, int, string) {
 		fp := ctfd.NewFlagPool()
 		var flags []store.FlagConfig
 		for i := 0; i < n-1; i++ {
@@ -286,6 +287,7 @@ func TestCheckFlagInterceptor(t *testing.T) {
 		flag := store.FlagConfig{
 			Tag:    flagtag,
 			EnvVar: "tst",
+			Format: format,
 		}
 		if static {
 			flag.Static = value
@@ -316,6 +318,7 @@ func TestCheckFlagInterceptor(t *testing.T) {
 		sendFlag  string
 		flagValue string
 		static    bool
+		format    store.FlagFormat
 		solve     bool
 		intercept bool
 	}{
@@ -324,11 +327,13 @@ func TestCheckFlagInterceptor(t *testing.T) {
 		{name: "Dynamic (incorrect)", sendFlag: "incorrect", flagValue: "abc", intercept: true},
 		{name: "Dynamic (correct)", sendFlag: "abc", flagValue: "abc", solve: true, intercept: true},
 		{name: "No flags", sendFlag: "abc", intercept: true},
+		{name: "Formatted (correct)", sendFlag: "HKN{abc}", flagValue: "HKN{abc}", format: "HKN{%s}", solve: true, intercept: true},
+		{name: "Formatted (wrong format)", sendFlag: "abc", flagValue: "HKN{abc}", format: "HKN{%s}", intercept: true},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			fp, flagtag, id, ctfdValue := genFlags(tc.flagValue, tc.static, 50)
+			fp, flagtag, id, ctfdValue := genFlags(tc.flagValue, tc.static, tc.format, 50)
 			ts := store.NewTeamStore()
 
 			team := store.NewTeam(email, "name_goes_here", "passhere")
@@ -353,7 +358,12 @@ func TestCheckFlagInterceptor(t *testing.T) {
 				ts.SaveTeam(team)
 			}
 
-			interceptor := ctfd.NewCheckFlagInterceptor(ts, fp)
+			var hooked []store.Challenge
+			hook := func(_ store.Team, c store.Challenge) {
+				hooked = append(hooked, c)
+			}
+
+			interceptor := ctfd.NewCheckFlagInterceptor(ts, fp, nil, hook)
 			ok := interceptor.ValidRequest(req)
 			if !ok {
 				if tc.intercept {
@@ -393,6 +403,14 @@ func TestCheckFlagInterceptor(t *testing.T) {
 				t.Fatalf("unable to read json response body")
 			}
 
+			if tc.format != "" && !tc.format.Matches(tc.sendFlag) {
+				if key != "" || !strings.Contains(respJson.M, "HKN{...}") {
+					t.Fatalf("expected flag of wrong format to be rejected, received: %s", respJson.M)
+				}
+
+				return
+			}
+
 			if readNonce != nonce {
 				t.Fatalf("expected nonce (value: %s) to be parsed on, but received: %s", nonce, readNonce)
 			}
@@ -412,6 +430,10 @@ func TestCheckFlagInterceptor(t *testing.T) {
 				t.Fatalf("missing challenge in solved challenges for team")
 			}
 
+			if solved := len(hooked) == 1 && hooked[0].FlagTag == flagtag; solved != tc.solve {
+				t.Fatalf("expected solve hook to be called (%t), but got: %v", tc.solve, hooked)
+			}
+
 			if !tc.solve {
 				if chal.CompletedAt != nil {
 					t.Fatalf("expected no completion of challenge")
@@ -428,6 +450,191 @@ func TestCheckFlagInterceptor(t *testing.T) {
 
 }
 
+func TestHintUnlockInterceptor(t *testing.T) {
+	host := "http://sec02.lab.es.aau.dk"
+	knownSession := "known_session"
+	email := "some@email.com"
+	flagtag := store.Tag("sql")
+
+	tt := []struct {
+		name    string
+		hintId  int
+		session string
+		resp    string
+		unlock  bool
+	}{
+		{name: "Unlocked", hintId: 2, session: knownSession, resp: `{"hint":"try harder","chal":1}`, unlock: true},
+		{name: "Not enough points", hintId: 2, session: knownSession, resp: `{"errors":"Not enough points"}`},
+		{name: "Unknown hint", hintId: 3, session: knownSession, resp: `{"hint":"try harder","chal":1}`},
+		{name: "Unknown session", hintId: 2, session: "unknown", resp: `{"hint":"try harder","chal":1}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			fp := ctfd.NewFlagPool()
+			fp.AddFlag(store.FlagConfig{
+				Tag: flagtag,
+				Hints: []store.Hint{
+					{Text: "look closer"},
+					{Text: "try harder", Cost: 5},
+				},
+			}, 1)
+			fp.AddHint(flagtag, 0, 1)
+			fp.AddHint(flagtag, 1, 2)
+
+			ts := store.NewTeamStore()
+			team := store.NewTeam(email, "name_goes_here", "passhere")
+			team.AddChallenge(store.Challenge{FlagTag: "web", FlagValue: "flag"})
+			if err := ts.CreateTeam(team); err != nil {
+				t.Fatalf("expected to be able to create team")
+			}
+
+			if err := ts.CreateTokenForTeam(knownSession, team); err != nil {
+				t.Fatalf("expected to be able to create token for team")
+			}
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("%s/hints/%d", host, tc.hintId), nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: tc.session})
+
+			interceptor := ctfd.NewHintUnlockInterceptor(ts, fp)
+			if !interceptor.ValidRequest(req) {
+				t.Fatalf("no interception, despite expected intercept")
+			}
+
+			// a challenge solved while the request is proxied
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				solver, _ := ts.GetTeamByEmail(email)
+				if err := solver.SolveChallenge("web", "flag"); err != nil {
+					t.Fatalf("expected to be able to solve challenge: %s", err)
+				}
+				ts.SaveTeam(solver)
+
+				w.Write([]byte(tc.resp))
+			})
+
+			w := httptest.NewRecorder()
+			interceptor.Intercept(testHandler).ServeHTTP(w, req)
+
+			if body := w.Body.String(); body != tc.resp {
+				t.Fatalf("expected response to be passed on (%s), but received: %s", tc.resp, body)
+			}
+
+			team, _ = ts.GetTeamByEmail(email)
+			if n := len(team.SolvedChallenges); n != 1 {
+				t.Fatalf("expected solve during request to be kept, but got %d solved challenges", n)
+			}
+			if !tc.unlock {
+				if len(team.UnlockedHints) != 0 {
+					t.Fatalf("expected no unlocked hints, but received: %v", team.UnlockedHints)
+				}
+
+				return
+			}
+
+			if len(team.UnlockedHints) != 1 {
+				t.Fatalf("expected one unlocked hint, but received: %d", len(team.UnlockedHints))
+			}
+
+			h := team.UnlockedHints[0]
+			if h.FlagTag != flagtag || h.Index != 1 || h.Cost != 5 {
+				t.Fatalf("unexpected unlocked hint: %+v", h)
+			}
+		})
+	}
+}
+
+func TestLabResetInterceptor(t *testing.T) {
+	host := "http://sec02.lab.es.aau.dk"
+	knownSession := "known_session"
+	page := `<html><body><div class="jumbotron"><h1>Challenges</h1></div></body></html>`
+
+	tt := []struct {
+		name    string
+		session string
+		buttons bool
+	}{
+		{name: "Logged in", session: knownSession, buttons: true},
+		{name: "Unknown session", session: "unknown"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := store.NewTeamStore()
+			team := store.NewTeam("some@email.com", "name_goes_here", "passhere")
+			if err := ts.CreateTeam(team); err != nil {
+				t.Fatalf("expected to be able to create team")
+			}
+
+			if err := ts.CreateTokenForTeam(knownSession, team); err != nil {
+				t.Fatalf("expected to be able to create token for team")
+			}
+
+			req := httptest.NewRequest(http.MethodGet, host+"/challenges", nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: tc.session})
+
+			exercises := []store.Tag{"sql", "xss"}
+			interceptor := ctfd.NewLabResetInterceptor(ts, func() []store.Tag { return exercises })
+			if !interceptor.ValidRequest(req) {
+				t.Fatalf("no interception, despite expected intercept")
+			}
+
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(page))
+			})
+
+			w := httptest.NewRecorder()
+			interceptor.Intercept(testHandler).ServeHTTP(w, req)
+
+			doc, err := goquery.NewDocumentFromReader(w.Body)
+			if err != nil {
+				t.Fatalf("unable to read response body as html: %s", err)
+			}
+
+			forms := doc.Find("#lab-reset form")
+			if !tc.buttons {
+				if forms.Length() != 0 {
+					t.Fatalf("expected no reset buttons, but received %d forms", forms.Length())
+				}
+				return
+			}
+
+			if forms.Length() != 2 {
+				t.Fatalf("expected two reset forms, but received: %d", forms.Length())
+			}
+
+			if action, _ := forms.First().Attr("action"); action != ctfd.LabResetExercisePath {
+				t.Fatalf("expected form to post to %s, but posts to: %s", ctfd.LabResetExercisePath, action)
+			}
+
+			if n := doc.Find("#lab-reset option").Length(); n != 2 {
+				t.Fatalf("expected an option per exercise, but received: %d", n)
+			}
+
+			token := ctfd.LabResetToken(knownSession)
+			forms.Each(func(_ int, form *goquery.Selection) {
+				field := form.Find(fmt.Sprintf("input[name=%q]", ctfd.LabResetTokenField))
+				if v, _ := field.Attr("value"); v != token {
+					t.Fatalf("expected form to contain token of session, but received: %q", v)
+				}
+			})
+
+			// exercises added to the event afterwards can be reset as well
+			exercises = append(exercises, "csrf")
+			w = httptest.NewRecorder()
+			interceptor.Intercept(testHandler).ServeHTTP(w, req)
+
+			doc, err = goquery.NewDocumentFromReader(w.Body)
+			if err != nil {
+				t.Fatalf("unable to read response body as html: %s", err)
+			}
+
+			if n := doc.Find("#lab-reset option").Length(); n != 3 {
+				t.Fatalf("expected an option per exercise after adding one, but received: %d", n)
+			}
+		})
+	}
+}
+
 func TestLoginInterception(t *testing.T) {
 	host := "http://sec02.lab.es.aau.dk"
 	knownEmail := "some@email.dk"
@@ -537,6 +744,71 @@ func TestLoginInterception(t *testing.T) {
 
 }
 
+func TestLoginInterceptionUpgradesLegacyPassword(t *testing.T) {
+	host := "http://sec02.lab.es.aau.dk"
+	knownEmail := "some@email.dk"
+	password := "secret_password"
+
+	ts := store.NewTeamStore()
+	team := store.NewTeam(knownEmail, "name_goes_here", password)
+	team.HashedPassword = fmt.Sprintf("%x", sha256.Sum256([]byte(password)))
+	team.CTFdPassword = ""
+	if err := ts.CreateTeam(team); err != nil {
+		t.Fatalf("expected to be able to create team")
+	}
+
+	var loginPassword, newPassword, confirm string
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/login":
+			loginPassword = r.FormValue("password")
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "secret-cookie"})
+		case r.URL.Path == "/profile" && r.Method == http.MethodGet:
+			w.Write([]byte(`<script>var csrf_nonce = "random_string";</script>`))
+		case r.URL.Path == "/profile" && r.Method == http.MethodPost:
+			newPassword = r.FormValue("password")
+			confirm = r.FormValue("confirm")
+			w.WriteHeader(http.StatusFound)
+		}
+	})
+
+	f := url.Values{
+		"name":     {knownEmail},
+		"password": {password},
+		"nonce":    {"random_string"},
+	}
+	req := httptest.NewRequest(http.MethodPost, host+"/login", strings.NewReader(f.Encode()))
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	w := httptest.NewRecorder()
+	ctfd.NewLoginInterceptor(ts).Intercept(testHandler).ServeHTTP(w, req)
+
+	if loginPassword != team.HashedPassword {
+		t.Fatalf("expected legacy password hash to be used for login")
+	}
+
+	if confirm != team.HashedPassword {
+		t.Fatalf("expected legacy password hash to confirm password change")
+	}
+
+	upgraded, err := ts.GetTeamByEmail(knownEmail)
+	if err != nil {
+		t.Fatalf("expected no error when fetching team: %s", err)
+	}
+
+	if upgraded.HasLegacyPassword() {
+		t.Fatalf("expected password of team to be upgraded")
+	}
+
+	if !upgraded.IsCorrectPassword(password) {
+		t.Fatalf("expected upgraded password to match original password")
+	}
+
+	if upgraded.CTFdPassword == "" || upgraded.CTFdPassword != newPassword {
+		t.Fatalf("expected CTFd password (%s) to be stored for team, received: %s", newPassword, upgraded.CTFdPassword)
+	}
+}
+
 func TestSelectorHtml(t *testing.T) {
 	s := ctfd.NewSelector("Age", "age", []string{"0-14", "15-21", "22-30", "30-50", "51+"})
 	htmlStr := s.Html()
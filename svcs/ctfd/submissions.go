@@ -0,0 +1,112 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package ctfd
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+const (
+	AttemptCorrect     = "correct"
+	AttemptIncorrect   = "incorrect"
+	AttemptWrongFormat = "wrong-format"
+	AttemptThrottled   = "throttled"
+
+	// status used by CTFd when flags are submitted too fast
+	throttledStatus  = 3
+	throttledMessage = "You're submitting flags too fast. Slow down."
+)
+
+// Attempt is a flag submitted by a team
+type Attempt struct {
+	Tag    store.Tag
+	Flag   string
+	Result string
+	Time   time.Time
+}
+
+// SubmissionLimiter throttles the flag submissions of teams using sliding
+// windows, and passes every attempt on to its hooks
+type SubmissionLimiter struct {
+	m     sync.Mutex
+	conf  store.SubmissionConfig
+	teams map[string][]time.Time
+	chals map[string][]time.Time
+	hooks []func(store.Team, Attempt)
+}
+
+func NewSubmissionLimiter(conf store.SubmissionConfig, hooks ...func(store.Team, Attempt)) *SubmissionLimiter {
+	return &SubmissionLimiter{
+		conf:  conf.WithDefaults(),
+		teams: map[string][]time.Time{},
+		chals: map[string][]time.Time{},
+		hooks: hooks,
+	}
+}
+
+// prune removes the submissions which have left the window
+func prune(times []time.Time, since time.Time) []time.Time {
+	i := 0
+	for i < len(times) && !times[i].After(since) {
+		i++
+	}
+
+	return times[i:]
+}
+
+// sweep prunes the submissions of every key, and removes the keys left
+// without any, so teams which stop submitting are forgotten
+func sweep(windows map[string][]time.Time, since time.Time) {
+	for k, times := range windows {
+		times = prune(times, since)
+		if len(times) == 0 {
+			delete(windows, k)
+			continue
+		}
+
+		windows[k] = times
+	}
+}
+
+// Allow reports whether the team can submit a flag for the challenge, and
+// counts the submission if so
+func (sl *SubmissionLimiter) Allow(teamId string, cid int, now time.Time) bool {
+	if sl == nil {
+		return true
+	}
+
+	sl.m.Lock()
+	defer sl.m.Unlock()
+
+	since := now.Add(-sl.conf.Window)
+	sweep(sl.teams, since)
+	sweep(sl.chals, since)
+
+	chalKey := fmt.Sprintf("%s-%d", teamId, cid)
+	team := sl.teams[teamId]
+	chal := sl.chals[chalKey]
+
+	allowed := len(team) < sl.conf.PerTeam && len(chal) < sl.conf.PerChallenge
+	if allowed {
+		sl.teams[teamId] = append(team, now)
+		sl.chals[chalKey] = append(chal, now)
+	}
+
+	return allowed
+}
+
+func (sl *SubmissionLimiter) Record(t store.Team, a Attempt) {
+	if sl == nil {
+		return
+	}
+
+	for _, h := range sl.hooks {
+		h(t, a)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package ctfd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/ctfd"
+)
+
+func TestSubmissionLimiter(t *testing.T) {
+	sl := ctfd.NewSubmissionLimiter(store.SubmissionConfig{
+		Window:       time.Minute,
+		PerTeam:      3,
+		PerChallenge: 2,
+	})
+
+	now := time.Now()
+	tt := []struct {
+		name    string
+		team    string
+		cid     int
+		offset  time.Duration
+		allowed bool
+	}{
+		{name: "First", team: "a", cid: 1, allowed: true},
+		{name: "Second", team: "a", cid: 1, offset: time.Second, allowed: true},
+		{name: "Challenge limit", team: "a", cid: 1, offset: 2 * time.Second},
+		{name: "Other challenge", team: "a", cid: 2, offset: 3 * time.Second, allowed: true},
+		{name: "Team limit", team: "a", cid: 3, offset: 4 * time.Second},
+		{name: "Other team", team: "b", cid: 1, offset: 5 * time.Second, allowed: true},
+		{name: "Window passed", team: "a", cid: 1, offset: time.Minute + 2*time.Second, allowed: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if allowed := sl.Allow(tc.team, tc.cid, now.Add(tc.offset)); allowed != tc.allowed {
+				t.Fatalf("expected submission to be allowed (%t), but got: %t", tc.allowed, allowed)
+			}
+		})
+	}
+}
+
+func TestCheckFlagInterceptorThrottle(t *testing.T) {
+	session := "known_session"
+	fp := ctfd.NewFlagPool()
+	fp.AddFlag(store.FlagConfig{Tag: "sql-1", EnvVar: "FLAG"}, 1)
+
+	ts := store.NewTeamStore()
+	team := store.NewTeam("some@email.com", "team", "secret")
+	if err := ts.CreateTeam(team); err != nil {
+		t.Fatalf("unable to create team: %s", err)
+	}
+
+	if err := ts.CreateTokenForTeam(session, team); err != nil {
+		t.Fatalf("unable to create token for team: %s", err)
+	}
+
+	var attempts []ctfd.Attempt
+	sl := ctfd.NewSubmissionLimiter(store.SubmissionConfig{PerChallenge: 1}, func(_ store.Team, a ctfd.Attempt) {
+		attempts = append(attempts, a)
+	})
+
+	forwarded := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded++
+		w.Write([]byte(`{"message":"Incorrect", "status": 0}`))
+	})
+	handler := ctfd.NewCheckFlagInterceptor(ts, fp, sl).Intercept(next)
+
+	for i := 0; i < 2; i++ {
+		f := url.Values{"key": {fmt.Sprintf("wrong-%d", i)}, "nonce": {"nonce"}}
+		req := httptest.NewRequest(http.MethodPost, "http://localhost/chal/1", strings.NewReader(f.Encode()))
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session", Value: session})
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		var resp struct {
+			Status int `json:"status"`
+		}
+		if err := json.NewDecoder(w.Result().Body).Decode(&resp); err != nil {
+			t.Fatalf("unable to read json response body: %s", err)
+		}
+
+		if i == 1 && resp.Status != 3 {
+			t.Fatalf("expected throttled status, got: %d", resp.Status)
+		}
+	}
+
+	if forwarded != 1 {
+		t.Fatalf("expected only the first submission to be forwarded, got: %d", forwarded)
+	}
+
+	if len(attempts) != 2 || attempts[0].Result != ctfd.AttemptIncorrect || attempts[1].Result != ctfd.AttemptThrottled {
+		t.Fatalf("unexpected attempts: %+v", attempts)
+	}
+
+	if attempts[0].Tag != "sql-1" {
+		t.Fatalf("expected attempt to have tag of challenge, got: %s", attempts[0].Tag)
+	}
+}
+
+func TestCheckFlagInterceptorWrongFormatNotThrottled(t *testing.T) {
+	session := "known_session"
+	fp := ctfd.NewFlagPool()
+	fp.AddFlag(store.FlagConfig{Tag: "sql-1", EnvVar: "FLAG", Format: "HKN{%s}"}, 1)
+
+	ts := store.NewTeamStore()
+	team := store.NewTeam("some@email.com", "team", "secret")
+	if err := ts.CreateTeam(team); err != nil {
+		t.Fatalf("unable to create team: %s", err)
+	}
+
+	if err := ts.CreateTokenForTeam(session, team); err != nil {
+		t.Fatalf("unable to create token for team: %s", err)
+	}
+
+	var attempts []ctfd.Attempt
+	sl := ctfd.NewSubmissionLimiter(store.SubmissionConfig{PerChallenge: 1}, func(_ store.Team, a ctfd.Attempt) {
+		attempts = append(attempts, a)
+	})
+
+	forwarded := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded++
+		w.Write([]byte(`{"message":"Incorrect", "status": 0}`))
+	})
+	handler := ctfd.NewCheckFlagInterceptor(ts, fp, sl).Intercept(next)
+
+	// wrong formats are rejected without using the single allowed submission
+	for _, flag := range []string{"wrong-1", "wrong-2", "HKN{wrong}"} {
+		f := url.Values{"key": {flag}, "nonce": {"nonce"}}
+		req := httptest.NewRequest(http.MethodPost, "http://localhost/chal/1", strings.NewReader(f.Encode()))
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session", Value: session})
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if forwarded != 1 {
+		t.Fatalf("expected the correctly formatted submission to be forwarded, got: %d", forwarded)
+	}
+
+	expected := []string{ctfd.AttemptWrongFormat, ctfd.AttemptWrongFormat, ctfd.AttemptIncorrect}
+	if len(attempts) != len(expected) {
+		t.Fatalf("unexpected attempts: %+v", attempts)
+	}
+
+	for i, a := range attempts {
+		if a.Result != expected[i] {
+			t.Fatalf("expected attempt %d to be %s, got: %s", i, expected[i], a.Result)
+		}
+	}
+}
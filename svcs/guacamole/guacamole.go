@@ -90,7 +90,10 @@ func (ge *GuacError) Error() string {
 type Guacamole interface {
 	io.Closer
 	Start(context.Context) error
+	Stop() error
 	CreateUser(username, password string) error
+	UpdateUserPassword(username, password string) error
+	DeleteUser(username string) error
 	CreateRDPConn(opts CreateRDPConnOpts) error
 	GetAdminPass() string
 	RawLogin(username, password string) ([]byte, error)
@@ -218,7 +221,7 @@ func (guac *guacamole) create(ctx context.Context) error {
 	}
 
 	guac.containers = containers
-	guac.stop()
+	guac.Stop()
 
 	return nil
 }
@@ -232,7 +235,7 @@ func (guac *guacamole) Start(ctx context.Context) error {
 	return nil
 }
 
-func (guac *guacamole) stop() error {
+func (guac *guacamole) Stop() error {
 	for _, container := range guac.containers {
 		if err := container.Stop(); err != nil {
 			return err
@@ -500,6 +503,71 @@ func (guac *guacamole) CreateUser(username, password string) error {
 	return nil
 }
 
+func (guac *guacamole) UpdateUserPassword(username, password string) error {
+	action := func(t string) (*http.Response, error) {
+		data := createUserInput{
+			Username: username,
+			Password: password,
+		}
+		jsonData, _ := json.Marshal(data)
+		endpoint := fmt.Sprintf("%s/guacamole/api/session/data/mysql/users/%s?token=%s", guac.baseUrl(), username, t)
+
+		req, err := http.NewRequest("PUT", endpoint, bytes.NewBuffer(jsonData))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		return guac.client.Do(req)
+	}
+
+	if err := guac.authAction("update user password", action, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUser deletes a user along with the connections it has access to
+func (guac *guacamole) DeleteUser(username string) error {
+	var perms struct {
+		ConnectionPermissions map[string][]string `json:"connectionPermissions"`
+	}
+
+	get := func(t string) (*http.Response, error) {
+		endpoint := fmt.Sprintf("%s/guacamole/api/session/data/mysql/users/%s/permissions?token=%s", guac.baseUrl(), username, t)
+		return guac.client.Get(endpoint)
+	}
+
+	if err := guac.authAction("get user permissions", get, &perms); err != nil {
+		return err
+	}
+
+	del := func(path string) func(string) (*http.Response, error) {
+		return func(t string) (*http.Response, error) {
+			endpoint := fmt.Sprintf("%s/guacamole/api/session/data/mysql/%s?token=%s", guac.baseUrl(), path, t)
+			req, err := http.NewRequest("DELETE", endpoint, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			return guac.client.Do(req)
+		}
+	}
+
+	for id := range perms.ConnectionPermissions {
+		if err := guac.authAction("delete rdp connection", del("connections/"+id), nil); err != nil {
+			return err
+		}
+	}
+
+	if err := guac.authAction("delete user", del("users/"+username), nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (guac *guacamole) logout() error {
 	action := func(t string) (*http.Response, error) {
 		endpoint := guac.baseUrl() + "/guacamole/api/tokens/" + t
@@ -159,6 +159,12 @@ func (us *GuacUserStore) CreateUserForTeam(tid string, u GuacUser) {
 	us.teams[tid] = u
 }
 
+func (us *GuacUserStore) DeleteUserForTeam(tid string) {
+	us.m.Lock()
+	defer us.m.Unlock()
+	delete(us.teams, tid)
+}
+
 func (us *GuacUserStore) GetUserForTeam(tid string) (*GuacUser, error) {
 	us.m.RLock()
 	defer us.m.RUnlock()
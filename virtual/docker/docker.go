@@ -30,6 +30,9 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// images committed from containers are stored in a repository per container
+const snapshotRepo = "hkn-snapshot"
+
 var (
 	DefaultClient     *docker.Client
 	DefaultLinkBridge *defaultBridge
@@ -121,7 +124,9 @@ type Identifier interface {
 type Container interface {
 	Identifier
 	virtual.Instance
+	virtual.ResourceResizer
 	BridgeAlias(string) (string, error)
+	Commit(context.Context, string) (string, error)
 }
 
 type ContainerConfig struct {
@@ -439,10 +444,83 @@ func (c *container) Close() error {
 	return nil
 }
 
+// SetRAM changes the memory limit of the container without restarting it
+func (c *container) SetRAM(mb uint) error {
+	if mb < 50 {
+		return TooLowMemErr
+	}
+
+	// the swap limit is lifted, as docker refuses a memory limit above it
+	if err := DefaultClient.UpdateContainer(c.id, docker.UpdateContainerOptions{
+		Memory:     int(mb) * 1024 * 1024,
+		MemorySwap: -1,
+	}); err != nil {
+		return err
+	}
+
+	if c.conf.Resources == nil {
+		c.conf.Resources = &Resources{}
+	}
+	c.conf.Resources.MemoryMB = mb
+
+	return nil
+}
+
+// SetCPU changes the CPU quota of the container without restarting it
+func (c *container) SetCPU(cpu float64) error {
+	if err := DefaultClient.UpdateContainer(c.id, docker.UpdateContainerOptions{
+		CPUPeriod: 100000,
+		CPUQuota:  int(100000 * cpu),
+	}); err != nil {
+		return err
+	}
+
+	if c.conf.Resources == nil {
+		c.conf.Resources = &Resources{}
+	}
+	c.conf.Resources.CPU = cpu
+
+	return nil
+}
+
 func (c *container) BridgeAlias(alias string) (string, error) {
 	return DefaultLinkBridge.connect(c.id, alias)
 }
 
+// Commit stores the file system of the container as an image tagged with
+// the given name, and returns the name of the image. Volumes are not part
+// of the image.
+func (c *container) Commit(ctx context.Context, tag string) (string, error) {
+	if c.id == "" {
+		return "", ContNotCreatedErr
+	}
+
+	img := Image{
+		Repo: snapshotRepo + "-" + c.id[0:12],
+		Tag:  tag,
+	}
+
+	if _, err := DefaultClient.CommitContainer(docker.CommitContainerOptions{
+		Container:  c.id,
+		Repository: img.Repo,
+		Tag:        img.Tag,
+		Context:    ctx,
+	}); err != nil {
+		return "", err
+	}
+
+	log.Debug().
+		Str("ID", c.id[0:8]).
+		Str("Image", img.String()).
+		Msg("Committed container")
+
+	return img.String(), nil
+}
+
+func RemoveImage(name string) error {
+	return DefaultClient.RemoveImage(name)
+}
+
 type network struct {
 	net       *docker.Network
 	subnet    string
@@ -814,6 +892,13 @@ func parseImage(img string) Image {
 	}
 }
 
+// KnownRegistry reports whether images can be pulled from the registry of
+// the image, which requires credentials unless the image is public
+func KnownRegistry(img string) bool {
+	_, ok := Registries[parseImage(img).Registry]
+	return ok
+}
+
 func getDockerHostIP() (string, error) {
 	i, err := net.InterfaceByName("docker0")
 	if err != nil {
@@ -0,0 +1,29 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package vbox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCpuArgs(t *testing.T) {
+	tt := []struct {
+		cpu      float64
+		expected string
+	}{
+		{cpu: 2, expected: "--cpus 2 --cpuexecutioncap 100"},
+		{cpu: 1.5, expected: "--cpus 2 --cpuexecutioncap 75"},
+		{cpu: 0.5, expected: "--cpus 1 --cpuexecutioncap 50"},
+		{cpu: 0.001, expected: "--cpus 1 --cpuexecutioncap 1"},
+	}
+
+	for _, tc := range tt {
+		args := strings.Join(cpuArgs(tc.cpu), " ")
+		if args != tc.expected {
+			t.Fatalf("expected arguments for %v cpus to be \"%s\", but received: %s", tc.cpu, tc.expected, args)
+		}
+	}
+}
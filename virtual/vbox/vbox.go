@@ -39,6 +39,11 @@ const (
 	vboxCtrlVM       = "controlvm"
 	vboxUnregisterVM = "unregistervm"
 	vboxShowVMInfo   = "showvminfo"
+
+	modifyRetries    = 5
+	modifyRetryDelay = time.Second
+
+	liveSnapshotTimeout = 2 * time.Minute
 )
 
 func init() {
@@ -56,7 +61,9 @@ func (err *VBoxErr) Error() string {
 
 type VM interface {
 	virtual.Instance
+	virtual.ResourceResizer
 	Snapshot(string) error
+	RestoreSnapshot(string) error
 	LinkedClone(context.Context, string, ...VMOpt) (VM, error)
 }
 
@@ -244,9 +251,29 @@ func SetLocalRDP(ip string, port uint) VMOpt {
 	}
 }
 
-func SetCPU(cores uint) VMOpt {
+// cpuArgs translates an amount of CPUs into whole cores and an execution
+// cap, e.g. 1.5 CPUs becomes two cores each capped at 75%.
+func cpuArgs(cpu float64) []string {
+	cores := math.Ceil(cpu)
+	if cores < 1 {
+		cores = 1
+	}
+
+	execCap := int(math.Round(cpu / cores * 100))
+	if execCap < 1 {
+		execCap = 1
+	}
+
+	if execCap > 100 {
+		execCap = 100
+	}
+
+	return []string{"--cpus", fmt.Sprintf("%d", int(cores)), "--cpuexecutioncap", strconv.Itoa(execCap)}
+}
+
+func SetCPU(cpu float64) VMOpt {
 	return func(ctx context.Context, vm *vm) error {
-		_, err := VBoxCmdContext(ctx, vboxModVM, vm.id, "--cpus", fmt.Sprintf("%d", cores))
+		_, err := VBoxCmdContext(ctx, vboxModVM, append([]string{vm.id}, cpuArgs(cpu)...)...)
 		return err
 	}
 }
@@ -258,6 +285,48 @@ func SetRAM(mb uint) VMOpt {
 	}
 }
 
+// SetRAM changes the memory of the VM, power cycling it if running
+func (vm *vm) SetRAM(mb uint) error {
+	return vm.modify(SetRAM(mb))
+}
+
+// SetCPU changes the CPUs of the VM, power cycling it if running
+func (vm *vm) SetCPU(cpu float64) error {
+	return vm.modify(SetCPU(cpu))
+}
+
+func (vm *vm) modify(opt VMOpt) error {
+	ctx := context.Background()
+
+	// a VM which has crashed cannot be powered off, in which case the
+	// modification is attempted regardless
+	wasRunning := vm.running
+	if wasRunning {
+		if err := vm.Stop(); err != nil {
+			log.Debug().Err(err).Str("ID", vm.id).Msg("Unable to stop VM before modification")
+		}
+	}
+
+	// the VM remains locked for a short while after being powered off
+	var err error
+	for i := 0; i < modifyRetries; i++ {
+		if err = opt(ctx, vm); err == nil {
+			break
+		}
+
+		time.Sleep(modifyRetryDelay)
+	}
+
+	// always attempt to bring the VM back, even if the modification failed
+	if wasRunning {
+		if startErr := vm.Start(ctx); startErr != nil && err == nil {
+			err = startErr
+		}
+	}
+
+	return err
+}
+
 func (vm *vm) ensureStopped(ctx context.Context) (func(), error) {
 	wasRunning := vm.running
 	if vm.running {
@@ -273,7 +342,13 @@ func (vm *vm) ensureStopped(ctx context.Context) (func(), error) {
 	}, nil
 }
 func (vm *vm) Snapshot(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// a snapshot of a running VM includes its memory, which takes a while
+	timeout := 5 * time.Second
+	if vm.running {
+		timeout = liveSnapshotTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	_, err := VBoxCmdContext(ctx, "snapshot", vm.id, "take", name)
@@ -284,6 +359,18 @@ func (vm *vm) Snapshot(name string) error {
 	return nil
 }
 
+// RestoreSnapshot returns the VM to the named snapshot, power cycling it if running
+func (vm *vm) RestoreSnapshot(name string) error {
+	return vm.modify(restoreSnapshot(name))
+}
+
+func restoreSnapshot(name string) VMOpt {
+	return func(ctx context.Context, vm *vm) error {
+		_, err := VBoxCmdContext(ctx, "snapshot", vm.id, "restore", name)
+		return err
+	}
+}
+
 func (v *vm) LinkedClone(ctx context.Context, snapshot string, vmOpts ...VMOpt) (VM, error) {
 	newID := strings.Replace(uuid.New().String(), "-", "", -1)
 	_, err := VBoxCmdContext(ctx, "clonevm", v.id, "--snapshot", snapshot, "--options", "link", "--name", newID, "--register")
@@ -357,6 +444,14 @@ func (lib *vBoxLibrary) getPathFromFile(file string) string {
 func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig, vmOpts ...VMOpt) (VM, error) {
 	path := lib.getPathFromFile(conf.Image)
 
+	if conf.CPU != 0 {
+		vmOpts = append(vmOpts, SetCPU(conf.CPU))
+	}
+
+	if conf.MemoryMB != 0 {
+		vmOpts = append(vmOpts, SetRAM(conf.MemoryMB))
+	}
+
 	lib.m.Lock()
 
 	pathLock, ok := lib.locks[path]
@@ -377,10 +472,8 @@ func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig,
 
 	vm, ok := lib.known[path]
 	if ok {
-		return vm.LinkedClone(ctx, "origin", vmOpts...) // if ok==true then VM will be linked without the ram value which is exist on configuration file
-		// vbox.SetRAM(conf.memoryMB) on addFrontend function in lab.go fixes the problem...
+		return vm.LinkedClone(ctx, "origin", vmOpts...)
 	}
-	// if ok==false, then following codes will be run, in that case there will be no problem because at the end instance returns with specified VMOpts parameter.
 	sum, err := checksumOfFile(path)
 	if err != nil {
 		return nil, err
@@ -405,14 +498,6 @@ func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig,
 	lib.known[path] = vm
 	lib.m.Unlock()
 
-	if conf.CPU != 0 {
-		vmOpts = append(vmOpts, SetCPU(uint(math.Ceil(conf.CPU))))
-	}
-
-	if conf.MemoryMB != 0 {
-		vmOpts = append(vmOpts, SetRAM(conf.MemoryMB))
-	}
-
 	instance, err := vm.LinkedClone(ctx, "origin", vmOpts...)
 	if err != nil {
 		return nil, err
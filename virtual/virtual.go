@@ -20,6 +20,17 @@ const (
 
 type State int
 
+func (s State) String() string {
+	switch s {
+	case Running:
+		return "running"
+	case Stopped:
+		return "stopped"
+	}
+
+	return "error"
+}
+
 type InstanceInfo struct {
 	Image string
 	Type  string
@@ -38,7 +49,7 @@ type Instance interface {
 
 type ResourceResizer interface {
 	SetRAM(uint) error
-	SetCPU(uint) error
+	SetCPU(float64) error
 }
 
 func GetAvailablePort() uint {
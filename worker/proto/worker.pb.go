@@ -0,0 +1,1234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: worker.proto
+
+package worker
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{0}
+}
+
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type StatusResponse struct {
+	CpuPercent           float32  `protobuf:"fixed32,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	Cpus                 int32    `protobuf:"varint,2,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	MemoryPercent        float32  `protobuf:"fixed32,3,opt,name=memoryPercent,proto3" json:"memoryPercent,omitempty"`
+	MemoryAvailableMB    int64    `protobuf:"varint,4,opt,name=memoryAvailableMB,proto3" json:"memoryAvailableMB,omitempty"`
+	Labs                 int32    `protobuf:"varint,5,opt,name=labs,proto3" json:"labs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
+func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()    {}
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{1}
+}
+
+func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
+}
+func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+}
+func (m *StatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse.Merge(m, src)
+}
+func (m *StatusResponse) XXX_Size() int {
+	return xxx_messageInfo_StatusResponse.Size(m)
+}
+func (m *StatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+
+func (m *StatusResponse) GetCpuPercent() float32 {
+	if m != nil {
+		return m.CpuPercent
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetCpus() int32 {
+	if m != nil {
+		return m.Cpus
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetMemoryPercent() float32 {
+	if m != nil {
+		return m.MemoryPercent
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetMemoryAvailableMB() int64 {
+	if m != nil {
+		return m.MemoryAvailableMB
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetLabs() int32 {
+	if m != nil {
+		return m.Labs
+	}
+	return 0
+}
+
+type CreateLabRequest struct {
+	// lab configuration in YAML
+	Config               []byte   `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateLabRequest) Reset()         { *m = CreateLabRequest{} }
+func (m *CreateLabRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateLabRequest) ProtoMessage()    {}
+func (*CreateLabRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{2}
+}
+
+func (m *CreateLabRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLabRequest.Unmarshal(m, b)
+}
+func (m *CreateLabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLabRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateLabRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLabRequest.Merge(m, src)
+}
+func (m *CreateLabRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateLabRequest.Size(m)
+}
+func (m *CreateLabRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLabRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLabRequest proto.InternalMessageInfo
+
+func (m *CreateLabRequest) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type CreateLabResponse struct {
+	Tag                  string                         `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	RdpHost              string                         `protobuf:"bytes,2,opt,name=rdpHost,proto3" json:"rdpHost,omitempty"`
+	RdpPorts             []uint32                       `protobuf:"varint,3,rep,packed,name=rdpPorts,proto3" json:"rdpPorts,omitempty"`
+	Challenges           []*CreateLabResponse_Challenge `protobuf:"bytes,4,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *CreateLabResponse) Reset()         { *m = CreateLabResponse{} }
+func (m *CreateLabResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateLabResponse) ProtoMessage()    {}
+func (*CreateLabResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{3}
+}
+
+func (m *CreateLabResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLabResponse.Unmarshal(m, b)
+}
+func (m *CreateLabResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLabResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateLabResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLabResponse.Merge(m, src)
+}
+func (m *CreateLabResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateLabResponse.Size(m)
+}
+func (m *CreateLabResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLabResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLabResponse proto.InternalMessageInfo
+
+func (m *CreateLabResponse) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *CreateLabResponse) GetRdpHost() string {
+	if m != nil {
+		return m.RdpHost
+	}
+	return ""
+}
+
+func (m *CreateLabResponse) GetRdpPorts() []uint32 {
+	if m != nil {
+		return m.RdpPorts
+	}
+	return nil
+}
+
+func (m *CreateLabResponse) GetChallenges() []*CreateLabResponse_Challenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+type CreateLabResponse_Challenge struct {
+	FlagTag              string   `protobuf:"bytes,1,opt,name=flagTag,proto3" json:"flagTag,omitempty"`
+	FlagValue            string   `protobuf:"bytes,2,opt,name=flagValue,proto3" json:"flagValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateLabResponse_Challenge) Reset()         { *m = CreateLabResponse_Challenge{} }
+func (m *CreateLabResponse_Challenge) String() string { return proto.CompactTextString(m) }
+func (*CreateLabResponse_Challenge) ProtoMessage()    {}
+func (*CreateLabResponse_Challenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{3, 0}
+}
+
+func (m *CreateLabResponse_Challenge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLabResponse_Challenge.Unmarshal(m, b)
+}
+func (m *CreateLabResponse_Challenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLabResponse_Challenge.Marshal(b, m, deterministic)
+}
+func (m *CreateLabResponse_Challenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLabResponse_Challenge.Merge(m, src)
+}
+func (m *CreateLabResponse_Challenge) XXX_Size() int {
+	return xxx_messageInfo_CreateLabResponse_Challenge.Size(m)
+}
+func (m *CreateLabResponse_Challenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLabResponse_Challenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLabResponse_Challenge proto.InternalMessageInfo
+
+func (m *CreateLabResponse_Challenge) GetFlagTag() string {
+	if m != nil {
+		return m.FlagTag
+	}
+	return ""
+}
+
+func (m *CreateLabResponse_Challenge) GetFlagValue() string {
+	if m != nil {
+		return m.FlagValue
+	}
+	return ""
+}
+
+type LabRequest struct {
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// snapshot name, exercise tag or instance id depending on the call
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image                string   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	MemoryMB             int64    `protobuf:"varint,4,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	Cpu                  float32  `protobuf:"fixed32,5,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabRequest) Reset()         { *m = LabRequest{} }
+func (m *LabRequest) String() string { return proto.CompactTextString(m) }
+func (*LabRequest) ProtoMessage()    {}
+func (*LabRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{4}
+}
+
+func (m *LabRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabRequest.Unmarshal(m, b)
+}
+func (m *LabRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabRequest.Marshal(b, m, deterministic)
+}
+func (m *LabRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabRequest.Merge(m, src)
+}
+func (m *LabRequest) XXX_Size() int {
+	return xxx_messageInfo_LabRequest.Size(m)
+}
+func (m *LabRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabRequest proto.InternalMessageInfo
+
+func (m *LabRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *LabRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LabRequest) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *LabRequest) GetMemoryMB() int64 {
+	if m != nil {
+		return m.MemoryMB
+	}
+	return 0
+}
+
+func (m *LabRequest) GetCpu() float32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+type ResizeFrontendsResponse struct {
+	Resized              int32    `protobuf:"varint,1,opt,name=resized,proto3" json:"resized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResizeFrontendsResponse) Reset()         { *m = ResizeFrontendsResponse{} }
+func (m *ResizeFrontendsResponse) String() string { return proto.CompactTextString(m) }
+func (*ResizeFrontendsResponse) ProtoMessage()    {}
+func (*ResizeFrontendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{5}
+}
+
+func (m *ResizeFrontendsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeFrontendsResponse.Unmarshal(m, b)
+}
+func (m *ResizeFrontendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeFrontendsResponse.Marshal(b, m, deterministic)
+}
+func (m *ResizeFrontendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeFrontendsResponse.Merge(m, src)
+}
+func (m *ResizeFrontendsResponse) XXX_Size() int {
+	return xxx_messageInfo_ResizeFrontendsResponse.Size(m)
+}
+func (m *ResizeFrontendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeFrontendsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeFrontendsResponse proto.InternalMessageInfo
+
+func (m *ResizeFrontendsResponse) GetResized() int32 {
+	if m != nil {
+		return m.Resized
+	}
+	return 0
+}
+
+type ListInstancesResponse struct {
+	Instances            []*ListInstancesResponse_Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ListInstancesResponse) Reset()         { *m = ListInstancesResponse{} }
+func (m *ListInstancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInstancesResponse) ProtoMessage()    {}
+func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{6}
+}
+
+func (m *ListInstancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInstancesResponse.Unmarshal(m, b)
+}
+func (m *ListInstancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInstancesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListInstancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInstancesResponse.Merge(m, src)
+}
+func (m *ListInstancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInstancesResponse.Size(m)
+}
+func (m *ListInstancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInstancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInstancesResponse proto.InternalMessageInfo
+
+func (m *ListInstancesResponse) GetInstances() []*ListInstancesResponse_Instance {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+type ListInstancesResponse_Instance struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	State                int32    `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInstancesResponse_Instance) Reset()         { *m = ListInstancesResponse_Instance{} }
+func (m *ListInstancesResponse_Instance) String() string { return proto.CompactTextString(m) }
+func (*ListInstancesResponse_Instance) ProtoMessage()    {}
+func (*ListInstancesResponse_Instance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{6, 0}
+}
+
+func (m *ListInstancesResponse_Instance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInstancesResponse_Instance.Unmarshal(m, b)
+}
+func (m *ListInstancesResponse_Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInstancesResponse_Instance.Marshal(b, m, deterministic)
+}
+func (m *ListInstancesResponse_Instance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInstancesResponse_Instance.Merge(m, src)
+}
+func (m *ListInstancesResponse_Instance) XXX_Size() int {
+	return xxx_messageInfo_ListInstancesResponse_Instance.Size(m)
+}
+func (m *ListInstancesResponse_Instance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInstancesResponse_Instance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInstancesResponse_Instance proto.InternalMessageInfo
+
+func (m *ListInstancesResponse_Instance) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ListInstancesResponse_Instance) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListInstancesResponse_Instance) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListInstancesResponse_Instance) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+type ExercisesRequest struct {
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// exercises in YAML when adding
+	Exercises []byte `protobuf:"bytes,2,opt,name=exercises,proto3" json:"exercises,omitempty"`
+	// exercise tags when removing
+	ExerciseTags         []string `protobuf:"bytes,3,rep,name=exerciseTags,proto3" json:"exerciseTags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExercisesRequest) Reset()         { *m = ExercisesRequest{} }
+func (m *ExercisesRequest) String() string { return proto.CompactTextString(m) }
+func (*ExercisesRequest) ProtoMessage()    {}
+func (*ExercisesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{7}
+}
+
+func (m *ExercisesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExercisesRequest.Unmarshal(m, b)
+}
+func (m *ExercisesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExercisesRequest.Marshal(b, m, deterministic)
+}
+func (m *ExercisesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExercisesRequest.Merge(m, src)
+}
+func (m *ExercisesRequest) XXX_Size() int {
+	return xxx_messageInfo_ExercisesRequest.Size(m)
+}
+func (m *ExercisesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExercisesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExercisesRequest proto.InternalMessageInfo
+
+func (m *ExercisesRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *ExercisesRequest) GetExercises() []byte {
+	if m != nil {
+		return m.Exercises
+	}
+	return nil
+}
+
+func (m *ExercisesRequest) GetExerciseTags() []string {
+	if m != nil {
+		return m.ExerciseTags
+	}
+	return nil
+}
+
+type ChallengesResponse struct {
+	Challenges           []*CreateLabResponse_Challenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ChallengesResponse) Reset()         { *m = ChallengesResponse{} }
+func (m *ChallengesResponse) String() string { return proto.CompactTextString(m) }
+func (*ChallengesResponse) ProtoMessage()    {}
+func (*ChallengesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ff6184b07e587a, []int{8}
+}
+
+func (m *ChallengesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChallengesResponse.Unmarshal(m, b)
+}
+func (m *ChallengesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChallengesResponse.Marshal(b, m, deterministic)
+}
+func (m *ChallengesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengesResponse.Merge(m, src)
+}
+func (m *ChallengesResponse) XXX_Size() int {
+	return xxx_messageInfo_ChallengesResponse.Size(m)
+}
+func (m *ChallengesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengesResponse proto.InternalMessageInfo
+
+func (m *ChallengesResponse) GetChallenges() []*CreateLabResponse_Challenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "worker.Empty")
+	proto.RegisterType((*StatusResponse)(nil), "worker.StatusResponse")
+	proto.RegisterType((*CreateLabRequest)(nil), "worker.CreateLabRequest")
+	proto.RegisterType((*CreateLabResponse)(nil), "worker.CreateLabResponse")
+	proto.RegisterType((*CreateLabResponse_Challenge)(nil), "worker.CreateLabResponse.Challenge")
+	proto.RegisterType((*LabRequest)(nil), "worker.LabRequest")
+	proto.RegisterType((*ResizeFrontendsResponse)(nil), "worker.ResizeFrontendsResponse")
+	proto.RegisterType((*ListInstancesResponse)(nil), "worker.ListInstancesResponse")
+	proto.RegisterType((*ListInstancesResponse_Instance)(nil), "worker.ListInstancesResponse.Instance")
+	proto.RegisterType((*ExercisesRequest)(nil), "worker.ExercisesRequest")
+	proto.RegisterType((*ChallengesResponse)(nil), "worker.ChallengesResponse")
+}
+
+func init() { proto.RegisterFile("worker.proto", fileDescriptor_e4ff6184b07e587a) }
+
+var fileDescriptor_e4ff6184b07e587a = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
+	0x10, 0x8e, 0xf3, 0xd7, 0x78, 0x9a, 0xf4, 0x67, 0x75, 0x4e, 0x8f, 0x8f, 0xd5, 0x73, 0x88, 0x16,
+	0x84, 0x22, 0x84, 0x52, 0xb5, 0x05, 0xee, 0xdb, 0xb4, 0x55, 0x2b, 0x15, 0xa9, 0xda, 0x54, 0x20,
+	0xb8, 0xdb, 0xd8, 0xd3, 0xd4, 0xc2, 0xf1, 0x1a, 0xef, 0xa6, 0x50, 0x9e, 0x89, 0x17, 0xe0, 0x81,
+	0x78, 0x02, 0x5e, 0x00, 0x79, 0x9d, 0xb5, 0x93, 0x26, 0x45, 0x29, 0xdc, 0xcd, 0x37, 0xde, 0x6f,
+	0x67, 0xe6, 0x9b, 0x99, 0x35, 0x34, 0x3f, 0x89, 0xe4, 0x03, 0x26, 0xdd, 0x38, 0x11, 0x4a, 0x90,
+	0x7a, 0x86, 0xe8, 0x0a, 0xd4, 0x8e, 0x47, 0xb1, 0xba, 0xa5, 0x5f, 0x2d, 0x58, 0xeb, 0x2b, 0xae,
+	0xc6, 0x92, 0xa1, 0x8c, 0x45, 0x24, 0x91, 0xfc, 0x0f, 0xe0, 0xc5, 0xe3, 0x0b, 0x4c, 0x3c, 0x8c,
+	0x94, 0x63, 0xb5, 0xad, 0x4e, 0x99, 0x4d, 0x79, 0x08, 0x81, 0xaa, 0x17, 0x8f, 0xa5, 0x53, 0x6e,
+	0x5b, 0x9d, 0x1a, 0xd3, 0x36, 0x79, 0x02, 0xad, 0x11, 0x8e, 0x44, 0x72, 0x6b, 0x68, 0x15, 0x4d,
+	0x9b, 0x75, 0x92, 0xe7, 0xb0, 0x99, 0x39, 0x0e, 0x6e, 0x78, 0x10, 0xf2, 0x41, 0x88, 0xaf, 0x0f,
+	0x9d, 0x6a, 0xdb, 0xea, 0x54, 0xd8, 0xfc, 0x87, 0x34, 0x4e, 0xc8, 0x07, 0xd2, 0xa9, 0x65, 0x71,
+	0x52, 0x9b, 0x3e, 0x83, 0x8d, 0x5e, 0x82, 0x5c, 0xe1, 0x39, 0x1f, 0x30, 0xfc, 0x38, 0x46, 0xa9,
+	0xc8, 0x16, 0xd4, 0x3d, 0x11, 0x5d, 0x05, 0x43, 0x9d, 0x6b, 0x93, 0x4d, 0x10, 0xfd, 0x6e, 0xc1,
+	0xe6, 0xd4, 0xe1, 0x49, 0x75, 0x1b, 0x50, 0x51, 0x3c, 0x3b, 0x6a, 0xb3, 0xd4, 0x24, 0x0e, 0xac,
+	0x24, 0x7e, 0x7c, 0x2a, 0xa4, 0xd2, 0x25, 0xd9, 0xcc, 0x40, 0xe2, 0x42, 0x23, 0xf1, 0xe3, 0x0b,
+	0x91, 0x28, 0xe9, 0x54, 0xda, 0x95, 0x4e, 0x8b, 0xe5, 0x98, 0xf4, 0x00, 0xbc, 0x6b, 0x1e, 0x86,
+	0x18, 0x0d, 0x51, 0x3a, 0xd5, 0x76, 0xa5, 0xb3, 0xba, 0xf7, 0xb8, 0x3b, 0x11, 0x7b, 0x2e, 0x6c,
+	0xb7, 0x67, 0xce, 0xb2, 0x29, 0x9a, 0xdb, 0x03, 0x3b, 0xff, 0x90, 0xe6, 0x71, 0x15, 0xf2, 0xe1,
+	0x65, 0x9e, 0x9d, 0x81, 0x64, 0x1b, 0xec, 0xd4, 0x7c, 0xc3, 0xc3, 0x31, 0x4e, 0x72, 0x2c, 0x1c,
+	0xf4, 0x06, 0x60, 0x4a, 0x8d, 0xf9, 0xfa, 0x08, 0x54, 0x23, 0x3e, 0x32, 0x44, 0x6d, 0x93, 0xbf,
+	0xa0, 0x16, 0x8c, 0xf8, 0x10, 0x75, 0x9f, 0x6c, 0x96, 0x81, 0xb4, 0xde, 0xac, 0x0d, 0x79, 0x5b,
+	0x72, 0x9c, 0xde, 0xeb, 0xc5, 0x63, 0xdd, 0x8c, 0x32, 0x4b, 0x4d, 0xba, 0x0f, 0xff, 0x30, 0x94,
+	0xc1, 0x17, 0x3c, 0x49, 0x44, 0xa4, 0x30, 0xf2, 0x8b, 0x11, 0x4a, 0x25, 0xd5, 0x9f, 0x7c, 0x9d,
+	0x48, 0x8d, 0x19, 0x48, 0xbf, 0x59, 0xf0, 0xf7, 0x79, 0x20, 0xd5, 0x59, 0x24, 0x15, 0x8f, 0x3c,
+	0x2c, 0x38, 0x47, 0x60, 0x07, 0xc6, 0xe9, 0x58, 0x5a, 0xcf, 0xa7, 0x46, 0xcf, 0x85, 0x8c, 0xae,
+	0xf1, 0xb0, 0x82, 0xe8, 0xbe, 0x87, 0x86, 0x71, 0x17, 0x45, 0x5a, 0xd3, 0x45, 0x12, 0xa8, 0xaa,
+	0xdb, 0x38, 0x97, 0x23, 0xb5, 0xc9, 0x1a, 0x94, 0x03, 0x7f, 0xa2, 0x45, 0x39, 0xf0, 0x53, 0xa6,
+	0x54, 0x5c, 0xa1, 0x56, 0xa1, 0xc6, 0x32, 0x40, 0xaf, 0x60, 0xe3, 0xf8, 0x33, 0x26, 0x5e, 0x20,
+	0x51, 0xde, 0x2f, 0xf7, 0x36, 0xd8, 0x68, 0x4e, 0xe9, 0x20, 0x4d, 0x56, 0x38, 0x08, 0x85, 0xa6,
+	0x01, 0x97, 0x7c, 0x98, 0x8d, 0x95, 0xcd, 0x66, 0x7c, 0xf4, 0x1d, 0x90, 0x7c, 0x2a, 0x0a, 0x7d,
+	0x66, 0x07, 0xce, 0xfa, 0xad, 0x81, 0xdb, 0xfb, 0x51, 0x87, 0xfa, 0x5b, 0x4d, 0x21, 0xbb, 0x50,
+	0xcf, 0x16, 0x9f, 0xb4, 0xcc, 0x2d, 0xfa, 0x49, 0x70, 0xb7, 0x0c, 0x9c, 0x7d, 0x17, 0x68, 0x89,
+	0x1c, 0x82, 0x9d, 0x07, 0x22, 0xce, 0x82, 0xd8, 0x5a, 0x13, 0xf7, 0xdf, 0x7b, 0xb3, 0xa2, 0x25,
+	0xb2, 0x03, 0x8d, 0xbe, 0xe2, 0x89, 0x4a, 0xaf, 0x20, 0x79, 0x7f, 0x0b, 0xf2, 0x6c, 0x32, 0xb4,
+	0x44, 0xba, 0xb0, 0xd2, 0x57, 0x22, 0x5e, 0xfa, 0xfc, 0x2e, 0x00, 0x43, 0xf9, 0xa0, 0x10, 0x3b,
+	0xd0, 0xe8, 0x85, 0x42, 0xe2, 0xd2, 0x84, 0x97, 0xb0, 0xc6, 0x50, 0xa2, 0xca, 0x27, 0x7f, 0x39,
+	0xda, 0x0b, 0x68, 0x69, 0x9a, 0x99, 0xa2, 0xe5, 0x58, 0xaf, 0x60, 0x7d, 0x52, 0x50, 0x3e, 0xd9,
+	0x4b, 0xf1, 0x4e, 0x61, 0xfd, 0xce, 0x7e, 0x2e, 0xe4, 0x3d, 0x32, 0xbe, 0x7b, 0x96, 0x99, 0x96,
+	0xc8, 0x1e, 0xac, 0xf6, 0x23, 0x1e, 0xcb, 0x6b, 0xa1, 0x1e, 0xda, 0x06, 0x91, 0x2c, 0xaf, 0xea,
+	0x11, 0xb4, 0x66, 0x16, 0x7d, 0x21, 0xeb, 0xbf, 0x5f, 0xbe, 0x09, 0xb4, 0x44, 0x4e, 0xa0, 0x79,
+	0xe0, 0xfb, 0xf9, 0xa2, 0x16, 0x73, 0x7a, 0x77, 0x77, 0x5d, 0x37, 0x9f, 0xd3, 0xb9, 0x6d, 0xa3,
+	0x25, 0x72, 0x96, 0xca, 0x37, 0x12, 0x37, 0xf8, 0xc7, 0x57, 0x0d, 0xea, 0xfa, 0xe7, 0xbb, 0xff,
+	0x73, 0x00, 0x79, 0x9f, 0x5d, 0x4b, 0x8c, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerClient interface {
+	Status(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	CreateLab(ctx context.Context, in *CreateLabRequest, opts ...grpc.CallOption) (*CreateLabResponse, error)
+	StartLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	StopLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestartLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	CloseLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	ResetFrontends(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	ResetExercise(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestartInstance(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	ResizeFrontends(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*ResizeFrontendsResponse, error)
+	SnapshotLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestoreLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListInstances(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error)
+	AddExercises(ctx context.Context, in *ExercisesRequest, opts ...grpc.CallOption) (*ChallengesResponse, error)
+	RemoveExercises(ctx context.Context, in *ExercisesRequest, opts ...grpc.CallOption) (*ChallengesResponse, error)
+}
+
+type workerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) Status(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/worker.Worker/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) CreateLab(ctx context.Context, in *CreateLabRequest, opts ...grpc.CallOption) (*CreateLabResponse, error) {
+	out := new(CreateLabResponse)
+	err := c.cc.Invoke(ctx, "/worker.Worker/CreateLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) StartLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/StartLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) StopLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/StopLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) RestartLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/RestartLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) CloseLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/CloseLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ResetFrontends(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/ResetFrontends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ResetExercise(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/ResetExercise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) RestartInstance(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/RestartInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ResizeFrontends(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*ResizeFrontendsResponse, error) {
+	out := new(ResizeFrontendsResponse)
+	err := c.cc.Invoke(ctx, "/worker.Worker/ResizeFrontends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) SnapshotLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/SnapshotLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) RestoreLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Worker/RestoreLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ListInstances(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error) {
+	out := new(ListInstancesResponse)
+	err := c.cc.Invoke(ctx, "/worker.Worker/ListInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) AddExercises(ctx context.Context, in *ExercisesRequest, opts ...grpc.CallOption) (*ChallengesResponse, error) {
+	out := new(ChallengesResponse)
+	err := c.cc.Invoke(ctx, "/worker.Worker/AddExercises", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) RemoveExercises(ctx context.Context, in *ExercisesRequest, opts ...grpc.CallOption) (*ChallengesResponse, error) {
+	out := new(ChallengesResponse)
+	err := c.cc.Invoke(ctx, "/worker.Worker/RemoveExercises", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkerServer is the server API for Worker service.
+type WorkerServer interface {
+	Status(context.Context, *Empty) (*StatusResponse, error)
+	CreateLab(context.Context, *CreateLabRequest) (*CreateLabResponse, error)
+	StartLab(context.Context, *LabRequest) (*Empty, error)
+	StopLab(context.Context, *LabRequest) (*Empty, error)
+	RestartLab(context.Context, *LabRequest) (*Empty, error)
+	CloseLab(context.Context, *LabRequest) (*Empty, error)
+	ResetFrontends(context.Context, *LabRequest) (*Empty, error)
+	ResetExercise(context.Context, *LabRequest) (*Empty, error)
+	RestartInstance(context.Context, *LabRequest) (*Empty, error)
+	ResizeFrontends(context.Context, *LabRequest) (*ResizeFrontendsResponse, error)
+	SnapshotLab(context.Context, *LabRequest) (*Empty, error)
+	RestoreLab(context.Context, *LabRequest) (*Empty, error)
+	ListInstances(context.Context, *LabRequest) (*ListInstancesResponse, error)
+	AddExercises(context.Context, *ExercisesRequest) (*ChallengesResponse, error)
+	RemoveExercises(context.Context, *ExercisesRequest) (*ChallengesResponse, error)
+}
+
+// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
+}
+
+func (*UnimplementedWorkerServer) Status(ctx context.Context, req *Empty) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedWorkerServer) CreateLab(ctx context.Context, req *CreateLabRequest) (*CreateLabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLab not implemented")
+}
+func (*UnimplementedWorkerServer) StartLab(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLab not implemented")
+}
+func (*UnimplementedWorkerServer) StopLab(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopLab not implemented")
+}
+func (*UnimplementedWorkerServer) RestartLab(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartLab not implemented")
+}
+func (*UnimplementedWorkerServer) CloseLab(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLab not implemented")
+}
+func (*UnimplementedWorkerServer) ResetFrontends(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetFrontends not implemented")
+}
+func (*UnimplementedWorkerServer) ResetExercise(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetExercise not implemented")
+}
+func (*UnimplementedWorkerServer) RestartInstance(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartInstance not implemented")
+}
+func (*UnimplementedWorkerServer) ResizeFrontends(ctx context.Context, req *LabRequest) (*ResizeFrontendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeFrontends not implemented")
+}
+func (*UnimplementedWorkerServer) SnapshotLab(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotLab not implemented")
+}
+func (*UnimplementedWorkerServer) RestoreLab(ctx context.Context, req *LabRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLab not implemented")
+}
+func (*UnimplementedWorkerServer) ListInstances(ctx context.Context, req *LabRequest) (*ListInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (*UnimplementedWorkerServer) AddExercises(ctx context.Context, req *ExercisesRequest) (*ChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExercises not implemented")
+}
+func (*UnimplementedWorkerServer) RemoveExercises(ctx context.Context, req *ExercisesRequest) (*ChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExercises not implemented")
+}
+
+func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
+	s.RegisterService(&_Worker_serviceDesc, srv)
+}
+
+func _Worker_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Status(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_CreateLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CreateLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/CreateLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CreateLab(ctx, req.(*CreateLabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_StartLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StartLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/StartLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StartLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_StopLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StopLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/StopLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StopLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_RestartLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RestartLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/RestartLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RestartLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_CloseLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CloseLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/CloseLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CloseLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ResetFrontends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ResetFrontends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/ResetFrontends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ResetFrontends(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ResetExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ResetExercise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/ResetExercise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ResetExercise(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_RestartInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RestartInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/RestartInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RestartInstance(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ResizeFrontends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ResizeFrontends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/ResizeFrontends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ResizeFrontends(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_SnapshotLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SnapshotLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/SnapshotLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SnapshotLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_RestoreLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RestoreLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/RestoreLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RestoreLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/ListInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ListInstances(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_AddExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExercisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).AddExercises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/AddExercises",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).AddExercises(ctx, req.(*ExercisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_RemoveExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExercisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RemoveExercises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/RemoveExercises",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RemoveExercises(ctx, req.(*ExercisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "worker.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _Worker_Status_Handler,
+		},
+		{
+			MethodName: "CreateLab",
+			Handler:    _Worker_CreateLab_Handler,
+		},
+		{
+			MethodName: "StartLab",
+			Handler:    _Worker_StartLab_Handler,
+		},
+		{
+			MethodName: "StopLab",
+			Handler:    _Worker_StopLab_Handler,
+		},
+		{
+			MethodName: "RestartLab",
+			Handler:    _Worker_RestartLab_Handler,
+		},
+		{
+			MethodName: "CloseLab",
+			Handler:    _Worker_CloseLab_Handler,
+		},
+		{
+			MethodName: "ResetFrontends",
+			Handler:    _Worker_ResetFrontends_Handler,
+		},
+		{
+			MethodName: "ResetExercise",
+			Handler:    _Worker_ResetExercise_Handler,
+		},
+		{
+			MethodName: "RestartInstance",
+			Handler:    _Worker_RestartInstance_Handler,
+		},
+		{
+			MethodName: "ResizeFrontends",
+			Handler:    _Worker_ResizeFrontends_Handler,
+		},
+		{
+			MethodName: "SnapshotLab",
+			Handler:    _Worker_SnapshotLab_Handler,
+		},
+		{
+			MethodName: "RestoreLab",
+			Handler:    _Worker_RestoreLab_Handler,
+		},
+		{
+			MethodName: "ListInstances",
+			Handler:    _Worker_ListInstances_Handler,
+		},
+		{
+			MethodName: "AddExercises",
+			Handler:    _Worker_AddExercises_Handler,
+		},
+		{
+			MethodName: "RemoveExercises",
+			Handler:    _Worker_RemoveExercises_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "worker.proto",
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	wpb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"gopkg.in/yaml.v2"
+)
+
+const infoTimeout = 10 * time.Second
+
+var (
+	RemoteEnvironmentErr = errors.New("operation is not supported by environments of labs on workers")
+)
+
+// Remote is the configuration used by the daemon to reach a worker
+type Remote struct {
+	Name    string `yaml:"name"`
+	Address string `yaml:"address"`
+	AuthKey string `yaml:"auth-key"`
+	TLS     bool   `yaml:"tls,omitempty"`
+}
+
+type authCreds struct {
+	key string
+	tls bool
+}
+
+func (c authCreds) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{tokenKey: c.key}, nil
+}
+
+func (c authCreds) RequireTransportSecurity() bool {
+	return c.tls
+}
+
+func dial(conf Remote, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append(opts, grpc.WithPerRPCCredentials(authCreds{key: conf.AuthKey, tls: conf.TLS}))
+	if conf.TLS {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	return grpc.Dial(conf.Address, opts...)
+}
+
+func newRemoteLab(ctx context.Context, client wpb.WorkerClient, conf lab.Config) (lab.Lab, error) {
+	data, err := yaml.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.CreateLab(ctx, &wpb.CreateLabRequest{Config: data})
+	if err != nil {
+		return nil, err
+	}
+
+	l := &remoteLab{
+		client:  client,
+		tag:     resp.Tag,
+		rdpHost: resp.RdpHost,
+	}
+
+	for _, p := range resp.RdpPorts {
+		l.rdpPorts = append(l.rdpPorts, uint(p))
+	}
+
+	l.env = &remoteEnvironment{lab: l, challenges: fromPbChallenges(resp.Challenges)}
+
+	return l, nil
+}
+
+func fromPbChallenges(pbChals []*wpb.CreateLabResponse_Challenge) []store.Challenge {
+	var chals []store.Challenge
+	for _, c := range pbChals {
+		chals = append(chals, store.Challenge{
+			FlagTag:   store.Tag(c.FlagTag),
+			FlagValue: c.FlagValue,
+		})
+	}
+
+	return chals
+}
+
+// remoteLab is a lab running on a worker
+type remoteLab struct {
+	client   wpb.WorkerClient
+	tag      string
+	rdpHost  string
+	rdpPorts []uint
+	env      *remoteEnvironment
+
+	// number of operations stopping instances of the lab on purpose
+	busy int32
+}
+
+// working marks the lab as busy until the returned function is called
+func (l *remoteLab) working() func() {
+	atomic.AddInt32(&l.busy, 1)
+	return func() {
+		atomic.AddInt32(&l.busy, -1)
+	}
+}
+
+// Busy reports whether the worker is stopping instances of the lab on
+// purpose on request of the daemon
+func (l *remoteLab) Busy() bool {
+	return atomic.LoadInt32(&l.busy) > 0
+}
+
+func (l *remoteLab) req() *wpb.LabRequest {
+	return &wpb.LabRequest{Tag: l.tag}
+}
+
+func (l *remoteLab) Start(ctx context.Context) error {
+	_, err := l.client.StartLab(ctx, l.req())
+	return err
+}
+
+func (l *remoteLab) Stop() error {
+	defer l.working()()
+
+	_, err := l.client.StopLab(context.Background(), l.req())
+	return err
+}
+
+func (l *remoteLab) Restart(ctx context.Context) error {
+	defer l.working()()
+
+	_, err := l.client.RestartLab(ctx, l.req())
+	return err
+}
+
+func (l *remoteLab) Environment() exercise.Environment {
+	return l.env
+}
+
+func (l *remoteLab) ResetFrontends(ctx context.Context) error {
+	defer l.working()()
+
+	_, err := l.client.ResetFrontends(ctx, l.req())
+	return err
+}
+
+func (l *remoteLab) RestartInstance(ctx context.Context, id string) error {
+	_, err := l.client.RestartInstance(ctx, &wpb.LabRequest{Tag: l.tag, Name: id})
+	return err
+}
+
+func (l *remoteLab) ResizeFrontends(ctx context.Context, conf store.InstanceConfig) (int, error) {
+	defer l.working()()
+
+	resp, err := l.client.ResizeFrontends(ctx, &wpb.LabRequest{
+		Tag:      l.tag,
+		Image:    conf.Image,
+		MemoryMB: int64(conf.MemoryMB),
+		Cpu:      float32(conf.CPU),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.Resized), nil
+}
+
+func (l *remoteLab) Snapshot(ctx context.Context, name string) error {
+	_, err := l.client.SnapshotLab(ctx, &wpb.LabRequest{Tag: l.tag, Name: name})
+	return err
+}
+
+func (l *remoteLab) Restore(ctx context.Context, name string) error {
+	defer l.working()()
+
+	_, err := l.client.RestoreLab(ctx, &wpb.LabRequest{Tag: l.tag, Name: name})
+	return err
+}
+
+func (l *remoteLab) RdpConnPorts() []uint {
+	return l.rdpPorts
+}
+
+func (l *remoteLab) RdpHost() string {
+	return l.rdpHost
+}
+
+func (l *remoteLab) Tag() string {
+	return l.tag
+}
+
+// InstanceInfo returns no instances if the worker cannot be reached
+func (l *remoteLab) InstanceInfo() []virtual.InstanceInfo {
+	ctx, cancel := context.WithTimeout(context.Background(), infoTimeout)
+	defer cancel()
+
+	resp, err := l.client.ListInstances(ctx, l.req())
+	if err != nil {
+		log.Warn().Str("lab", l.tag).Msgf("unable to list instances of lab on worker: %s", err)
+		return nil
+	}
+
+	var instances []virtual.InstanceInfo
+	for _, i := range resp.Instances {
+		instances = append(instances, virtual.InstanceInfo{
+			Image: i.Image,
+			Type:  i.Type,
+			Id:    i.Id,
+			State: virtual.State(i.State),
+		})
+	}
+
+	return instances
+}
+
+func (l *remoteLab) Close() error {
+	_, err := l.client.CloseLab(context.Background(), l.req())
+	return err
+}
+
+// remoteEnvironment exposes the parts of the environment of a remote lab
+// used by the daemon, the environment is otherwise managed by the worker.
+type remoteEnvironment struct {
+	lab *remoteLab
+
+	m          sync.Mutex
+	challenges []store.Challenge
+}
+
+func (ee *remoteEnvironment) Create(context.Context) error {
+	return RemoteEnvironmentErr
+}
+
+func (ee *remoteEnvironment) Add(ctx context.Context, confs ...store.Exercise) error {
+	data, err := yaml.Marshal(confs)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ee.lab.client.AddExercises(ctx, &wpb.ExercisesRequest{Tag: ee.lab.tag, Exercises: data})
+	if err != nil {
+		return err
+	}
+
+	ee.setChallenges(fromPbChallenges(resp.Challenges))
+
+	return nil
+}
+
+func (ee *remoteEnvironment) Remove(ctx context.Context, tags ...store.Tag) error {
+	req := &wpb.ExercisesRequest{Tag: ee.lab.tag}
+	for _, t := range tags {
+		req.ExerciseTags = append(req.ExerciseTags, string(t))
+	}
+
+	resp, err := ee.lab.client.RemoveExercises(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	ee.setChallenges(fromPbChallenges(resp.Challenges))
+
+	return nil
+}
+
+func (ee *remoteEnvironment) setChallenges(chals []store.Challenge) {
+	ee.m.Lock()
+	ee.challenges = chals
+	ee.m.Unlock()
+}
+
+func (ee *remoteEnvironment) ResetByTag(ctx context.Context, tag string) error {
+	_, err := ee.lab.client.ResetExercise(ctx, &wpb.LabRequest{Tag: ee.lab.tag, Name: tag})
+	return err
+}
+
+func (ee *remoteEnvironment) RestartInstance(ctx context.Context, id string) error {
+	return ee.lab.RestartInstance(ctx, id)
+}
+
+func (ee *remoteEnvironment) Snapshot(context.Context, string) error {
+	return RemoteEnvironmentErr
+}
+
+func (ee *remoteEnvironment) Restore(context.Context, string) error {
+	return RemoteEnvironmentErr
+}
+
+func (ee *remoteEnvironment) NetworkInterface() string {
+	return ""
+}
+
+func (ee *remoteEnvironment) Network() docker.Network {
+	return nil
+}
+
+func (ee *remoteEnvironment) Challenges() []store.Challenge {
+	ee.m.Lock()
+	defer ee.m.Unlock()
+
+	return ee.challenges
+}
+
+// InstanceInfo returns nothing, as the instances are listed by the lab
+func (ee *remoteEnvironment) InstanceInfo() []virtual.InstanceInfo {
+	return nil
+}
+
+func (ee *remoteEnvironment) Start(context.Context) error {
+	return RemoteEnvironmentErr
+}
+
+func (ee *remoteEnvironment) Stop() error {
+	return RemoteEnvironmentErr
+}
+
+func (ee *remoteEnvironment) Close() error {
+	return RemoteEnvironmentErr
+}
@@ -0,0 +1,322 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	wpb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+const (
+	localHostName = "local"
+	pollInterval  = 10 * time.Second
+	pollTimeout   = 5 * time.Second
+)
+
+// HostInfo describes a host as last seen by the scheduler
+type HostInfo struct {
+	Name    string
+	Address string
+	Status  Status
+	Err     error
+}
+
+type host struct {
+	name    string
+	address string
+	poll    func(context.Context) (Status, error)
+	newLab  func(context.Context, lab.Config, lab.Creator) (lab.Lab, error)
+	conn    *grpc.ClientConn
+
+	m      sync.Mutex
+	polled bool
+	status Status
+	err    error
+	// reservedMB is the memory of labs placed on the host since it was last polled
+	reservedMB uint64
+}
+
+func (h *host) update(ctx context.Context) {
+	s, err := h.poll(ctx)
+
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	h.polled = true
+	h.err = err
+	if err != nil {
+		log.Warn().Str("host", h.name).Msgf("unable to poll status of host: %s", err)
+		return
+	}
+
+	h.status = s
+	h.reservedMB = 0
+}
+
+func (h *host) score() (float64, bool) {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	if !h.polled || h.err != nil {
+		return 0, false
+	}
+
+	s := h.status
+	if s.MemoryAvailableMB < h.reservedMB {
+		s.MemoryAvailableMB = 0
+	} else {
+		s.MemoryAvailableMB -= h.reservedMB
+	}
+
+	return s.score(), true
+}
+
+func (h *host) reserve(mb uint64) {
+	h.m.Lock()
+	h.reservedMB += mb
+	h.m.Unlock()
+}
+
+// unreserve gives back the memory of a lab which could not be created,
+// unless the host has been polled since
+func (h *host) unreserve(mb uint64) {
+	h.m.Lock()
+	if h.reservedMB < mb {
+		h.reservedMB = 0
+	} else {
+		h.reservedMB -= mb
+	}
+	h.m.Unlock()
+}
+
+func (h *host) info() HostInfo {
+	h.m.Lock()
+	defer h.m.Unlock()
+
+	return HostInfo{
+		Name:    h.name,
+		Address: h.address,
+		Status:  h.status,
+		Err:     h.err,
+	}
+}
+
+// Scheduler places labs on the local host or on one of the workers,
+// picking the host with the most free resources
+type Scheduler struct {
+	local *host
+	hosts []*host
+
+	stop chan struct{}
+	once sync.Once
+}
+
+func NewScheduler(remotes []Remote) (*Scheduler, error) {
+	local := &host{
+		name: localHostName,
+		poll: func(context.Context) (Status, error) {
+			return hostStatus()
+		},
+		newLab: func(ctx context.Context, conf lab.Config, local lab.Creator) (lab.Lab, error) {
+			return local.NewLab(ctx)
+		},
+	}
+
+	s := &Scheduler{
+		local: local,
+		hosts: []*host{local},
+		stop:  make(chan struct{}),
+	}
+
+	for _, r := range remotes {
+		conn, err := dial(r)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+
+		s.hosts = append(s.hosts, newRemoteHost(r, conn))
+	}
+
+	go s.run()
+
+	return s, nil
+}
+
+func newRemoteHost(r Remote, conn *grpc.ClientConn) *host {
+	client := wpb.NewWorkerClient(conn)
+
+	return &host{
+		name:    r.Name,
+		address: r.Address,
+		conn:    conn,
+		poll: func(ctx context.Context) (Status, error) {
+			resp, err := client.Status(ctx, &wpb.Empty{})
+			if err != nil {
+				return Status{}, err
+			}
+
+			return Status{
+				CPUPercent:        float64(resp.CpuPercent),
+				CPUs:              int(resp.Cpus),
+				MemoryPercent:     float64(resp.MemoryPercent),
+				MemoryAvailableMB: uint64(resp.MemoryAvailableMB),
+				Labs:              int(resp.Labs),
+			}, nil
+		},
+		newLab: func(ctx context.Context, conf lab.Config, _ lab.Creator) (lab.Lab, error) {
+			return newRemoteLab(ctx, client, conf)
+		},
+	}
+}
+
+func (s *Scheduler) run() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.poll()
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) poll() {
+	var wg sync.WaitGroup
+	for _, h := range s.hosts {
+		wg.Add(1)
+		go func(h *host) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), pollTimeout)
+			defer cancel()
+
+			h.update(ctx)
+		}(h)
+	}
+	wg.Wait()
+}
+
+// candidates returns the hosts whose status is known, the highest score
+// first, followed by the local host if its status is unknown
+func (s *Scheduler) candidates() []*host {
+	type scored struct {
+		h     *host
+		score float64
+	}
+
+	var known []scored
+	for _, h := range s.hosts {
+		if score, ok := h.score(); ok {
+			known = append(known, scored{h, score})
+		}
+	}
+
+	sort.SliceStable(known, func(i, j int) bool {
+		return known[i].score > known[j].score
+	})
+
+	var hosts []*host
+	var local bool
+	for _, k := range known {
+		hosts = append(hosts, k.h)
+		local = local || k.h == s.local
+	}
+
+	if !local {
+		hosts = append(hosts, s.local)
+	}
+
+	return hosts
+}
+
+// pick returns the host with the highest score, the local host is used
+// if the status of no host is known
+func (s *Scheduler) pick() *host {
+	return s.candidates()[0]
+}
+
+// NewLab creates the lab on the host with the highest score, falling back
+// to the next host (and finally this host) if the creation fails
+func (s *Scheduler) NewLab(ctx context.Context, conf lab.Config, local lab.Creator) (lab.Lab, error) {
+	mb := memoryMB(conf)
+
+	var firstErr error
+	for _, h := range s.candidates() {
+		h.reserve(mb)
+
+		log.Debug().Str("host", h.name).Msg("Scheduling lab")
+		l, err := h.newLab(ctx, conf, local)
+		if err == nil {
+			return l, nil
+		}
+
+		h.unreserve(mb)
+		log.Warn().
+			Err(err).
+			Str("host", h.name).
+			Msg("Unable to create lab on host")
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return nil, firstErr
+}
+
+func (s *Scheduler) Hosts() []HostInfo {
+	var infos []HostInfo
+	for _, h := range s.hosts {
+		infos = append(infos, h.info())
+	}
+
+	return infos
+}
+
+func (s *Scheduler) Close() error {
+	s.once.Do(func() {
+		close(s.stop)
+	})
+
+	for _, h := range s.hosts {
+		if h.conn != nil {
+			h.conn.Close()
+		}
+	}
+
+	return nil
+}
+
+// memoryMB estimates the memory used by a lab
+func memoryMB(conf lab.Config) uint64 {
+	var mb uint64
+	for _, f := range conf.Frontends {
+		mb += uint64(f.MemoryMB)
+	}
+
+	for _, e := range conf.Exercises {
+		for _, c := range e.DockerConfs {
+			mb += uint64(c.MemoryMB)
+		}
+
+		for _, c := range e.VboxConfs {
+			mb += uint64(c.MemoryMB)
+		}
+	}
+
+	return mb
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestSchedulerPick(t *testing.T) {
+	type hostStatus struct {
+		name       string
+		polled     bool
+		status     Status
+		err        error
+		reservedMB uint64
+	}
+
+	tt := []struct {
+		name     string
+		local    hostStatus
+		remotes  []hostStatus
+		expected string
+	}{
+		{
+			name:  "Most available memory",
+			local: hostStatus{polled: true, status: Status{MemoryAvailableMB: 4000}},
+			remotes: []hostStatus{
+				{name: "w1", polled: true, status: Status{MemoryAvailableMB: 8000}},
+				{name: "w2", polled: true, status: Status{MemoryAvailableMB: 6000}},
+			},
+			expected: "w1",
+		},
+		{
+			name:  "Busy CPU",
+			local: hostStatus{polled: true, status: Status{MemoryAvailableMB: 4000}},
+			remotes: []hostStatus{
+				{name: "w1", polled: true, status: Status{MemoryAvailableMB: 8000, CPUPercent: 75}},
+			},
+			expected: localHostName,
+		},
+		{
+			name:  "Reserved memory",
+			local: hostStatus{polled: true, status: Status{MemoryAvailableMB: 4000}},
+			remotes: []hostStatus{
+				{name: "w1", polled: true, status: Status{MemoryAvailableMB: 8000}, reservedMB: 6000},
+			},
+			expected: localHostName,
+		},
+		{
+			name:  "Unreachable worker",
+			local: hostStatus{polled: true, status: Status{MemoryAvailableMB: 4000}},
+			remotes: []hostStatus{
+				{name: "w1", polled: true, status: Status{MemoryAvailableMB: 8000}, err: errors.New("unreachable")},
+			},
+			expected: localHostName,
+		},
+		{
+			name:  "Local not polled",
+			local: hostStatus{},
+			remotes: []hostStatus{
+				{name: "w1", polled: true, status: Status{MemoryAvailableMB: 1000}},
+			},
+			expected: "w1",
+		},
+		{
+			name:     "Nothing polled",
+			local:    hostStatus{},
+			remotes:  []hostStatus{{name: "w1"}},
+			expected: localHostName,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			newHost := func(hs hostStatus) *host {
+				return &host{
+					name:       hs.name,
+					polled:     hs.polled,
+					status:     hs.status,
+					err:        hs.err,
+					reservedMB: hs.reservedMB,
+				}
+			}
+
+			tc.local.name = localHostName
+			local := newHost(tc.local)
+			s := &Scheduler{local: local, hosts: []*host{local}}
+			for _, r := range tc.remotes {
+				s.hosts = append(s.hosts, newHost(r))
+			}
+
+			h := s.pick()
+			if h.name != tc.expected {
+				t.Fatalf("expected host (%s) to be picked, but picked: %s", tc.expected, h.name)
+			}
+		})
+	}
+}
+
+type schedulerLab struct {
+	host string
+	lab.Lab
+}
+
+func TestSchedulerNewLab(t *testing.T) {
+	conf := lab.Config{Frontends: []store.InstanceConfig{{Image: "kali", MemoryMB: 2048}}}
+
+	tt := []struct {
+		name     string
+		failing  map[string]bool
+		expected string
+		err      bool
+	}{
+		{name: "Best host", expected: "w1"},
+		{name: "Worker down", failing: map[string]bool{"w1": true}, expected: "w2"},
+		{name: "Workers down", failing: map[string]bool{"w1": true, "w2": true}, expected: localHostName},
+		{name: "Every host down", failing: map[string]bool{"w1": true, "w2": true, localHostName: true}, err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			newHost := func(name string, availableMB uint64) *host {
+				return &host{
+					name:   name,
+					polled: true,
+					status: Status{MemoryAvailableMB: availableMB},
+					newLab: func(context.Context, lab.Config, lab.Creator) (lab.Lab, error) {
+						if tc.failing[name] {
+							return nil, errors.New("unreachable")
+						}
+
+						return &schedulerLab{host: name}, nil
+					},
+				}
+			}
+
+			local := newHost(localHostName, 4000)
+			s := &Scheduler{local: local, hosts: []*host{local, newHost("w1", 8000), newHost("w2", 6000)}}
+
+			l, err := s.NewLab(context.Background(), conf, nil)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error when every host fails")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if h := l.(*schedulerLab).host; h != tc.expected {
+					t.Fatalf("expected lab to be created on %s, but was created on: %s", tc.expected, h)
+				}
+			}
+
+			for _, h := range s.hosts {
+				expected := uint64(0)
+				if !tc.err && h.name == tc.expected {
+					expected = 2048
+				}
+
+				if h.reservedMB != expected {
+					t.Fatalf("expected %d MB to be reserved on %s, but got: %d", expected, h.name, h.reservedMB)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryMB(t *testing.T) {
+	conf := lab.Config{
+		Frontends: []store.InstanceConfig{{Image: "kali", MemoryMB: 2048}},
+		Exercises: []store.Exercise{
+			{
+				DockerConfs: []store.DockerConfig{
+					{ExerciseInstanceConfig: store.ExerciseInstanceConfig{InstanceConfig: store.InstanceConfig{MemoryMB: 50}}},
+					{ExerciseInstanceConfig: store.ExerciseInstanceConfig{InstanceConfig: store.InstanceConfig{MemoryMB: 100}}},
+				},
+				VboxConfs: []store.VboxConfig{
+					{ExerciseInstanceConfig: store.ExerciseInstanceConfig{InstanceConfig: store.InstanceConfig{MemoryMB: 1024}}},
+				},
+			},
+		},
+	}
+
+	if mb := memoryMB(conf); mb != 3222 {
+		t.Fatalf("expected estimated memory (3222), received: %d", mb)
+	}
+}
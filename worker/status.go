@@ -0,0 +1,59 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/mem"
+)
+
+// Status describes the resources of a host running labs
+type Status struct {
+	CPUPercent        float64
+	CPUs              int
+	MemoryPercent     float64
+	MemoryAvailableMB uint64
+	Labs              int
+}
+
+func hostStatus() (Status, error) {
+	cpus, err := cpu.Percent(time.Second, false)
+	if err != nil {
+		return Status{}, err
+	}
+
+	n, err := cpu.Counts(true)
+	if err != nil {
+		return Status{}, err
+	}
+
+	v, err := mem.VirtualMemory()
+	if err != nil {
+		return Status{}, err
+	}
+
+	s := Status{
+		CPUs:              n,
+		MemoryPercent:     v.UsedPercent,
+		MemoryAvailableMB: v.Available / 1024 / 1024,
+	}
+	if len(cpus) == 1 {
+		s.CPUPercent = cpus[0]
+	}
+
+	return s, nil
+}
+
+// score ranks hosts by their free memory, weighted by their idle CPU
+func (s Status) score() float64 {
+	idle := 1 - s.CPUPercent/100
+	if idle < 0 {
+		idle = 0
+	}
+
+	return float64(s.MemoryAvailableMB) * idle
+}
@@ -0,0 +1,418 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/vbox"
+	wpb "github.com/aau-network-security/haaukins/worker/proto"
+	dockerclient "github.com/fsouza/go-dockerclient"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultPort = 5455
+	tokenKey    = "token"
+)
+
+var (
+	MissingHostErr    = errors.New("worker requires a host, which frontends serve RDP on")
+	MissingAuthKeyErr = errors.New("worker requires an authentication key")
+	InvalidAuthKeyErr = errors.New("invalid authentication key")
+	UnknownLabErr     = errors.New("unknown lab")
+)
+
+type Config struct {
+	// Host is the address of the worker reachable by the daemon, which
+	// frontends serve RDP on
+	Host               string                           `yaml:"host"`
+	Port               uint                             `yaml:"port,omitempty"`
+	AuthKey            string                           `yaml:"auth-key"`
+	OvaDir             string                           `yaml:"ova-directory,omitempty"`
+	DockerRepositories []dockerclient.AuthConfiguration `yaml:"docker-repositories,omitempty"`
+	TLS                struct {
+		Enabled  bool   `yaml:"enabled"`
+		CertFile string `yaml:"certfile"`
+		CertKey  string `yaml:"certkey"`
+	} `yaml:"tls,omitempty"`
+}
+
+func NewConfigFromFile(path string) (*Config, error) {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := yaml.Unmarshal(f, &c); err != nil {
+		return nil, err
+	}
+
+	for _, repo := range c.DockerRepositories {
+		docker.Registries[repo.ServerAddress] = repo
+	}
+
+	if c.Host == "" {
+		return nil, MissingHostErr
+	}
+
+	if c.AuthKey == "" {
+		return nil, MissingAuthKeyErr
+	}
+
+	if c.Port == 0 {
+		c.Port = defaultPort
+	}
+
+	if c.OvaDir == "" {
+		dir, _ := os.Getwd()
+		c.OvaDir = filepath.Join(dir, "vbox")
+	}
+
+	return &c, nil
+}
+
+// worker runs labs on behalf of a daemon
+type worker struct {
+	conf   *Config
+	vlib   vbox.Library
+	newLab func(context.Context, lab.Config) (lab.Lab, error)
+
+	m    sync.Mutex
+	labs map[string]lab.Lab
+}
+
+func New(conf *Config) *worker {
+	vlib := vbox.NewLibrary(conf.OvaDir)
+
+	return &worker{
+		conf: conf,
+		vlib: vlib,
+		newLab: func(ctx context.Context, lconf lab.Config) (lab.Lab, error) {
+			lh := lab.LabHost{
+				Vlib:    vlib,
+				Conf:    lconf,
+				RdpHost: conf.Host,
+			}
+
+			return lh.NewLab(ctx)
+		},
+		labs: map[string]lab.Lab{},
+	}
+}
+
+func (w *worker) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[tokenKey]) == 0 {
+		return InvalidAuthKeyErr
+	}
+
+	if subtle.ConstantTimeCompare([]byte(md[tokenKey][0]), []byte(w.conf.AuthKey)) != 1 {
+		return InvalidAuthKeyErr
+	}
+
+	return nil
+}
+
+func (w *worker) GetServer(opts ...grpc.ServerOption) *grpc.Server {
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := w.authenticate(ctx); err != nil {
+			log.Warn().Str("method", info.FullMethod).Msg("Unauthenticated call to worker")
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+
+	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	s := grpc.NewServer(opts...)
+	wpb.RegisterWorkerServer(s, w)
+
+	return s
+}
+
+func (w *worker) Run() error {
+	var opts []grpc.ServerOption
+	if w.conf.TLS.Enabled {
+		creds, err := credentials.NewServerTLSFromFile(w.conf.TLS.CertFile, w.conf.TLS.CertKey)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", w.conf.Port))
+	if err != nil {
+		return err
+	}
+
+	log.Info().Uint("port", w.conf.Port).Msg("Worker has been started")
+
+	return w.GetServer(opts...).Serve(lis)
+}
+
+func (w *worker) Close() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	for tag, l := range w.labs {
+		if err := l.Close(); err != nil {
+			log.Warn().Str("lab", tag).Msgf("error while closing lab: %s", err)
+		}
+	}
+	w.labs = map[string]lab.Lab{}
+
+	return nil
+}
+
+func (w *worker) getLab(tag string) (lab.Lab, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	l, ok := w.labs[tag]
+	if !ok {
+		return nil, UnknownLabErr
+	}
+
+	return l, nil
+}
+
+func (w *worker) Status(ctx context.Context, req *wpb.Empty) (*wpb.StatusResponse, error) {
+	s, err := hostStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	w.m.Lock()
+	s.Labs = len(w.labs)
+	w.m.Unlock()
+
+	return &wpb.StatusResponse{
+		CpuPercent:        float32(s.CPUPercent),
+		Cpus:              int32(s.CPUs),
+		MemoryPercent:     float32(s.MemoryPercent),
+		MemoryAvailableMB: int64(s.MemoryAvailableMB),
+		Labs:              int32(s.Labs),
+	}, nil
+}
+
+func (w *worker) CreateLab(ctx context.Context, req *wpb.CreateLabRequest) (*wpb.CreateLabResponse, error) {
+	var conf lab.Config
+	if err := yaml.Unmarshal(req.Config, &conf); err != nil {
+		return nil, err
+	}
+
+	// the lab outlives the request
+	l, err := w.newLab(context.Background(), conf)
+	if err != nil {
+		return nil, err
+	}
+
+	w.m.Lock()
+	w.labs[l.Tag()] = l
+	w.m.Unlock()
+
+	log.Info().Str("lab", l.Tag()).Msg("Created lab")
+
+	var ports []uint32
+	for _, p := range l.RdpConnPorts() {
+		ports = append(ports, uint32(p))
+	}
+
+	return &wpb.CreateLabResponse{
+		Tag:        l.Tag(),
+		RdpHost:    l.RdpHost(),
+		RdpPorts:   ports,
+		Challenges: challenges(l),
+	}, nil
+}
+
+func challenges(l lab.Lab) []*wpb.CreateLabResponse_Challenge {
+	var chals []*wpb.CreateLabResponse_Challenge
+	for _, c := range l.Environment().Challenges() {
+		chals = append(chals, &wpb.CreateLabResponse_Challenge{
+			FlagTag:   string(c.FlagTag),
+			FlagValue: c.FlagValue,
+		})
+	}
+
+	return chals
+}
+
+func (w *worker) StartLab(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.Start(context.Background())
+}
+
+func (w *worker) StopLab(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.Stop()
+}
+
+func (w *worker) RestartLab(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.Restart(context.Background())
+}
+
+func (w *worker) CloseLab(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	w.m.Lock()
+	delete(w.labs, req.Tag)
+	w.m.Unlock()
+
+	log.Info().Str("lab", req.Tag).Msg("Closing lab")
+
+	return &wpb.Empty{}, l.Close()
+}
+
+func (w *worker) ResetFrontends(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.ResetFrontends(context.Background())
+}
+
+func (w *worker) ResetExercise(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.Environment().ResetByTag(context.Background(), req.Name)
+}
+
+func (w *worker) RestartInstance(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.RestartInstance(context.Background(), req.Name)
+}
+
+func (w *worker) ResizeFrontends(ctx context.Context, req *wpb.LabRequest) (*wpb.ResizeFrontendsResponse, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := l.ResizeFrontends(context.Background(), store.InstanceConfig{
+		Image:    req.Image,
+		MemoryMB: uint(req.MemoryMB),
+		CPU:      float64(req.Cpu),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.ResizeFrontendsResponse{Resized: int32(n)}, nil
+}
+
+func (w *worker) SnapshotLab(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.Snapshot(context.Background(), req.Name)
+}
+
+func (w *worker) RestoreLab(ctx context.Context, req *wpb.LabRequest) (*wpb.Empty, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wpb.Empty{}, l.Restore(context.Background(), req.Name)
+}
+
+func (w *worker) ListInstances(ctx context.Context, req *wpb.LabRequest) (*wpb.ListInstancesResponse, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []*wpb.ListInstancesResponse_Instance
+	for _, i := range l.InstanceInfo() {
+		instances = append(instances, &wpb.ListInstancesResponse_Instance{
+			Image: i.Image,
+			Type:  i.Type,
+			Id:    i.Id,
+			State: int32(i.State),
+		})
+	}
+
+	return &wpb.ListInstancesResponse{Instances: instances}, nil
+}
+
+func (w *worker) AddExercises(ctx context.Context, req *wpb.ExercisesRequest) (*wpb.ChallengesResponse, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var exercises []store.Exercise
+	if err := yaml.Unmarshal(req.Exercises, &exercises); err != nil {
+		return nil, err
+	}
+
+	if err := l.Environment().Add(context.Background(), exercises...); err != nil {
+		return nil, err
+	}
+
+	return &wpb.ChallengesResponse{Challenges: challenges(l)}, nil
+}
+
+func (w *worker) RemoveExercises(ctx context.Context, req *wpb.ExercisesRequest) (*wpb.ChallengesResponse, error) {
+	l, err := w.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []store.Tag
+	for _, t := range req.ExerciseTags {
+		tags = append(tags, store.Tag(t))
+	}
+
+	if err := l.Environment().Remove(context.Background(), tags...); err != nil {
+		return nil, err
+	}
+
+	return &wpb.ChallengesResponse{Challenges: challenges(l)}, nil
+}
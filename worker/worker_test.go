@@ -0,0 +1,280 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	wpb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func init() {
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+}
+
+type fakeEnvironment struct {
+	challenges []store.Challenge
+	reset      []string
+	exercise.Environment
+}
+
+func (fe *fakeEnvironment) Challenges() []store.Challenge {
+	return fe.challenges
+}
+
+func (fe *fakeEnvironment) Add(ctx context.Context, exercises ...store.Exercise) error {
+	for _, e := range exercises {
+		fe.challenges = append(fe.challenges, store.Challenge{FlagTag: store.Tag(string(e.Tags[0]) + "-1")})
+	}
+	return nil
+}
+
+func (fe *fakeEnvironment) Remove(ctx context.Context, tags ...store.Tag) error {
+	var chals []store.Challenge
+	for _, c := range fe.challenges {
+		removed := false
+		for _, t := range tags {
+			if c.FlagTag == store.Tag(string(t)+"-1") {
+				removed = true
+			}
+		}
+
+		if !removed {
+			chals = append(chals, c)
+		}
+	}
+	fe.challenges = chals
+	return nil
+}
+
+func (fe *fakeEnvironment) ResetByTag(ctx context.Context, tag string) error {
+	fe.reset = append(fe.reset, tag)
+	return nil
+}
+
+type fakeLab struct {
+	conf      lab.Config
+	env       *fakeEnvironment
+	started   bool
+	closed    bool
+	snapshots []string
+	lab.Lab
+}
+
+func (fl *fakeLab) Tag() string {
+	return "lab-1"
+}
+
+func (fl *fakeLab) RdpHost() string {
+	return "10.0.0.11"
+}
+
+func (fl *fakeLab) RdpConnPorts() []uint {
+	return []uint{5000, 5001}
+}
+
+func (fl *fakeLab) Environment() exercise.Environment {
+	return fl.env
+}
+
+func (fl *fakeLab) Start(context.Context) error {
+	fl.started = true
+	return nil
+}
+
+func (fl *fakeLab) Snapshot(ctx context.Context, name string) error {
+	fl.snapshots = append(fl.snapshots, name)
+	return nil
+}
+
+func (fl *fakeLab) InstanceInfo() []virtual.InstanceInfo {
+	return []virtual.InstanceInfo{{Image: "kali", Type: "vbox", Id: "abc", State: virtual.Running}}
+}
+
+func (fl *fakeLab) Close() error {
+	fl.closed = true
+	return nil
+}
+
+func getClient(t *testing.T, w *worker, key string) (wpb.WorkerClient, func()) {
+	const oneMegaByte = 1024 * 1024
+	lis := bufconn.Listen(oneMegaByte)
+	s := w.GetServer()
+
+	go func() {
+		s.Serve(lis)
+	}()
+
+	dialer := func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}
+
+	conn, err := dial(Remote{Address: "bufnet", AuthKey: key}, grpc.WithDialer(dialer))
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+
+	return wpb.NewWorkerClient(conn), func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestWorkerAuth(t *testing.T) {
+	tt := []struct {
+		name string
+		key  string
+		err  string
+	}{
+		{name: "Normal", key: "secret", err: UnknownLabErr.Error()},
+		{name: "Wrong key", key: "guess", err: InvalidAuthKeyErr.Error()},
+		{name: "No key", key: "", err: InvalidAuthKeyErr.Error()},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &worker{
+				conf: &Config{AuthKey: "secret"},
+				labs: map[string]lab.Lab{},
+			}
+
+			client, closer := getClient(t, w, tc.key)
+			defer closer()
+
+			_, err := client.StartLab(context.Background(), &wpb.LabRequest{Tag: "unknown"})
+			if err == nil {
+				t.Fatalf("expected error, but received none")
+			}
+
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error (%s), received: %s", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestRemoteLab(t *testing.T) {
+	fl := &fakeLab{
+		env: &fakeEnvironment{
+			challenges: []store.Challenge{{FlagTag: "csrf-1", FlagValue: "HKN{abc}"}},
+		},
+	}
+
+	w := &worker{
+		conf: &Config{AuthKey: "secret"},
+		newLab: func(ctx context.Context, conf lab.Config) (lab.Lab, error) {
+			fl.conf = conf
+			return fl, nil
+		},
+		labs: map[string]lab.Lab{},
+	}
+
+	client, closer := getClient(t, w, "secret")
+	defer closer()
+
+	ctx := context.Background()
+	conf := lab.Config{
+		Frontends: []store.InstanceConfig{{Image: "kali", MemoryMB: 2048}},
+		Exercises: []store.Exercise{{Name: "csrf", Tags: []store.Tag{"csrf"}}},
+	}
+
+	l, err := newRemoteLab(ctx, client, conf)
+	if err != nil {
+		t.Fatalf("unexpected error when creating lab: %s", err)
+	}
+
+	if len(fl.conf.Frontends) != 1 || fl.conf.Frontends[0].Image != "kali" || fl.conf.Frontends[0].MemoryMB != 2048 {
+		t.Fatalf("expected frontends to be sent to worker, received: %v", fl.conf.Frontends)
+	}
+
+	if len(fl.conf.Exercises) != 1 || fl.conf.Exercises[0].Name != "csrf" {
+		t.Fatalf("expected exercises to be sent to worker, received: %v", fl.conf.Exercises)
+	}
+
+	if l.Tag() != "lab-1" {
+		t.Fatalf("expected tag (lab-1), received: %s", l.Tag())
+	}
+
+	if l.RdpHost() != "10.0.0.11" {
+		t.Fatalf("expected rdp host (10.0.0.11), received: %s", l.RdpHost())
+	}
+
+	if ports := l.RdpConnPorts(); len(ports) != 2 || ports[0] != 5000 {
+		t.Fatalf("expected rdp ports [5000 5001], received: %v", ports)
+	}
+
+	chals := l.Environment().Challenges()
+	if len(chals) != 1 || chals[0].FlagValue != "HKN{abc}" {
+		t.Fatalf("expected challenge to be received, received: %v", chals)
+	}
+
+	if err := l.Start(ctx); err != nil {
+		t.Fatalf("unexpected error when starting lab: %s", err)
+	}
+
+	if !fl.started {
+		t.Fatalf("expected lab to be started")
+	}
+
+	if err := l.Snapshot(ctx, "before"); err != nil {
+		t.Fatalf("unexpected error when taking snapshot: %s", err)
+	}
+
+	if len(fl.snapshots) != 1 || fl.snapshots[0] != "before" {
+		t.Fatalf("expected snapshot (before), received: %v", fl.snapshots)
+	}
+
+	if err := l.Environment().ResetByTag(ctx, "csrf"); err != nil {
+		t.Fatalf("unexpected error when resetting exercise: %s", err)
+	}
+
+	if len(fl.env.reset) != 1 || fl.env.reset[0] != "csrf" {
+		t.Fatalf("expected exercise (csrf) to be reset, received: %v", fl.env.reset)
+	}
+
+	if err := l.Environment().Add(ctx, store.Exercise{Tags: []store.Tag{"sql"}}); err != nil {
+		t.Fatalf("unexpected error when adding exercise: %s", err)
+	}
+
+	if chals := l.Environment().Challenges(); len(chals) != 2 || chals[1].FlagTag != "sql-1" {
+		t.Fatalf("expected challenge of added exercise, received: %v", chals)
+	}
+
+	if err := l.Environment().Remove(ctx, "sql"); err != nil {
+		t.Fatalf("unexpected error when removing exercise: %s", err)
+	}
+
+	if chals := l.Environment().Challenges(); len(chals) != 1 {
+		t.Fatalf("expected challenge of removed exercise to be gone, received: %v", chals)
+	}
+
+	instances := l.InstanceInfo()
+	if len(instances) != 1 || instances[0].Id != "abc" || instances[0].State != virtual.Running {
+		t.Fatalf("expected running instance (abc), received: %v", instances)
+	}
+
+	if err := l.Close(); err != nil {
+		t.Fatalf("unexpected error when closing lab: %s", err)
+	}
+
+	if !fl.closed {
+		t.Fatalf("expected lab to be closed")
+	}
+
+	if err := l.Start(ctx); err == nil || !strings.Contains(err.Error(), UnknownLabErr.Error()) {
+		t.Fatalf("expected error (%s) for closed lab, received: %v", UnknownLabErr, err)
+	}
+}